@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
+)
+
+// accessTokenResourceServer validates the opaque access tokens minted by flyteadmin and hands any other token to the
+// wrapped resource server.
+type accessTokenResourceServer struct {
+	interfaces.OAuth2ResourceServer
+	validator interfaces.AccessTokenValidator
+}
+
+func (r accessTokenResourceServer) ValidateAccessToken(ctx context.Context, expectedAudience, tokenStr string) (
+	interfaces.IdentityContext, error) {
+	if strings.HasPrefix(tokenStr, AccessTokenPrefix) {
+		return r.validator.ValidateAccessToken(ctx, tokenStr)
+	}
+	return r.OAuth2ResourceServer.ValidateAccessToken(ctx, expectedAudience, tokenStr)
+}
+
+// NewAccessTokenResourceServer wraps a resource server so that access tokens carrying AccessTokenPrefix are validated
+// by the given validator rather than as JWTs.
+func NewAccessTokenResourceServer(resourceServer interfaces.OAuth2ResourceServer,
+	validator interfaces.AccessTokenValidator) interfaces.OAuth2ResourceServer {
+	return accessTokenResourceServer{
+		OAuth2ResourceServer: resourceServer,
+		validator:            validator,
+	}
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth/interfaces/mocks"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestAccessTokenResourceServer(t *testing.T) {
+	ctx := context.Background()
+	jwtIdentity := NewIdentityContext("aud", "jwt-user", "", time.Now(), sets.NewString(ScopeAll), nil)
+	accessTokenIdentity := NewIdentityContext("", "token-user", "", time.Now(), sets.NewString(ScopeAll), nil)
+
+	delegate := &mocks.OAuth2ResourceServer{}
+	delegate.OnValidateAccessToken(ctx, "aud", "a.b.c").Return(jwtIdentity, nil)
+	validator := &mocks.AccessTokenValidator{}
+	validator.OnValidateAccessToken(ctx, AccessTokenPrefix+"secret").Return(accessTokenIdentity, nil)
+	resourceServer := NewAccessTokenResourceServer(delegate, validator)
+
+	t.Run("jwt", func(t *testing.T) {
+		identity, err := resourceServer.ValidateAccessToken(ctx, "aud", "a.b.c")
+		assert.NoError(t, err)
+		assert.Equal(t, "jwt-user", identity.UserID())
+	})
+	t.Run("access token", func(t *testing.T) {
+		identity, err := resourceServer.ValidateAccessToken(ctx, "aud", AccessTokenPrefix+"secret")
+		assert.NoError(t, err)
+		assert.Equal(t, "token-user", identity.UserID())
+	})
+}
@@ -87,6 +87,9 @@ func authCallbackEndpoint(authCtx interfaces.AuthenticationContext, rw http.Resp
 
 	// Now that the user is authorized, we set up a session:
 	mySessionData := oauth2Provider.NewJWTSessionToken(identityContext.UserID(), ar.GetClient().GetID(), issuer, issuer, userInfo)
+	for claim, value := range auth.GetAuthorizationClaims(identityContext, authCtx.Options().Authorization.Claims) {
+		mySessionData.JWTClaims.Extra[claim] = value
+	}
 	mySessionData.JWTClaims.ExpiresAt = time.Now().Add(authCtx.Options().AppAuth.SelfAuthServer.AccessTokenLifespan.Duration)
 	mySessionData.SetExpiresAt(fosite.AuthorizeCode, time.Now().Add(authCtx.Options().AppAuth.SelfAuthServer.AuthorizationCodeLifespan.Duration))
 	mySessionData.SetExpiresAt(fosite.AccessToken, time.Now().Add(authCtx.Options().AppAuth.SelfAuthServer.AccessTokenLifespan.Duration))
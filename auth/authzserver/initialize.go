@@ -1,9 +1,8 @@
 package authzserver
 
 import (
-	"crypto/rsa"
-
 	"github.com/ory/fosite/handler/oauth2"
+	"github.com/ory/fosite/handler/openid"
 
 	"github.com/ory/fosite"
 
@@ -21,20 +20,21 @@ func RegisterHandlers(handler interfaces.HandlerRegisterer, authCtx interfaces.A
 		handler.HandleFunc(authorizeCallbackRelativeURL.String(), getAuthCallbackEndpoint(authCtx))
 		handler.HandleFunc(tokenRelativeURL.String(), getTokenEndpointHandler(authCtx))
 		handler.HandleFunc(jsonWebKeysURL.String(), GetJSONWebKeysEndpoint(authCtx))
+		if authCtx.Options().AppAuth.SelfAuthServer.EnableTokenRevocation {
+			handler.HandleFunc(revocationRelativeURL.String(), getRevocationEndpointHandler(authCtx))
+		}
 	}
 }
 
 // composeOAuth2Provider builds a fosite.OAuth2Provider that uses JWT for issuing access tokens and uses the provided
-// codeProvider to issue AuthCode and RefreshTokens.
+// codeProvider to issue AuthCode and RefreshTokens. ID tokens and other JWTs are signed with the jwtStrategy.
 func composeOAuth2Provider(codeProvider oauth2.CoreStrategy, config *compose.Config, storage fosite.Storage,
-	key *rsa.PrivateKey) fosite.OAuth2Provider {
+	jwtStrategy jwt.JWTStrategy) fosite.OAuth2Provider {
 
 	commonStrategy := &compose.CommonStrategy{
 		CoreStrategy:               codeProvider,
-		OpenIDConnectTokenStrategy: compose.NewOpenIDConnectStrategy(config, key),
-		JWTStrategy: &jwt.RS256JWTStrategy{
-			PrivateKey: key,
-		},
+		OpenIDConnectTokenStrategy: newOpenIDConnectStrategy(config, jwtStrategy),
+		JWTStrategy:                jwtStrategy,
 	}
 
 	return compose.Compose(
@@ -48,8 +48,19 @@ func composeOAuth2Provider(codeProvider oauth2.CoreStrategy, config *compose.Con
 		compose.OAuth2RefreshTokenGrantFactory,
 
 		compose.OAuth2StatelessJWTIntrospectionFactory,
-		//compose.OAuth2TokenRevocationFactory,
+		tokenRevocationFactory,
 
 		compose.OAuth2PKCEFactory,
 	)
 }
+
+// newOpenIDConnectStrategy mirrors compose.NewOpenIDConnectStrategy, which only accepts a static key, to sign ID tokens
+// with the jwtStrategy.
+func newOpenIDConnectStrategy(config *compose.Config, jwtStrategy jwt.JWTStrategy) *openid.DefaultStrategy {
+	return &openid.DefaultStrategy{
+		JWTStrategy:         jwtStrategy,
+		Expiry:              config.GetIDTokenLifespan(),
+		Issuer:              config.IDTokenIssuer,
+		MinParameterEntropy: config.GetMinParameterEntropy(),
+	}
+}
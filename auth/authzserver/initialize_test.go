@@ -1,15 +1,21 @@
 package authzserver
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/ory/fosite"
+	"github.com/ory/fosite/handler/openid"
 	"github.com/ory/fosite/storage"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/ory/fosite/compose"
 
 	"github.com/stretchr/testify/mock"
 
 	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/auth/config"
 	"github.com/flyteorg/flyteadmin/auth/interfaces/mocks"
 )
 
@@ -28,10 +34,46 @@ func TestRegisterHandlers(t *testing.T) {
 		authCtx := &mocks.AuthenticationContext{}
 		oauth2Provider := &mocks.OAuth2Provider{}
 		authCtx.OnOAuth2Provider().Return(oauth2Provider)
+		authCtx.OnOptions().Return(config.DefaultConfig)
 		RegisterHandlers(registerer, authCtx)
+		registerer.AssertNumberOfCalls(t, "HandleFunc", 4)
+	})
+
+	t.Run("Register the revocation endpoint", func(t *testing.T) {
+		registerer := &mocks.HandlerRegisterer{}
+		registerer.On("HandleFunc", mock.Anything, mock.Anything)
+		authCtx := &mocks.AuthenticationContext{}
+		oauth2Provider := &mocks.OAuth2Provider{}
+		authCtx.OnOAuth2Provider().Return(oauth2Provider)
+		cfg := *config.DefaultConfig
+		cfg.AppAuth.SelfAuthServer.EnableTokenRevocation = true
+		authCtx.OnOptions().Return(&cfg)
+		RegisterHandlers(registerer, authCtx)
+		registerer.AssertCalled(t, "HandleFunc", "/oauth2/revoke", mock.Anything)
 	})
 }
 
 func Test_composeOAuth2Provider(t *testing.T) {
 	composeOAuth2Provider(nil, &compose.Config{}, &storage.MemoryStore{}, nil)
 }
+
+func Test_newOpenIDConnectStrategy(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	ring, err := newKeyRing([]signingKey{newTestKey(t, now.Add(-time.Hour))}, 10*time.Minute)
+	assert.NoError(t, err)
+	jwtStrategy := keyRingJWTStrategy{ring: ring}
+	strategy := newOpenIDConnectStrategy(&compose.Config{}, jwtStrategy)
+
+	// ID tokens are signed with the active key of the key ring, including once the key ring rotated.
+	assert.NoError(t, ring.set([]signingKey{newTestKey(t, now.Add(-20*time.Minute)), ring.keys[0]}))
+	session := openid.NewDefaultSession()
+	session.Claims.Subject = "alice"
+	idToken, err := strategy.GenerateIDToken(ctx, fosite.NewAccessRequest(session))
+	assert.NoError(t, err)
+
+	parsedToken, err := jwtStrategy.Decode(ctx, idToken)
+	assert.NoError(t, err)
+	activeKey, _ := ring.KeySet().Get(0)
+	assert.Equal(t, activeKey.KeyID(), parsedToken.Header[KeyIDClaim])
+}
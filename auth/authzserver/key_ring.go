@@ -0,0 +1,400 @@
+package authzserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/ory/fosite"
+	"github.com/ory/fosite/token/jwt"
+	"github.com/ory/x/errorsx"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+const (
+	rsaPEMType           = "RSA PRIVATE KEY"
+	signingKeyLengthBits = 2048
+)
+
+type signingKey struct {
+	PrivateKey *rsa.PrivateKey
+	CreatedAt  time.Time
+}
+
+// The format of the keys in the key ring secret.
+type persistedSigningKey struct {
+	CreatedAt time.Time `json:"createdAt"`
+	// PEM encoded PKCS1 private key.
+	PrivateKey string `json:"privateKey"`
+}
+
+// keyRing holds the keys tokens are signed with, newest first. The keys are published through the JSON web keys
+// endpoint as soon as they're added but only sign tokens once they've been published for the activation delay, which
+// gives all replicas (and the clients caching the published keys) the time to load them.
+type keyRing struct {
+	lock            sync.RWMutex
+	keys            []signingKey
+	keySet          jwk.Set
+	activationDelay time.Duration
+}
+
+// activeKeyIndex returns the index of the newest key published for at least the activation delay, or of the oldest key
+// if none was.
+func activeKeyIndex(keys []signingKey, now time.Time, activationDelay time.Duration) int {
+	for i, key := range keys {
+		if now.Sub(key.CreatedAt) >= activationDelay {
+			return i
+		}
+	}
+
+	return len(keys) - 1
+}
+
+func (r *keyRing) set(keys []signingKey) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("a key ring requires at least one key")
+	}
+
+	publicKeys := make([]rsa.PublicKey, 0, len(keys))
+	for _, key := range keys {
+		publicKeys = append(publicKeys, key.PrivateKey.PublicKey)
+	}
+
+	keySet, err := newJSONWebKeySet(publicKeys)
+	if err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.keys = keys
+	r.keySet = keySet
+	return nil
+}
+
+// signingKey returns the key to sign new tokens with and its ID.
+func (r *keyRing) signingKey() (*rsa.PrivateKey, string) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	i := activeKeyIndex(r.keys, time.Now(), r.activationDelay)
+	key, _ := r.keySet.Get(i)
+	return r.keys[i].PrivateKey, key.KeyID()
+}
+
+func (r *keyRing) KeySet() jwk.Set {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.keySet
+}
+
+func (r *keyRing) PublicKeys() []rsa.PublicKey {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	publicKeys := make([]rsa.PublicKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		publicKeys = append(publicKeys, key.PrivateKey.PublicKey)
+	}
+
+	return publicKeys
+}
+
+func newKeyRing(keys []signingKey, activationDelay time.Duration) (*keyRing, error) {
+	r := &keyRing{activationDelay: activationDelay}
+	if err := r.set(keys); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func parsePrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode PEM block")
+	}
+
+	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PKCS1PrivateKey. Error: %w", err)
+	}
+
+	return privateKey, nil
+}
+
+func parseKeyRing(raw string) ([]signingKey, error) {
+	var persistedKeys []persistedSigningKey
+	if err := json.Unmarshal([]byte(raw), &persistedKeys); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal key ring. Error: %w", err)
+	}
+
+	keys := make([]signingKey, 0, len(persistedKeys))
+	for _, persistedKey := range persistedKeys {
+		privateKey, err := parsePrivateKey(persistedKey.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, signingKey{PrivateKey: privateKey, CreatedAt: persistedKey.CreatedAt})
+	}
+
+	return keys, nil
+}
+
+func marshalKeyRing(keys []signingKey) (string, error) {
+	persistedKeys := make([]persistedSigningKey, 0, len(keys))
+	for _, key := range keys {
+		privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: rsaPEMType, Bytes: x509.MarshalPKCS1PrivateKey(key.PrivateKey)})
+		persistedKeys = append(persistedKeys, persistedSigningKey{
+			CreatedAt:  key.CreatedAt,
+			PrivateKey: string(privateKeyPEM),
+		})
+	}
+
+	raw, err := json.Marshal(persistedKeys)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal key ring. Error: %w", err)
+	}
+
+	return string(raw), nil
+}
+
+// rotateKeys adds a new key to the keys once the newest is older than the rotation interval, and retires the keys the
+// tokens of which have all expired: a key stops signing tokens once its successor is activated, and the tokens it
+// signed expire within the maximum token lifespan. Returns whether the keys changed.
+func rotateKeys(keys []signingKey, now time.Time, rotationInterval, activationDelay, maxTokenLifespan time.Duration,
+	generate func() (*rsa.PrivateKey, error)) ([]signingKey, bool, error) {
+
+	changed := false
+	if len(keys) == 0 || now.Sub(keys[0].CreatedAt) >= rotationInterval {
+		privateKey, err := generate()
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to generate token signing key. Error: %w", err)
+		}
+
+		keys = append([]signingKey{{PrivateKey: privateKey, CreatedAt: now}}, keys...)
+		changed = true
+	}
+
+	for i := 1; i < len(keys); i++ {
+		if now.Sub(keys[i-1].CreatedAt) > activationDelay+maxTokenLifespan {
+			keys = keys[:i]
+			changed = true
+			break
+		}
+	}
+
+	return keys, changed, nil
+}
+
+func generateSigningKey() (*rsa.PrivateKey, error) {
+	return rsa.GenerateKey(rand.Reader, signingKeyLengthBits)
+}
+
+// keyRotator periodically reloads the key ring from the store all replicas share, picking up the keys other replicas
+// generated, and generates and retires keys as needed. The key ring is stored encrypted, and only when no other replica
+// stored it since it was loaded, so that a single replica's rotation applies when replicas rotate at once.
+type keyRotator struct {
+	ring             *keyRing
+	cfg              config.AuthorizationServer
+	secretManager    core.SecretManager
+	store            interfaces.SigningKeyStore
+	blockKey         [auth.SymmetricKeyLength]byte
+	maxTokenLifespan time.Duration
+	generate         func() (*rsa.PrivateKey, error)
+}
+
+// seedKeys returns the configured signing keys, which seed the key ring when none was stored yet.
+func (r keyRotator) seedKeys(ctx context.Context) ([]signingKey, error) {
+	privateKeyPEM, err := r.secretManager.Get(ctx, r.cfg.TokenSigningRSAKeySecretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token signing RSA Key. Error: %w", err)
+	}
+
+	privateKey, err := parsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	// The configured keys already sign tokens, so they're seeded as activated.
+	createdAt := time.Now().Add(-r.ring.activationDelay)
+	keys := []signingKey{{PrivateKey: privateKey, CreatedAt: createdAt}}
+
+	// The old key is optional.
+	privateKeyPEM, err = r.secretManager.Get(ctx, r.cfg.OldTokenSigningRSAKeySecretName)
+	if err == nil {
+		oldPrivateKey, err := parsePrivateKey(privateKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, signingKey{PrivateKey: oldPrivateKey, CreatedAt: createdAt})
+	}
+
+	return keys, nil
+}
+
+// loadKeys reads the key ring and its version from the store, seeding a new key ring with the configured signing keys
+// when none was stored yet, in which case the version is 0.
+func (r keyRotator) loadKeys(ctx context.Context) ([]signingKey, uint32, error) {
+	encrypted, version, err := r.store.GetSigningKeys(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read token signing key ring. Error: %w", err)
+	}
+
+	if version == 0 {
+		logger.Infof(ctx, "Seeding the token signing key ring with the configured signing keys")
+		keys, err := r.seedKeys(ctx)
+		return keys, 0, err
+	}
+
+	raw, err := decryptString(encrypted, r.blockKey)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to decrypt token signing key ring. Error: %w", err)
+	}
+
+	keys, err := parseKeyRing(raw)
+	return keys, version, err
+}
+
+func (r keyRotator) rotate(ctx context.Context) error {
+	keys, version, err := r.loadKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys, rotated, err := rotateKeys(keys, time.Now(), r.cfg.SigningKeyRotation.RotationInterval.Duration,
+		r.ring.activationDelay, r.maxTokenLifespan, r.generate)
+	if err != nil {
+		return err
+	}
+
+	if version == 0 || rotated {
+		raw, err := marshalKeyRing(keys)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := encryptString(raw, r.blockKey)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt token signing key ring. Error: %w", err)
+		}
+
+		stored, err := r.store.SetSigningKeys(ctx, encrypted, version)
+		if err != nil {
+			return fmt.Errorf("failed to store token signing key ring. Error: %w", err)
+		}
+
+		if !stored {
+			// Another replica stored the key ring first, its keys are used instead.
+			logger.Infof(ctx, "Loading the token signing key ring another replica rotated")
+			if keys, version, err = r.loadKeys(ctx); err != nil {
+				return err
+			} else if version == 0 {
+				return fmt.Errorf("token signing key ring was removed while rotating it")
+			}
+		} else {
+			logger.Infof(ctx, "Rotated the token signing key ring, which now holds [%v] keys", len(keys))
+		}
+	}
+
+	return r.ring.set(keys)
+}
+
+// StartRotating rotates the keys every check interval until the context is done.
+func (r keyRotator) StartRotating(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.SigningKeyRotation.CheckInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.rotate(ctx); err != nil {
+				logger.Errorf(ctx, "Failed to rotate token signing keys. Error: %v", err)
+			}
+		}
+	}
+}
+
+func newKeyRotator(cfg config.AuthorizationServer, sm core.SecretManager, store interfaces.SigningKeyStore,
+	blockKey [auth.SymmetricKeyLength]byte) keyRotator {
+	return keyRotator{
+		// Give the replicas two checks to load a new key before it signs tokens.
+		ring:          &keyRing{activationDelay: 2 * cfg.SigningKeyRotation.CheckInterval.Duration},
+		cfg:           cfg,
+		secretManager: sm,
+		store:         store,
+		blockKey:      blockKey,
+		maxTokenLifespan: maxDuration(cfg.AccessTokenLifespan.Duration, cfg.RefreshTokenLifespan.Duration,
+			cfg.AuthorizationCodeLifespan.Duration),
+		generate: generateSigningKey,
+	}
+}
+
+// keyRingJWTStrategy signs tokens with the active key of the key ring and validates them with the key they were signed
+// with, identified by their KeyIDClaim header.
+type keyRingJWTStrategy struct {
+	ring *keyRing
+}
+
+func (s keyRingJWTStrategy) Generate(ctx context.Context, claims jwtgo.Claims, header jwt.Mapper) (string, string, error) {
+	privateKey, keyID := s.ring.signingKey()
+	if header != nil {
+		header.Add(KeyIDClaim, keyID)
+	}
+
+	return (&jwt.RS256JWTStrategy{PrivateKey: privateKey}).Generate(ctx, claims, header)
+}
+
+func (s keyRingJWTStrategy) Validate(ctx context.Context, token string) (string, error) {
+	if _, err := s.Decode(ctx, token); err != nil {
+		return "", errorsx.WithStack(err)
+	}
+
+	return s.GetSignature(ctx, token)
+}
+
+func (s keyRingJWTStrategy) Decode(ctx context.Context, token string) (*jwtgo.Token, error) {
+	parsedToken, err := jwtgo.Parse(token, func(t *jwtgo.Token) (interface{}, error) {
+		return findPublicKeyForTokenOrFirst(ctx, t, s.ring.KeySet())
+	})
+
+	if err != nil {
+		return parsedToken, errorsx.WithStack(err)
+	} else if !parsedToken.Valid {
+		return parsedToken, errorsx.WithStack(fosite.ErrInactiveToken)
+	}
+
+	return parsedToken, nil
+}
+
+func (s keyRingJWTStrategy) GetSignature(_ context.Context, token string) (string, error) {
+	split := strings.Split(token, ".")
+	if len(split) != 3 {
+		return "", fmt.Errorf("header, body and signature must all be set")
+	}
+
+	return split[2], nil
+}
+
+func (s keyRingJWTStrategy) Hash(ctx context.Context, in []byte) ([]byte, error) {
+	return (&jwt.RS256JWTStrategy{}).Hash(ctx, in)
+}
+
+func (s keyRingJWTStrategy) GetSigningMethodLength() int {
+	return (&jwt.RS256JWTStrategy{}).GetSigningMethodLength()
+}
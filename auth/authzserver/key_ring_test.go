@@ -0,0 +1,270 @@
+package authzserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"github.com/ory/fosite/token/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/auth/config"
+	authMocks "github.com/flyteorg/flyteadmin/auth/interfaces/mocks"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+)
+
+func generateTestKey() (*rsa.PrivateKey, error) {
+	return rsa.GenerateKey(rand.Reader, 1024)
+}
+
+func newTestKey(t *testing.T, createdAt time.Time) signingKey {
+	privateKey, err := generateTestKey()
+	assert.NoError(t, err)
+	return signingKey{PrivateKey: privateKey, CreatedAt: createdAt}
+}
+
+func TestActiveKeyIndex(t *testing.T) {
+	now := time.Now()
+	keys := []signingKey{
+		{CreatedAt: now.Add(-time.Minute)},
+		{CreatedAt: now.Add(-time.Hour)},
+		{CreatedAt: now.Add(-2 * time.Hour)},
+	}
+
+	assert.Equal(t, 0, activeKeyIndex(keys, now, 0))
+	assert.Equal(t, 1, activeKeyIndex(keys, now, 10*time.Minute))
+	assert.Equal(t, 2, activeKeyIndex(keys, now, 3*time.Hour))
+}
+
+func TestRotateKeys(t *testing.T) {
+	now := time.Now()
+	rotationInterval := 24 * time.Hour
+	activationDelay := 10 * time.Minute
+	maxTokenLifespan := time.Hour
+
+	t.Run("Generate the first key", func(t *testing.T) {
+		keys, changed, err := rotateKeys(nil, now, rotationInterval, activationDelay, maxTokenLifespan, generateTestKey)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Len(t, keys, 1)
+		assert.Equal(t, now, keys[0].CreatedAt)
+	})
+
+	t.Run("Keep recent keys", func(t *testing.T) {
+		keys := []signingKey{newTestKey(t, now.Add(-time.Hour))}
+		rotated, changed, err := rotateKeys(keys, now, rotationInterval, activationDelay, maxTokenLifespan, generateTestKey)
+		assert.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, keys, rotated)
+	})
+
+	t.Run("Generate a key once the newest is older than the rotation interval", func(t *testing.T) {
+		keys := []signingKey{newTestKey(t, now.Add(-rotationInterval))}
+		rotated, changed, err := rotateKeys(keys, now, rotationInterval, activationDelay, maxTokenLifespan, generateTestKey)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Len(t, rotated, 2)
+		assert.Equal(t, now, rotated[0].CreatedAt)
+		assert.Equal(t, keys[0], rotated[1])
+	})
+
+	t.Run("Retire keys once the tokens they signed expired", func(t *testing.T) {
+		keys := []signingKey{
+			newTestKey(t, now.Add(-time.Hour)),
+			newTestKey(t, now.Add(-2*time.Hour)),
+			newTestKey(t, now.Add(-26*time.Hour)),
+		}
+
+		rotated, changed, err := rotateKeys(keys, now, rotationInterval, activationDelay, maxTokenLifespan, generateTestKey)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, keys[:2], rotated)
+
+		rotated, changed, err = rotateKeys(keys[:2], now.Add(11*time.Minute), rotationInterval, activationDelay,
+			maxTokenLifespan, generateTestKey)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, keys[:1], rotated)
+	})
+
+	t.Run("Failed to generate a key", func(t *testing.T) {
+		_, _, err := rotateKeys(nil, now, rotationInterval, activationDelay, maxTokenLifespan, func() (*rsa.PrivateKey, error) {
+			return nil, fmt.Errorf("failed")
+		})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestKeyRing_Marshal(t *testing.T) {
+	keys := []signingKey{newTestKey(t, time.Now().UTC().Truncate(time.Second))}
+	raw, err := marshalKeyRing(keys)
+	assert.NoError(t, err)
+
+	parsed, err := parseKeyRing(raw)
+	assert.NoError(t, err)
+	assert.Len(t, parsed, 1)
+	assert.True(t, keys[0].CreatedAt.Equal(parsed[0].CreatedAt))
+	assert.True(t, keys[0].PrivateKey.Equal(parsed[0].PrivateKey))
+
+	_, err = parseKeyRing("not json")
+	assert.Error(t, err)
+}
+
+func newTestKeyRotator(t *testing.T, sm *mocks.SecretManager, store *authMocks.SigningKeyStore) keyRotator {
+	cfg := config.DefaultConfig.AppAuth.SelfAuthServer
+	cfg.SigningKeyRotation.Enabled = true
+	blockKey := [auth.SymmetricKeyLength]byte{}
+	_, err := rand.Read(blockKey[:])
+	assert.NoError(t, err)
+	rotator := newKeyRotator(cfg, sm, store, blockKey)
+	rotator.generate = generateTestKey
+	return rotator
+}
+
+func encryptTestKeyRing(t *testing.T, rotator keyRotator, keys []signingKey) string {
+	raw, err := marshalKeyRing(keys)
+	assert.NoError(t, err)
+	encrypted, err := encryptString(raw, rotator.blockKey)
+	assert.NoError(t, err)
+	return encrypted
+}
+
+func TestKeyRotator_rotate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Seed the key ring", func(t *testing.T) {
+		seedKey := newTestKey(t, time.Time{})
+		seedKeyPEM := pem.EncodeToMemory(&pem.Block{Type: rsaPEMType, Bytes: x509.MarshalPKCS1PrivateKey(seedKey.PrivateKey)})
+
+		sm := &mocks.SecretManager{}
+		sm.OnGet(ctx, config.SecretNameTokenSigningRSAKey).Return(string(seedKeyPEM), nil)
+		sm.OnGet(ctx, config.SecretNameOldTokenSigningRSAKey).Return("", fmt.Errorf("not found"))
+
+		store := &authMocks.SigningKeyStore{}
+		store.OnGetSigningKeys(ctx).Return("", 0, nil)
+		stored := ""
+		store.OnSetSigningKeys(ctx, mock.Anything, uint32(0)).Run(func(args mock.Arguments) {
+			stored = args.String(1)
+		}).Return(true, nil)
+
+		rotator := newTestKeyRotator(t, sm, store)
+		assert.NoError(t, rotator.rotate(ctx))
+
+		// The key ring is stored encrypted.
+		_, err := parseKeyRing(stored)
+		assert.Error(t, err)
+		raw, err := decryptString(stored, rotator.blockKey)
+		assert.NoError(t, err)
+		storedKeys, err := parseKeyRing(raw)
+		assert.NoError(t, err)
+		assert.Len(t, storedKeys, 1)
+		assert.True(t, seedKey.PrivateKey.Equal(storedKeys[0].PrivateKey))
+
+		// The seeded key signs tokens right away.
+		privateKey, _ := rotator.ring.signingKey()
+		assert.True(t, seedKey.PrivateKey.Equal(privateKey))
+	})
+
+	t.Run("Load the key ring", func(t *testing.T) {
+		store := &authMocks.SigningKeyStore{}
+		rotator := newTestKeyRotator(t, &mocks.SecretManager{}, store)
+		store.OnGetSigningKeys(ctx).Return(
+			encryptTestKeyRing(t, rotator, []signingKey{newTestKey(t, time.Now().Add(-time.Hour))}), 3, nil)
+
+		assert.NoError(t, rotator.rotate(ctx))
+		store.AssertNotCalled(t, "SetSigningKeys", mock.Anything, mock.Anything, mock.Anything)
+		assert.Equal(t, 1, rotator.ring.KeySet().Len())
+	})
+
+	t.Run("Failed to read the key ring", func(t *testing.T) {
+		store := &authMocks.SigningKeyStore{}
+		store.OnGetSigningKeys(ctx).Return("", 0, fmt.Errorf("connection refused"))
+
+		// The key ring isn't re-seeded, which would replace the rotated keys.
+		rotator := newTestKeyRotator(t, &mocks.SecretManager{}, store)
+		assert.Error(t, rotator.rotate(ctx))
+		store.AssertNotCalled(t, "SetSigningKeys", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Another replica rotated the key ring first", func(t *testing.T) {
+		store := &authMocks.SigningKeyStore{}
+		rotator := newTestKeyRotator(t, &mocks.SecretManager{}, store)
+		expiringKey := newTestKey(t, time.Now().Add(-rotator.cfg.SigningKeyRotation.RotationInterval.Duration))
+		otherReplicaKey := newTestKey(t, time.Now())
+		store.OnGetSigningKeys(ctx).Return(encryptTestKeyRing(t, rotator, []signingKey{expiringKey}), 3, nil).Once()
+		store.OnGetSigningKeys(ctx).Return(
+			encryptTestKeyRing(t, rotator, []signingKey{otherReplicaKey, expiringKey}), 4, nil).Once()
+		store.OnSetSigningKeys(ctx, mock.Anything, uint32(3)).Return(false, nil)
+
+		assert.NoError(t, rotator.rotate(ctx))
+		assert.Equal(t, []rsa.PublicKey{otherReplicaKey.PrivateKey.PublicKey, expiringKey.PrivateKey.PublicKey},
+			rotator.ring.PublicKeys())
+	})
+
+	t.Run("Failed to store the key ring", func(t *testing.T) {
+		store := &authMocks.SigningKeyStore{}
+		rotator := newTestKeyRotator(t, &mocks.SecretManager{}, store)
+		store.OnGetSigningKeys(ctx).Return(encryptTestKeyRing(t, rotator, []signingKey{
+			newTestKey(t, time.Now().Add(-rotator.cfg.SigningKeyRotation.RotationInterval.Duration)),
+		}), 3, nil)
+		store.OnSetSigningKeysMatch(mock.Anything, mock.Anything, mock.Anything).Return(false, fmt.Errorf("read only"))
+
+		assert.Error(t, rotator.rotate(ctx))
+	})
+}
+
+func TestNewSigningKeyRing_RequiresStore(t *testing.T) {
+	cfg := config.DefaultConfig.AppAuth.SelfAuthServer
+	cfg.SigningKeyRotation.Enabled = true
+	_, err := newSigningKeyRing(context.Background(), cfg, &mocks.SecretManager{}, nil, [auth.SymmetricKeyLength]byte{})
+	assert.Error(t, err)
+}
+
+func TestKeyRingJWTStrategy(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	oldKey := newTestKey(t, now.Add(-time.Hour))
+	ring, err := newKeyRing([]signingKey{oldKey}, 10*time.Minute)
+	assert.NoError(t, err)
+
+	strategy := keyRingJWTStrategy{ring: ring}
+	oldToken, _, err := strategy.Generate(ctx, jwtgo.MapClaims{"sub": "old"}, &jwt.Headers{})
+	assert.NoError(t, err)
+
+	// A new key is published but doesn't sign tokens until activated.
+	newKey := newTestKey(t, now)
+	assert.NoError(t, ring.set([]signingKey{newKey, oldKey}))
+	token, _, err := strategy.Generate(ctx, jwtgo.MapClaims{"sub": "pending"}, &jwt.Headers{})
+	assert.NoError(t, err)
+	parsedToken, err := strategy.Decode(ctx, token)
+	assert.NoError(t, err)
+	oldKeyID, _ := ring.KeySet().Get(1)
+	assert.Equal(t, oldKeyID.KeyID(), parsedToken.Header[KeyIDClaim])
+
+	newKey.CreatedAt = now.Add(-time.Hour)
+	oldKey.CreatedAt = now.Add(-2 * time.Hour)
+	assert.NoError(t, ring.set([]signingKey{newKey, oldKey}))
+	token, _, err = strategy.Generate(ctx, jwtgo.MapClaims{"sub": "new"}, &jwt.Headers{})
+	assert.NoError(t, err)
+	parsedToken, err = strategy.Decode(ctx, token)
+	assert.NoError(t, err)
+	newKeyID, _ := ring.KeySet().Get(0)
+	assert.Equal(t, newKeyID.KeyID(), parsedToken.Header[KeyIDClaim])
+
+	// Tokens signed by previous keys are valid until the keys are retired.
+	_, err = strategy.Validate(ctx, oldToken)
+	assert.NoError(t, err)
+
+	assert.NoError(t, ring.set([]signingKey{newKey}))
+	_, err = strategy.Validate(ctx, oldToken)
+	assert.Error(t, err)
+}
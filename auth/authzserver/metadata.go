@@ -22,6 +22,7 @@ var (
 	authorizeRelativeURL         = config.MustParseURL("/oauth2/authorize")
 	authorizeCallbackRelativeURL = config.MustParseURL("/oauth2/authorize_callback")
 	jsonWebKeysURL               = config.MustParseURL("/oauth2/jwks")
+	revocationRelativeURL        = config.MustParseURL("/oauth2/revoke")
 	oauth2MetadataEndpoint       = config.MustParseURL(auth.OAuth2MetadataEndpoint)
 )
 
@@ -40,7 +40,7 @@ func (s OAuth2MetadataProvider) GetOAuth2Metadata(ctx context.Context, r *servic
 				"code token",
 			},
 			GrantTypesSupported: supportedGrantTypes,
-			ScopesSupported:     []string{auth.ScopeAll},
+			ScopesSupported:     append([]string{auth.ScopeAll}, auth.FineGrainedScopes...),
 			TokenEndpointAuthMethodsSupported: []string{
 				"client_secret_basic",
 			},
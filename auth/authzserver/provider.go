@@ -3,11 +3,10 @@ package authzserver
 import (
 	"context"
 	"crypto/rsa"
-	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
-	"encoding/pem"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
@@ -40,31 +39,30 @@ const (
 	UserIDClaim   = "user_info"
 	ScopeClaim    = "scp"
 	KeyIDClaim    = "key_id"
+	GrantIDClaim  = "grant_id"
+	TokenIDClaim  = "jti"
 )
 
 // Provider implements OAuth2 Authorization Server.
 type Provider struct {
 	fosite.OAuth2Provider
-	cfg       config.AuthorizationServer
-	publicKey []rsa.PublicKey
-	keySet    jwk.Set
+	cfg     config.AuthorizationServer
+	keyRing *keyRing
+	// Set when token revocation is enabled.
+	revocationStore interfaces.TokenRevocationStore
 }
 
 func (p Provider) PublicKeys() []rsa.PublicKey {
-	return p.publicKey
+	return p.keyRing.PublicKeys()
 }
 
 func (p Provider) KeySet() jwk.Set {
-	return p.keySet
+	return p.keyRing.KeySet()
 }
 
 // NewJWTSessionToken is a helper function for creating a new session.
 func (p Provider) NewJWTSessionToken(subject, appID, issuer, audience string, userInfoClaims *service.UserInfoResponse) *fositeOAuth2.JWTSession {
-	key, found := p.keySet.Get(0)
-	keyID := ""
-	if found {
-		keyID = key.KeyID()
-	}
+	_, keyID := p.keyRing.signingKey()
 
 	return &fositeOAuth2.JWTSession{
 		JWTClaims: &jwt.JWTClaims{
@@ -128,9 +126,42 @@ func (p Provider) ValidateAccessToken(ctx context.Context, expectedAudience, tok
 	}
 
 	claimsRaw := parsedToken.Claims.(jwtgo.MapClaims)
+	if err = p.verifyNotRevoked(ctx, claimsRaw); err != nil {
+		return nil, err
+	}
+
 	return verifyClaims(sets.NewString(expectedAudience), claimsRaw)
 }
 
+// verifyNotRevoked checks neither the token nor the grant it was issued for has been revoked.
+func (p Provider) verifyNotRevoked(ctx context.Context, claimsRaw map[string]interface{}) error {
+	if p.revocationStore == nil {
+		return nil
+	}
+
+	ids := make([]string, 0, 2)
+	for _, claim := range []string{TokenIDClaim, GrantIDClaim} {
+		if id, casted := claimsRaw[claim].(string); casted && len(id) > 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	revoked, err := p.revocationStore.IsTokenRevoked(ctx, ids...)
+	if err != nil {
+		return fmt.Errorf("failed to check whether the token has been revoked. Error: %w", err)
+	}
+
+	if revoked {
+		return fmt.Errorf("token has been revoked")
+	}
+
+	return nil
+}
+
 func verifyClaims(expectedAudience sets.String, claimsRaw map[string]interface{}) (interfaces.IdentityContext, error) {
 	claims := jwtx.ParseMapStringInterfaceClaims(claimsRaw)
 	if len(claims.Audience) != 1 {
@@ -170,15 +201,36 @@ func verifyClaims(expectedAudience sets.String, claimsRaw map[string]interface{}
 		scopes.Insert(auth.ScopeAll)
 	}
 
-	return auth.NewIdentityContext(claims.Audience[0], claims.Subject, clientID, claims.IssuedAt, scopes, userInfo), nil
+	return auth.NewIdentityContext(claims.Audience[0], claims.Subject, clientID, claims.IssuedAt, scopes, userInfo).
+		WithClaims(claimsRaw), nil
+}
+
+// scopeStrategy matches the scopes requested by clients against those they're allowed like fosite's default wildcard
+// strategy, and additionally lets clients allowed the `all` scope request any of the fine-grained scopes it covers.
+func scopeStrategy(allowed []string, requested string) bool {
+	requested = strings.TrimPrefix(requested, requestedScopePrefix)
+	if fosite.WildcardScopeStrategy(allowed, requested) {
+		return true
+	}
+
+	for _, scope := range auth.FineGrainedScopes {
+		if scope == requested {
+			return fosite.WildcardScopeStrategy(allowed, auth.ScopeAll)
+		}
+	}
+
+	return false
 }
 
 // NewProvider creates a new OAuth2 Provider that is able to do OAuth 2-legged and 3-legged flows. It'll lookup
 // config.SecretNameClaimSymmetricKey and config.SecretNameTokenSigningRSAKey secrets from the secret manager to use to
 // sign and generate hashes for tokens. The RSA Private key is expected to be in PEM format with the public key embedded.
 // Use auth.GetInitSecretsCommand() to generate new valid secrets that will be accepted by this provider.
-// The config.SecretNameClaimSymmetricKey must be a 32-bytes long key in Base64Encoding.
-func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.SecretManager) (Provider, error) {
+// The config.SecretNameClaimSymmetricKey must be a 32-bytes long key in Base64Encoding. When cfg.SigningKeyRotation is
+// enabled, the signing keys are rotated and shared with the other replicas through the signingKeyStore, which is then
+// required. Revoked tokens are remembered in the revocationStore when cfg.EnableTokenRevocation is set.
+func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.SecretManager,
+	signingKeyStore interfaces.SigningKeyStore, revocationStore interfaces.TokenRevocationStore) (Provider, error) {
 	// fosite requires four parameters for the server to get up and running:
 	// 1. config - for any enforcement you may desire, you can do this using `compose.Config`. You like PKCE, enforce it!
 	// 2. store - no auth service is generally useful unless it can remember clients and users.
@@ -191,6 +243,7 @@ func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.Se
 		RefreshTokenLifespan:  cfg.RefreshTokenLifespan.Duration,
 		AuthorizeCodeLifespan: cfg.AuthorizationCodeLifespan.Duration,
 		RefreshTokenScopes:    []string{refreshTokenScope},
+		ScopeStrategy:         scopeStrategy,
 	}
 
 	// This secret is used to encryptString/decrypt challenge code to maintain a stateless authcode token.
@@ -204,18 +257,17 @@ func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.Se
 		return Provider{}, fmt.Errorf("failed to decode token hash using base64 encoding. Error: %w", err)
 	}
 
-	// privateKey is used to sign JWT tokens. The default strategy uses RS256 (RSA Signature with SHA-256)
-	privateKeyPEM, err := sm.Get(ctx, cfg.TokenSigningRSAKeySecretName)
-	if err != nil {
-		return Provider{}, fmt.Errorf("failed to read token signing RSA Key. Error: %w", err)
-	}
+	sec := [auth.SymmetricKeyLength]byte{}
+	copy(sec[:], secret)
 
-	block, _ := pem.Decode([]byte(privateKeyPEM))
-	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	// The keys of the key ring are used to sign JWT tokens. The default strategy uses RS256 (RSA Signature with SHA-256)
+	ring, err := newSigningKeyRing(ctx, cfg, sm, signingKeyStore, sec)
 	if err != nil {
-		return Provider{}, fmt.Errorf("failed to parse PKCS1PrivateKey. Error: %w", err)
+		return Provider{}, err
 	}
 
+	jwtStrategy := keyRingJWTStrategy{ring: ring}
+
 	// Build an in-memory store with static clients defined in Config. This gives us the potential to move the clients
 	// storage into DB and allow registration of new clients to users.
 	store := &StatelessTokenStore{
@@ -232,39 +284,79 @@ func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.Se
 		},
 	}
 
-	sec := [auth.SymmetricKeyLength]byte{}
-	copy(sec[:], secret)
-	codeProvider := NewStatelessCodeProvider(cfg, sec, compose.NewOAuth2JWTStrategy(privateKey, nil))
+	if cfg.EnableTokenRevocation {
+		store.revocationStore = revocationStore
+		store.revocationLifespan = maxDuration(cfg.AccessTokenLifespan.Duration, cfg.RefreshTokenLifespan.Duration,
+			cfg.AuthorizationCodeLifespan.Duration)
+	}
+
+	codeProvider := NewStatelessCodeProvider(cfg, sec, &fositeOAuth2.DefaultJWTStrategy{JWTStrategy: jwtStrategy})
 
 	// Build a fosite instance with all OAuth2 and OpenID Connect handlers enabled, plugging in our configurations as specified above.
-	oauth2Provider := composeOAuth2Provider(codeProvider, composeConfig, store, privateKey)
-	store.JWTStrategy = &jwt.RS256JWTStrategy{
-		PrivateKey: privateKey,
-	}
+	oauth2Provider := composeOAuth2Provider(codeProvider, composeConfig, store, jwtStrategy)
+	store.JWTStrategy = jwtStrategy
 	store.encryptor = codeProvider
 
-	publicKeys := []rsa.PublicKey{privateKey.PublicKey}
+	return Provider{
+		OAuth2Provider:  oauth2Provider,
+		keyRing:         ring,
+		revocationStore: store.revocationStore,
+	}, nil
+}
+
+// newSigningKeyRing loads the key ring from the store when signing keys are rotated automatically, and starts rotating
+// them until the context is done. Otherwise, the key ring holds the key of cfg.TokenSigningRSAKeySecretName followed,
+// when present, by the key of cfg.OldTokenSigningRSAKeySecretName to keep validating the tokens it signed.
+func newSigningKeyRing(ctx context.Context, cfg config.AuthorizationServer, sm core.SecretManager,
+	store interfaces.SigningKeyStore, blockKey [auth.SymmetricKeyLength]byte) (*keyRing, error) {
+	if cfg.SigningKeyRotation.Enabled {
+		// Replicas rotating keys on their own would each sign tokens with keys the others don't know.
+		if store == nil {
+			return nil, fmt.Errorf("signing key rotation requires a signing key store shared by all replicas")
+		}
+
+		rotator := newKeyRotator(cfg, sm, store, blockKey)
+		if err := rotator.rotate(ctx); err != nil {
+			return nil, fmt.Errorf("failed to load token signing key ring. Error: %w", err)
+		}
+
+		go rotator.StartRotating(ctx)
+		return rotator.ring, nil
+	}
+
+	privateKeyPEM, err := sm.Get(ctx, cfg.TokenSigningRSAKeySecretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token signing RSA Key. Error: %w", err)
+	}
+
+	privateKey, err := parsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []signingKey{{PrivateKey: privateKey}}
 
 	// Try to load old key to validate tokens using it to support key rotation.
 	privateKeyPEM, err = sm.Get(ctx, cfg.OldTokenSigningRSAKeySecretName)
 	if err == nil {
-		block, _ = pem.Decode([]byte(privateKeyPEM))
-		oldPrivateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		oldPrivateKey, err := parsePrivateKey(privateKeyPEM)
 		if err != nil {
-			return Provider{}, fmt.Errorf("failed to parse PKCS1PrivateKey. Error: %w", err)
+			return nil, err
 		}
 
-		publicKeys = append(publicKeys, oldPrivateKey.PublicKey)
+		keys = append(keys, signingKey{PrivateKey: oldPrivateKey})
 	}
 
-	keysSet, err := newJSONWebKeySet(publicKeys)
-	if err != nil {
-		return Provider{}, err
+	return newKeyRing(keys, 0)
+}
+
+func maxDuration(durations ...time.Duration) time.Duration {
+	var res time.Duration
+	for _, d := range durations {
+		if d > res {
+			res = d
+		}
 	}
 
-	return Provider{
-		OAuth2Provider: oauth2Provider,
-		publicKey:      publicKeys,
-		keySet:         keysSet,
-	}, nil
+	return res
 }
@@ -19,11 +19,17 @@ import (
 
 	"github.com/flyteorg/flyteadmin/auth"
 	"github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
 	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core/mocks"
 	"github.com/stretchr/testify/assert"
 )
 
 func newMockProvider(t testing.TB) (Provider, auth.SecretsSet) {
+	return newMockProviderWithConfig(t, config.DefaultConfig.AppAuth.SelfAuthServer, nil)
+}
+
+func newMockProviderWithConfig(t testing.TB, cfg config.AuthorizationServer,
+	revocationStore interfaces.TokenRevocationStore) (Provider, auth.SecretsSet) {
 	secrets, err := auth.NewSecrets()
 	assert.NoError(t, err)
 
@@ -39,7 +45,7 @@ func newMockProvider(t testing.TB) (Provider, auth.SecretsSet) {
 	sm.OnGet(ctx, config.SecretNameTokenSigningRSAKey).Return(buf.String(), nil)
 	sm.OnGet(ctx, config.SecretNameOldTokenSigningRSAKey).Return("", fmt.Errorf("not found"))
 
-	p, err := NewProvider(ctx, config.DefaultConfig.AppAuth.SelfAuthServer, sm)
+	p, err := NewProvider(ctx, cfg, sm, nil, revocationStore)
 	assert.NoError(t, err)
 	return p, secrets
 }
@@ -180,7 +186,7 @@ func TestProvider_ValidateAccessToken(t *testing.T) {
 		sm.OnGet(ctx, config.SecretNameTokenSigningRSAKey).Return(buf.String(), nil)
 		sm.OnGet(ctx, config.SecretNameOldTokenSigningRSAKey).Return("", fmt.Errorf("not found"))
 
-		p, err := NewProvider(ctx, config.DefaultConfig.AppAuth.SelfAuthServer, sm)
+		p, err := NewProvider(ctx, config.DefaultConfig.AppAuth.SelfAuthServer, sm, nil, nil)
 		assert.NoError(t, err)
 
 		// create a signer for rsa 256
@@ -235,5 +241,16 @@ func Test_verifyClaims(t *testing.T) {
 		assert.Equal(t, sets.NewString("all", "offline"), identityCtx.Scopes())
 		assert.Equal(t, "my-client", identityCtx.AppID())
 		assert.Equal(t, "123", identityCtx.UserID())
+		assert.Equal(t, "my-client", identityCtx.Claims()["client_id"])
 	})
 }
+
+func Test_scopeStrategy(t *testing.T) {
+	assert.True(t, scopeStrategy([]string{"all", "offline"}, "all"))
+	assert.True(t, scopeStrategy([]string{"all", "offline"}, "executions:read"))
+	assert.True(t, scopeStrategy([]string{"all", "offline"}, "f.executions:write"))
+	assert.True(t, scopeStrategy([]string{"executions:read"}, "executions:read"))
+	assert.False(t, scopeStrategy([]string{"executions:read"}, "executions:write"))
+	assert.False(t, scopeStrategy([]string{"executions:read"}, "all"))
+	assert.False(t, scopeStrategy([]string{"all"}, "unknown"))
+}
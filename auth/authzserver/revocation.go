@@ -0,0 +1,73 @@
+package authzserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ory/fosite"
+	"github.com/ory/fosite/compose"
+
+	"github.com/flyteorg/flytestdlib/logger"
+
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
+)
+
+// tokenRevocationHandler revokes access and refresh tokens issued by the StatelessTokenStore.
+// ref: https://tools.ietf.org/html/rfc7009
+type tokenRevocationHandler struct {
+	store *StatelessTokenStore
+}
+
+// RevokeToken revokes the token along with all the other tokens issued for the same grant, which RFC 7009 allows and
+// which prevents a refresh token from issuing new access tokens after either was revoked.
+func (h tokenRevocationHandler) RevokeToken(ctx context.Context, token string, _ fosite.TokenType, client fosite.Client) error {
+	request, err := h.store.rehydrateSession(ctx, token)
+	if err != nil {
+		// Invalid tokens do not cause an error response since the purpose of the request is already achieved.
+		logger.Infof(ctx, "Ignoring the revocation of an invalid token. Error: %v", err)
+		return nil
+	}
+
+	if request.GetClient().GetID() != client.GetID() {
+		return fosite.ErrUnauthorizedClient
+	}
+
+	if err = h.store.revoke(ctx, request.GetID()); err != nil {
+		return fosite.ErrTemporarilyUnavailable.WithWrap(err)
+	}
+
+	if err = h.store.revokeGrant(ctx, request); err != nil {
+		return fosite.ErrTemporarilyUnavailable.WithWrap(err)
+	}
+
+	return nil
+}
+
+// tokenRevocationFactory is a compose.Factory that builds a revocation handler when the storage is the
+// StatelessTokenStore with token revocation enabled.
+func tokenRevocationFactory(_ *compose.Config, storage interface{}, _ interface{}) interface{} {
+	store, casted := storage.(*StatelessTokenStore)
+	if !casted || store.revocationStore == nil {
+		return nil
+	}
+
+	return tokenRevocationHandler{store: store}
+}
+
+func getRevocationEndpointHandler(authCtx interfaces.AuthenticationContext) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		revocationEndpoint(authCtx, writer, request)
+	}
+}
+
+func revocationEndpoint(authCtx interfaces.AuthenticationContext, rw http.ResponseWriter, req *http.Request) {
+	oauth2Provider := authCtx.OAuth2Provider()
+
+	// This authenticates the client and iterates through the registered RevocationHandlers to revoke the token.
+	err := oauth2Provider.NewRevocationRequest(req.Context(), req)
+	if err != nil {
+		logger.Infof(req.Context(), "Error occurred in NewRevocationRequest: %+v", err)
+	}
+
+	oauth2Provider.WriteRevocationResponse(rw, err)
+}
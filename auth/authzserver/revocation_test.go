@@ -0,0 +1,198 @@
+package authzserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/auth/interfaces/mocks"
+)
+
+const testIssuer = "https://flyte.example.com"
+
+type inMemoryRevocationStore struct {
+	lock    sync.Mutex
+	revoked map[string]time.Time
+}
+
+func (s *inMemoryRevocationStore) RevokeToken(_ context.Context, id string, expiresAt time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.revoked[id] = expiresAt
+	return nil
+}
+
+func (s *inMemoryRevocationStore) IsTokenRevoked(_ context.Context, ids ...string) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, id := range ids {
+		if _, found := s.revoked[id]; found {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func newRevocationTestAuthCtx(t *testing.T) (*mocks.AuthenticationContext, string) {
+	cfg := *config.DefaultConfig
+	cfg.AppAuth.SelfAuthServer.EnableTokenRevocation = true
+	cfg.AppAuth.SelfAuthServer.Issuer = testIssuer
+	oauth2Provider, secrets := newMockProviderWithConfig(t, cfg.AppAuth.SelfAuthServer,
+		&inMemoryRevocationStore{revoked: map[string]time.Time{}})
+
+	authCtx := &mocks.AuthenticationContext{}
+	authCtx.OnOAuth2Provider().Return(oauth2Provider)
+	authCtx.OnOptions().Return(&cfg)
+
+	tok := jwtgo.New(jwtgo.GetSigningMethod("RS256"))
+	tok.Claims = jwtgo.MapClaims{
+		ClientIDClaim: "flytectl",
+		ScopeClaim:    []string{"access_token", "offline"},
+		TokenIDClaim:  "code-id",
+		GrantIDClaim:  "grant-id",
+	}
+
+	authCode, err := tok.SignedString(secrets.TokenSigningRSAPrivateKey)
+	assert.NoError(t, err)
+	return authCtx, authCode
+}
+
+func postForm(authCtx interfaces.AuthenticationContext, endpoint func(interfaces.AuthenticationContext,
+	http.ResponseWriter, *http.Request), payload url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/oauth2", bytes.NewReader([]byte(payload.Encode())))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rw := httptest.NewRecorder()
+	endpoint(authCtx, rw, req)
+	return rw
+}
+
+func requestTokens(t *testing.T, authCtx interfaces.AuthenticationContext, payload url.Values) (
+	accessToken, refreshToken string, ok bool) {
+	payload.Set("client_id", "flytectl")
+	rw := postForm(authCtx, tokenEndpoint, payload)
+	if rw.Code != http.StatusOK {
+		return "", "", false
+	}
+
+	m := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(rw.Body.Bytes(), &m))
+	return m["access_token"].(string), m["refresh_token"].(string), true
+}
+
+func exchangeAuthCode(t *testing.T, authCtx interfaces.AuthenticationContext, authCode string) (
+	accessToken, refreshToken string, ok bool) {
+	return requestTokens(t, authCtx, url.Values{
+		"code":       {authCode},
+		"grant_type": {"authorization_code"},
+		"scope":      {"all", "offline"},
+	})
+}
+
+func refreshTokens(t *testing.T, authCtx interfaces.AuthenticationContext, refreshToken string) (
+	newAccessToken, newRefreshToken string, ok bool) {
+	return requestTokens(t, authCtx, url.Values{
+		"refresh_token": {refreshToken},
+		"grant_type":    {"refresh_token"},
+	})
+}
+
+func isValid(authCtx interfaces.AuthenticationContext, accessToken string) bool {
+	_, err := authCtx.OAuth2Provider().ValidateAccessToken(context.Background(), testIssuer, accessToken)
+	return err == nil
+}
+
+func TestAuthorizationCodeReuse(t *testing.T) {
+	authCtx, authCode := newRevocationTestAuthCtx(t)
+	accessToken, refreshToken, ok := exchangeAuthCode(t, authCtx, authCode)
+	if !assert.True(t, ok) {
+		t.FailNow()
+	}
+
+	assert.True(t, isValid(authCtx, accessToken))
+
+	_, _, ok = exchangeAuthCode(t, authCtx, authCode)
+	assert.False(t, ok)
+	assert.False(t, isValid(authCtx, accessToken))
+	_, _, ok = refreshTokens(t, authCtx, refreshToken)
+	assert.False(t, ok)
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	authCtx, authCode := newRevocationTestAuthCtx(t)
+	_, refreshToken, ok := exchangeAuthCode(t, authCtx, authCode)
+	if !assert.True(t, ok) {
+		t.FailNow()
+	}
+
+	newAccessToken, newRefreshToken, ok := refreshTokens(t, authCtx, refreshToken)
+	if !assert.True(t, ok) {
+		t.FailNow()
+	}
+
+	assert.True(t, isValid(authCtx, newAccessToken))
+
+	// Reusing the rotated refresh token revokes all tokens of the grant.
+	_, _, ok = refreshTokens(t, authCtx, refreshToken)
+	assert.False(t, ok)
+	assert.False(t, isValid(authCtx, newAccessToken))
+	_, _, ok = refreshTokens(t, authCtx, newRefreshToken)
+	assert.False(t, ok)
+}
+
+func Test_revocationEndpoint(t *testing.T) {
+	t.Run("Revoke access token", func(t *testing.T) {
+		authCtx, authCode := newRevocationTestAuthCtx(t)
+		accessToken, refreshToken, ok := exchangeAuthCode(t, authCtx, authCode)
+		if !assert.True(t, ok) {
+			t.FailNow()
+		}
+
+		rw := postForm(authCtx, revocationEndpoint, url.Values{
+			"token":     {accessToken},
+			"client_id": {"flytectl"},
+		})
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.False(t, isValid(authCtx, accessToken))
+		_, _, ok = refreshTokens(t, authCtx, refreshToken)
+		assert.False(t, ok)
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		authCtx, _ := newRevocationTestAuthCtx(t)
+		rw := postForm(authCtx, revocationEndpoint, url.Values{
+			"token":     {"invalid"},
+			"client_id": {"flytectl"},
+		})
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	t.Run("Token of another client", func(t *testing.T) {
+		authCtx, authCode := newRevocationTestAuthCtx(t)
+		accessToken, _, ok := exchangeAuthCode(t, authCtx, authCode)
+		if !assert.True(t, ok) {
+			t.FailNow()
+		}
+
+		rw := postForm(authCtx, revocationEndpoint, url.Values{
+			"token":     {accessToken},
+			"client_id": {"flyte-cli"},
+		})
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.True(t, isValid(authCtx, accessToken))
+	})
+}
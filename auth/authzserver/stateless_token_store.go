@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/google/uuid"
 
 	"github.com/flyteorg/flyteadmin/auth/config"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -35,6 +37,10 @@ type StatelessTokenStore struct {
 	*storage.MemoryStore
 	jwt.JWTStrategy
 	encryptor Encryptor
+	// Remembers used authorization codes, rotated refresh tokens and revoked grants when token revocation is enabled.
+	revocationStore interfaces.TokenRevocationStore
+	// How long revocations are remembered for, which is the lifespan of the longest lived tokens.
+	revocationLifespan time.Duration
 }
 
 func (s StatelessTokenStore) rehydrateSession(ctx context.Context, token string) (request *fosite.Request, err error) {
@@ -61,6 +67,9 @@ func (s StatelessTokenStore) rehydrateSession(ctx context.Context, token string)
 		return nil, fmt.Errorf("expected *oauth22.JWTSession. Found %v", reflect.TypeOf(rawRequest.GetSession()))
 	}
 
+	// The request is identified by its token, which fosite revokes once the authorization code or refresh token is used.
+	rawRequest.ID = jwtSession.GetJWTClaims().(*jwt.JWTClaims).JTI
+
 	formPostClaimValue, found := jwtSession.JWTClaims.Extra[encryptedFormPostClaim]
 	if found {
 		formPostParams, casted := formPostClaimValue.(map[string]interface{})
@@ -83,8 +92,50 @@ func (s StatelessTokenStore) rehydrateSession(ctx context.Context, token string)
 	return rawRequest, nil
 }
 
-func (s StatelessTokenStore) InvalidateAuthorizeCodeSession(_ context.Context, _ string) (err error) {
-	return nil
+// getGrantID returns the ID shared by the authorization code and all tokens issued from it.
+func getGrantID(request fosite.Requester) string {
+	jwtSession, casted := request.GetSession().(*oauth22.JWTSession)
+	if !casted {
+		return ""
+	}
+
+	grantID, _ := jwtSession.GetJWTClaims().(*jwt.JWTClaims).Extra[GrantIDClaim].(string)
+	return grantID
+}
+
+// Returns whether the token or grant of the ID is revoked. Nothing is revoked when token revocation is disabled.
+func (s StatelessTokenStore) isRevoked(ctx context.Context, id string) (bool, error) {
+	if s.revocationStore == nil || len(id) == 0 {
+		return false, nil
+	}
+
+	return s.revocationStore.IsTokenRevoked(ctx, id)
+}
+
+func (s StatelessTokenStore) revoke(ctx context.Context, id string) error {
+	if s.revocationStore == nil || len(id) == 0 {
+		return nil
+	}
+
+	return s.revocationStore.RevokeToken(ctx, id, time.Now().Add(s.revocationLifespan))
+}
+
+// revokeGrant revokes all tokens issued for the grant of a request.
+func (s StatelessTokenStore) revokeGrant(ctx context.Context, request fosite.Requester) error {
+	return s.revoke(ctx, getGrantID(request))
+}
+
+func (s StatelessTokenStore) InvalidateAuthorizeCodeSession(ctx context.Context, code string) (err error) {
+	if s.revocationStore == nil {
+		return nil
+	}
+
+	request, err := s.rehydrateSession(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	return s.revoke(ctx, request.GetID())
 }
 
 func (s StatelessTokenStore) GetAuthorizeCodeSession(ctx context.Context, code string, _ fosite.Session) (fosite.Requester, error) {
@@ -97,6 +148,17 @@ func (s StatelessTokenStore) GetAuthorizeCodeSession(ctx context.Context, code s
 		return nil, fmt.Errorf("authcode not found [%v]", code)
 	}
 
+	if revoked, err := s.isRevoked(ctx, request.GetID()); err != nil {
+		return nil, err
+	} else if revoked {
+		// The code was already exchanged. It may have been stolen, so the tokens issued for it are revoked.
+		if err = s.revokeGrant(ctx, request); err != nil {
+			return nil, err
+		}
+
+		return request, fosite.ErrInvalidatedAuthorizeCode
+	}
+
 	requestedScopes := request.RequestedScope
 	request.RequestedScope = fosite.Arguments{}
 	for _, requestedScope := range requestedScopes {
@@ -135,6 +197,20 @@ func (s StatelessTokenStore) GetRefreshTokenSession(ctx context.Context, signatu
 		return nil, err
 	}
 
+	if revoked, err := s.isRevoked(ctx, getGrantID(rawRequest)); err != nil {
+		return nil, err
+	} else if revoked {
+		return nil, fosite.ErrNotFound
+	}
+
+	// Refresh tokens are revoked once used. Fosite handles the reuse of a rotated refresh token, which may have been
+	// stolen, by deleting its session which revokes all tokens of its grant (RFC 6819 section 5.2.2.3).
+	if revoked, err := s.isRevoked(ctx, rawRequest.GetID()); err != nil {
+		return nil, err
+	} else if revoked {
+		return rawRequest, fosite.ErrInactiveToken
+	}
+
 	requestedScopes := rawRequest.GrantedScope
 	rawRequest.GrantedScope = fosite.Arguments{}
 	rawRequest.RequestedScope = fosite.Arguments{}
@@ -146,8 +222,27 @@ func (s StatelessTokenStore) GetRefreshTokenSession(ctx context.Context, signatu
 	return rawRequest, nil
 }
 
-func (s StatelessTokenStore) DeleteRefreshTokenSession(_ context.Context, _ string) (err error) {
-	return nil
+func (s StatelessTokenStore) DeleteRefreshTokenSession(ctx context.Context, signature string) (err error) {
+	if s.revocationStore == nil {
+		return nil
+	}
+
+	request, err := s.rehydrateSession(ctx, signature)
+	if err != nil {
+		return err
+	}
+
+	return s.revokeGrant(ctx, request)
+}
+
+// RevokeRefreshToken revokes the refresh token identified by the request ID, which fosite calls as it rotates the
+// token.
+func (s StatelessTokenStore) RevokeRefreshToken(ctx context.Context, requestID string) error {
+	return s.revoke(ctx, requestID)
+}
+
+func (s StatelessTokenStore) RevokeAccessToken(ctx context.Context, requestID string) error {
+	return s.revoke(ctx, requestID)
 }
 
 // StatelessCodeProvider offers a strategy that encodes authorization code and refresh tokens into JWT
@@ -167,7 +262,32 @@ func (p StatelessCodeProvider) AuthorizeCodeSignature(token string) string {
 	return token
 }
 
+// assignTokenIDs assigns the token about to be issued a unique ID, and its grant one if it's the first token of the
+// grant. Tokens issued from an authorization code or refreshed from a refresh token keep the ID of its grant.
+func assignTokenIDs(requester fosite.Requester) error {
+	jwtSession, casted := requester.GetSession().(*oauth22.JWTSession)
+	if !casted {
+		return fmt.Errorf("expected *oauth22.JWTSession. Found [%v]", reflect.TypeOf(requester.GetSession()))
+	}
+
+	claims := jwtSession.GetJWTClaims().(*jwt.JWTClaims)
+	if claims.Extra == nil {
+		claims.Extra = map[string]interface{}{}
+	}
+
+	if _, found := claims.Extra[GrantIDClaim]; !found {
+		claims.Extra[GrantIDClaim] = uuid.New().String()
+	}
+
+	claims.JTI = uuid.New().String()
+	return nil
+}
+
 func (p StatelessCodeProvider) GenerateAccessToken(ctx context.Context, requester fosite.Requester) (token string, signature string, err error) {
+	if err = assignTokenIDs(requester); err != nil {
+		return "", "", err
+	}
+
 	requester.GetSession().SetExpiresAt(fosite.AccessToken, time.Now().Add(p.accessTokenLifespan))
 	return p.CoreStrategy.GenerateAccessToken(ctx, requester)
 }
@@ -191,6 +311,10 @@ func (p StatelessCodeProvider) GenerateAuthorizeCode(ctx context.Context, reques
 		return "", "", fmt.Errorf("expected *oauth22.JWTSession. Found [%v]", reflect.TypeOf(rawRequest.Session))
 	}
 
+	if err = assignTokenIDs(requester); err != nil {
+		return "", "", err
+	}
+
 	m := make(map[string]interface{}, len(requester.GetRequestForm()))
 
 	for key, val := range requester.GetRequestForm() {
@@ -240,6 +364,10 @@ func (p StatelessCodeProvider) GenerateRefreshToken(ctx context.Context, request
 		return "", "", fmt.Errorf("expected *fosite.AccessRequest. Found [%v]", reflect.TypeOf(requester))
 	}
 
+	if err = assignTokenIDs(requester); err != nil {
+		return "", "", err
+	}
+
 	grantedScopes := requester.GetGrantedScopes()
 	rawRequest.GrantedScope = fosite.Arguments{}
 
@@ -83,6 +83,10 @@ var (
 				ClaimSymmetricEncryptionKeySecretName: SecretNameClaimSymmetricKey,
 				TokenSigningRSAKeySecretName:          SecretNameTokenSigningRSAKey,
 				OldTokenSigningRSAKeySecretName:       SecretNameOldTokenSigningRSAKey,
+				SigningKeyRotation: SigningKeyRotation{
+					RotationInterval: config.Duration{Duration: 30 * 24 * time.Hour},
+					CheckInterval:    config.Duration{Duration: 5 * time.Minute},
+				},
 				StaticClients: map[string]*fosite.DefaultClient{
 					"flyte-cli": {
 						ID:            "flyte-cli",
@@ -111,6 +115,12 @@ var (
 				},
 			},
 		},
+		Authorization: AuthorizationConfig{
+			Claims: ClaimsConfig{
+				Groups: "groups",
+				Email:  "email",
+			},
+		},
 	}
 
 	cfgSection = config.MustRegisterSection("auth", DefaultConfig)
@@ -146,6 +156,48 @@ type Config struct {
 
 	// AppAuth settings used to authenticate and control/limit access scopes for apps.
 	AppAuth OAuth2Options `json:"appAuth" pflag:",Defines Auth options for apps. UserAuth must be enabled for AppAuth to work."`
+
+	// Authorization settings used to control the access of authenticated users and apps to projects and domains.
+	Authorization AuthorizationConfig `json:"authorization" pflag:",Defines the authorization of authenticated users and apps."`
+}
+
+// AuthorizationConfig defines role-based access control settings. Roles are bound to users, apps, groups and email
+// domains in projects and domains, either statically below or through the role bindings API. The groups and email
+// domains of users are read from the claims of the tokens they authenticate with.
+type AuthorizationConfig struct {
+	// Enables role-based access control. When disabled, any identity with the `all` scope may call any endpoint.
+	Enabled bool `json:"enabled" pflag:",Enables role-based access control of authenticated requests."`
+
+	// Role bindings applied in addition to those stored in the database, for instance to bootstrap the first admin.
+	RoleBindings []RoleBinding `json:"roleBindings" pflag:"-,Statically defined role bindings."`
+
+	// The token claims roles can be bound to besides the user and app IDs.
+	Claims ClaimsConfig `json:"claims" pflag:",Defines the token claims roles can be bound to."`
+}
+
+// ClaimsConfig names the claims of ID and access tokens which identify the groups and the email domain of a user.
+type ClaimsConfig struct {
+	// The claim listing the groups a user is a member of, bound to by the "group" subject type. Leave empty to ignore
+	// group memberships.
+	Groups string `json:"groups" pflag:",The claim listing the groups a user is a member of."`
+
+	// The claim holding the email address of a user, whose domain is bound to by the "emailDomain" subject type. Leave
+	// empty to ignore email domains.
+	Email string `json:"email" pflag:",The claim holding the email address of a user."`
+}
+
+// RoleBinding binds a role to a user, app or group in a project and domain.
+type RoleBinding struct {
+	// One of "user", "app", "group" or "emailDomain".
+	SubjectType string `json:"subjectType"`
+	// The user or app ID, the group name or the email domain, like "example.com".
+	Subject string `json:"subject"`
+	// One of "viewer", "contributor" or "admin".
+	Role string `json:"role"`
+	// Optional, the binding applies to all projects when empty. May be a pattern like "ml-*", see path.Match.
+	Project string `json:"project"`
+	// Optional, the binding applies to all domains when empty. May be a pattern like "prod*", see path.Match.
+	Domain string `json:"domain"`
 }
 
 type AuthorizationServer struct {
@@ -166,10 +218,31 @@ type AuthorizationServer struct {
 	TokenSigningRSAKeySecretName          string `json:"tokenSigningRSAKeySecretName" pflag:",OPTIONAL: Secret name to use to retrieve RSA Signing Key."`
 	OldTokenSigningRSAKeySecretName       string `json:"oldTokenSigningRSAKeySecretName" pflag:",OPTIONAL: Secret name to use to retrieve Old RSA Signing Key. This can be useful during key rotation to continue to accept older tokens."`
 
-	// A list of clients to grant access to.
+	// Revoked tokens are only remembered when enabled, otherwise tokens stay valid until they expire and refresh
+	// tokens can be reused.
+	EnableTokenRevocation bool `json:"enableTokenRevocation" pflag:",Persists revoked tokens in the database, enabling the revocation endpoint, refresh token rotation and the revocation checks of access tokens."`
+
+	// Rotates the token signing keys automatically, replacing TokenSigningRSAKeySecretName and
+	// OldTokenSigningRSAKeySecretName.
+	SigningKeyRotation SigningKeyRotation `json:"signingKeyRotation"`
+
+	// A list of clients to grant access to. The scopes of a client bound those its tokens may be issued with: `all`
+	// allows requesting any scope, and fine-grained scopes like `executions:read` restrict its tokens to a subset of
+	// the admin service.
 	StaticClients map[string]*fosite.DefaultClient `json:"staticClients" pflag:"-,Defines statically defined list of clients to allow."`
 }
 
+// SigningKeyRotation configures the automated rotation of the keys signing the tokens issued by the self-hosted
+// authorization server. A new key is generated every RotationInterval and added to the key ring the replicas share in
+// the database, seeded with the key of TokenSigningRSAKeySecretName. Previous keys are kept, and published through the
+// JSON web keys endpoint, until the tokens they signed have expired.
+type SigningKeyRotation struct {
+	Enabled          bool            `json:"enabled" pflag:",Enables the automated rotation of token signing keys."`
+	RotationInterval config.Duration `json:"rotationInterval" pflag:",How often a new signing key is generated."`
+	// Replicas pick up the keys generated by one another when they reload the key ring.
+	CheckInterval config.Duration `json:"checkInterval" pflag:",How often the key ring is reloaded and checked for keys to generate or retire."`
+}
+
 type ExternalAuthorizationServer struct {
 	// BaseURL should be the base url of the authorization server that you are trying to hit. With Okta for instance, it will look something like https://company.okta.com/oauth2/abcdef123456789/
 	// If not provided, the OpenID.BaseURL will be assumed instead.
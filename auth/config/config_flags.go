@@ -70,11 +70,18 @@ func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.claimSymmetricEncryptionKeySecretName"), DefaultConfig.AppAuth.SelfAuthServer.ClaimSymmetricEncryptionKeySecretName, "OPTIONAL: Secret name to use to encrypt claims in authcode token.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.tokenSigningRSAKeySecretName"), DefaultConfig.AppAuth.SelfAuthServer.TokenSigningRSAKeySecretName, "OPTIONAL: Secret name to use to retrieve RSA Signing Key.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.oldTokenSigningRSAKeySecretName"), DefaultConfig.AppAuth.SelfAuthServer.OldTokenSigningRSAKeySecretName, "OPTIONAL: Secret name to use to retrieve Old RSA Signing Key. This can be useful during key rotation to continue to accept older tokens.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.enableTokenRevocation"), DefaultConfig.AppAuth.SelfAuthServer.EnableTokenRevocation, "Persists revoked tokens in the database, enabling the revocation endpoint, refresh token rotation and the revocation checks of access tokens.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.signingKeyRotation.enabled"), DefaultConfig.AppAuth.SelfAuthServer.SigningKeyRotation.Enabled, "Enables the automated rotation of token signing keys.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.signingKeyRotation.rotationInterval"), DefaultConfig.AppAuth.SelfAuthServer.SigningKeyRotation.RotationInterval.String(), "How often a new signing key is generated.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.signingKeyRotation.checkInterval"), DefaultConfig.AppAuth.SelfAuthServer.SigningKeyRotation.CheckInterval.String(), "How often the key ring is reloaded and checked for keys to generate or retire.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.externalAuthServer.baseUrl"), DefaultConfig.AppAuth.ExternalAuthServer.BaseURL.String(), "This should be the base url of the authorization server that you are trying to hit. With Okta for instance,  it will look something like https://company.okta.com/oauth2/abcdef123456789/")
 	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "appAuth.externalAuthServer.allowedAudience"), []string{}, "Optional: A list of allowed audiences. If not provided,  the audience is expected to be the public Uri of the service.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.externalAuthServer.metadataUrl"), DefaultConfig.AppAuth.ExternalAuthServer.MetadataEndpointURL.String(), "Optional: If the server doesn't support /.well-known/oauth-authorization-server,  you can set a custom metadata url here.'")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.thirdPartyConfig.flyteClient.clientId"), DefaultConfig.AppAuth.ThirdParty.FlyteClientConfig.ClientID, "public identifier for the app which handles authorization for a Flyte deployment")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.thirdPartyConfig.flyteClient.redirectUri"), DefaultConfig.AppAuth.ThirdParty.FlyteClientConfig.RedirectURI, "This is the callback uri registered with the app which handles authorization for a Flyte deployment")
 	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "appAuth.thirdPartyConfig.flyteClient.scopes"), []string{}, "Recommended scopes for the client to request.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "authorization.enabled"), DefaultConfig.Authorization.Enabled, "Enables role-based access control of authenticated requests.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authorization.claims.groups"), DefaultConfig.Authorization.Claims.Groups, "The claim listing the groups a user is a member of.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authorization.claims.email"), DefaultConfig.Authorization.Claims.Email, "The claim holding the email address of a user.")
 	return cmdFlags
 }
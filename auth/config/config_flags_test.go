@@ -379,6 +379,62 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_appAuth.selfAuthServer.enableTokenRevocation", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("appAuth.selfAuthServer.enableTokenRevocation", testValue)
+			if vBool, err := cmdFlags.GetBool("appAuth.selfAuthServer.enableTokenRevocation"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.AppAuth.SelfAuthServer.EnableTokenRevocation)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_appAuth.selfAuthServer.signingKeyRotation.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("appAuth.selfAuthServer.signingKeyRotation.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("appAuth.selfAuthServer.signingKeyRotation.enabled"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.AppAuth.SelfAuthServer.SigningKeyRotation.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_appAuth.selfAuthServer.signingKeyRotation.rotationInterval", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := DefaultConfig.AppAuth.SelfAuthServer.SigningKeyRotation.RotationInterval.String()
+
+			cmdFlags.Set("appAuth.selfAuthServer.signingKeyRotation.rotationInterval", testValue)
+			if vString, err := cmdFlags.GetString("appAuth.selfAuthServer.signingKeyRotation.rotationInterval"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.AppAuth.SelfAuthServer.SigningKeyRotation.RotationInterval)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_appAuth.selfAuthServer.signingKeyRotation.checkInterval", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := DefaultConfig.AppAuth.SelfAuthServer.SigningKeyRotation.CheckInterval.String()
+
+			cmdFlags.Set("appAuth.selfAuthServer.signingKeyRotation.checkInterval", testValue)
+			if vString, err := cmdFlags.GetString("appAuth.selfAuthServer.signingKeyRotation.checkInterval"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.AppAuth.SelfAuthServer.SigningKeyRotation.CheckInterval)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 	t.Run("Test_appAuth.externalAuthServer.baseUrl", func(t *testing.T) {
 
 		t.Run("Override", func(t *testing.T) {
@@ -463,4 +519,46 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_authorization.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authorization.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("authorization.enabled"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.Authorization.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.claims.groups", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authorization.claims.groups", testValue)
+			if vString, err := cmdFlags.GetString("authorization.claims.groups"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Authorization.Claims.Groups)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.claims.email", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authorization.claims.email", testValue)
+			if vString, err := cmdFlags.GetString("authorization.claims.email"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Authorization.Claims.Email)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
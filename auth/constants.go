@@ -21,4 +21,26 @@ const (
 
 	ContextKeyIdentityContext = contextutils.Key("identity_context")
 	ScopeAll                  = "all"
+
+	// Prefix of the opaque access tokens minted by flyteadmin, which tells them apart from JWTs.
+	AccessTokenPrefix = "flyte_at_"
+
+	// Fine-grained scopes tokens may be restricted to instead of ScopeAll. Each write or admin scope implies the read
+	// scope of its kind.
+	ScopeExecutionsRead    = "executions:read"
+	ScopeExecutionsWrite   = "executions:write"
+	ScopeRegistrationRead  = "registration:read"
+	ScopeRegistrationWrite = "registration:write"
+	ScopeAttributesRead    = "attributes:read"
+	ScopeAttributesAdmin   = "attributes:admin"
 )
+
+// FineGrainedScopes lists the scopes covered by ScopeAll.
+var FineGrainedScopes = []string{
+	ScopeExecutionsRead,
+	ScopeExecutionsWrite,
+	ScopeRegistrationRead,
+	ScopeRegistrationWrite,
+	ScopeAttributesRead,
+	ScopeAttributesAdmin,
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -25,6 +27,10 @@ type IdentityContext struct {
 	userInfo        *service.UserInfoResponse
 	// Set to pointer just to keep this struct go-simple to support equal operator
 	scopes *sets.String
+	// The claims of the token the identity authenticated with. Set to pointer for the same reason as scopes.
+	claims *map[string]interface{}
+	// Whether the identity authenticated with an access token minted by flyteadmin, see AccessTokenPrefix.
+	accessToken bool
 }
 
 func (c IdentityContext) Audience() string {
@@ -59,6 +65,34 @@ func (c IdentityContext) Scopes() sets.String {
 	return sets.NewString()
 }
 
+// Claims returns the claims of the ID or access token the identity authenticated with, for instance to authorize the
+// groups a user is a member of.
+func (c IdentityContext) Claims() map[string]interface{} {
+	if c.claims != nil {
+		return *c.claims
+	}
+
+	return map[string]interface{}{}
+}
+
+// WithClaims returns a copy of the identity carrying the claims of the token it authenticated with.
+func (c IdentityContext) WithClaims(claims map[string]interface{}) IdentityContext {
+	c.claims = &claims
+	return c
+}
+
+// IsAccessToken returns whether the identity authenticated with an access token minted by flyteadmin rather than with
+// a token issued by an identity provider or authorization server.
+func (c IdentityContext) IsAccessToken() bool {
+	return c.accessToken
+}
+
+// WithAccessToken returns a copy of the identity marked as authenticated with an access token minted by flyteadmin.
+func (c IdentityContext) WithAccessToken() IdentityContext {
+	c.accessToken = true
+	return c
+}
+
 func (c IdentityContext) WithContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, ContextKeyIdentityContext, c)
 }
@@ -88,6 +122,21 @@ func NewIdentityContext(audience, userID, appID string, authenticatedAt time.Tim
 	}
 }
 
+// GetAuthorizationClaims returns the claims of the token an identity authenticated with which roles can be bound to, to
+// carry them over to the tokens issued for the identity.
+func GetAuthorizationClaims(identityContext interfaces.IdentityContext, cfg config.ClaimsConfig) map[string]interface{} {
+	claims := map[string]interface{}{}
+	for _, claim := range []string{cfg.Groups, cfg.Email} {
+		if len(claim) == 0 {
+			continue
+		}
+		if value, found := identityContext.Claims()[claim]; found {
+			claims[claim] = value
+		}
+	}
+	return claims
+}
+
 // IdentityContextFromContext retrieves the authenticated identity from context.Context.
 func IdentityContextFromContext(ctx context.Context) IdentityContext {
 	existing := ctx.Value(ContextKeyIdentityContext)
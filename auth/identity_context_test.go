@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAuthorizationClaims(t *testing.T) {
+	identityCtx := NewIdentityContext("aud", "user", "", time.Now(), nil, nil).WithClaims(
+		map[string]interface{}{
+			"sub":    "user",
+			"groups": []interface{}{"ml-platform"},
+		})
+
+	assert.Equal(t, map[string]interface{}{"groups": []interface{}{"ml-platform"}},
+		GetAuthorizationClaims(identityCtx, config.ClaimsConfig{Groups: "groups", Email: "email"}))
+	assert.Empty(t, GetAuthorizationClaims(identityCtx, config.ClaimsConfig{}))
+}
+
+func TestIdentityContext_WithAccessToken(t *testing.T) {
+	identityCtx := NewIdentityContext("", "user", "", time.Now(), nil, nil)
+	assert.False(t, identityCtx.IsAccessToken())
+	assert.True(t, identityCtx.WithAccessToken().IsAccessToken())
+	assert.False(t, identityCtx.IsAccessToken())
+}
@@ -39,6 +39,27 @@ type OAuth2ResourceServer interface {
 	ValidateAccessToken(ctx context.Context, expectedAudience, tokenStr string) (IdentityContext, error)
 }
 
+// TokenRevocationStore persists the IDs of revoked tokens, and of authorization grants all tokens of which are revoked,
+// until they expire.
+type TokenRevocationStore interface {
+	RevokeToken(ctx context.Context, id string, expiresAt time.Time) error
+	IsTokenRevoked(ctx context.Context, ids ...string) (bool, error)
+}
+
+// SigningKeyStore persists the token signing key ring shared by all replicas of the authorization server.
+type SigningKeyStore interface {
+	// GetSigningKeys returns the key ring and its version, which is 0 when no key ring was stored yet.
+	GetSigningKeys(ctx context.Context) (keyRing string, version uint32, err error)
+	// SetSigningKeys stores the key ring read at the version, 0 to store the first one. Returns false, rather than an
+	// error, when another replica stored the key ring since it was read.
+	SetSigningKeys(ctx context.Context, keyRing string, version uint32) (bool, error)
+}
+
+// AccessTokenValidator validates the opaque access tokens minted by flyteadmin for users and service accounts.
+type AccessTokenValidator interface {
+	ValidateAccessToken(ctx context.Context, tokenStr string) (IdentityContext, error)
+}
+
 // AuthenticationContext is a convenience wrapper object that holds all the utilities necessary to run Flyte Admin behind authentication
 // It is constructed at the root server layer, and passed around to the various auth handlers and utility functions/objects.
 type AuthenticationContext interface {
@@ -63,6 +84,8 @@ type IdentityContext interface {
 	UserInfo() *service.UserInfoResponse
 	AuthenticatedAt() time.Time
 	Scopes() sets.String
+	// The claims of the token the identity authenticated with.
+	Claims() map[string]interface{}
 
 	IsEmpty() bool
 	WithContext(ctx context.Context) context.Context
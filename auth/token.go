@@ -131,7 +131,12 @@ func IdentityContextFromIDTokenToken(ctx context.Context, tokenStr, clientID str
 		return nil, err
 	}
 
+	claims := map[string]interface{}{}
+	if err = idToken.Claims(&claims); err != nil {
+		return nil, errors.Wrapf(ErrJwtValidation, err, "failed to unmarshal id token claims")
+	}
+
 	// TODO: Document why automatically specify "all" scope
 	return NewIdentityContext(idToken.Audience[0], idToken.Subject, "", idToken.IssuedAt,
-		sets.NewString(ScopeAll), userInfo), nil
+		sets.NewString(ScopeAll), userInfo).WithClaims(claims), nil
 }
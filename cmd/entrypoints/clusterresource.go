@@ -44,6 +44,7 @@ var controllerRunCmd = &cobra.Command{
 			BaseConfig: repositoryConfig.BaseConfig{
 				IsDebug: dbConfigValues.Debug,
 			},
+			Type:         dbConfigValues.Type,
 			Host:         dbConfigValues.Host,
 			Port:         dbConfigValues.Port,
 			DbName:       dbConfigValues.DbName,
@@ -52,7 +53,7 @@ var controllerRunCmd = &cobra.Command{
 			ExtraOptions: dbConfigValues.ExtraOptions,
 		}
 		db := repositories.GetRepository(
-			repositories.POSTGRES, dbConfig, scope.NewSubScope("database"))
+			repositories.GetRepoConfig(dbConfig), dbConfig, scope.NewSubScope("database"))
 
 		cfg := config.GetConfig()
 		executionCluster := executioncluster.GetExecutionCluster(
@@ -80,6 +81,7 @@ var controllerSyncCmd = &cobra.Command{
 			BaseConfig: repositoryConfig.BaseConfig{
 				IsDebug: dbConfigValues.Debug,
 			},
+			Type:         dbConfigValues.Type,
 			Host:         dbConfigValues.Host,
 			Port:         dbConfigValues.Port,
 			DbName:       dbConfigValues.DbName,
@@ -88,7 +90,7 @@ var controllerSyncCmd = &cobra.Command{
 			ExtraOptions: dbConfigValues.ExtraOptions,
 		}
 		db := repositories.GetRepository(
-			repositories.POSTGRES, dbConfig, scope.NewSubScope("database"))
+			repositories.GetRepoConfig(dbConfig), dbConfig, scope.NewSubScope("database"))
 
 		cfg := config.GetConfig()
 		executionCluster := executioncluster.GetExecutionCluster(
@@ -2,13 +2,18 @@ package entrypoints
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/runtime"
 
 	"github.com/flyteorg/flytestdlib/promutils"
 
 	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/storage"
 
+	"github.com/flyteorg/flyteadmin/pkg/data"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
@@ -24,6 +29,13 @@ var parentMigrateCmd = &cobra.Command{
 var migrationsScope = promutils.NewScope("migrations")
 var migrateScope = migrationsScope.NewSubScope("migrate")
 var rollbackScope = promutils.NewScope("migrations").NewSubScope("rollback")
+var partitionsScope = migrationsScope.NewSubScope("partitions")
+var offloadClosuresScope = migrationsScope.NewSubScope("offload_closures")
+var statusScope = migrationsScope.NewSubScope("status")
+var verifyScope = migrationsScope.NewSubScope("verify")
+
+var partitionMaintenanceOptions config.PartitionMaintenanceOptions
+var offloadClosuresBatchSize int
 
 // This runs all the migrations
 var migrateCmd = &cobra.Command{
@@ -33,18 +45,8 @@ var migrateCmd = &cobra.Command{
 		ctx := context.Background()
 		configuration := runtime.NewConfigurationProvider()
 		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
-		postgresConfigProvider := config.NewPostgresConfigProvider(config.DbConfig{
-			BaseConfig: config.BaseConfig{
-				IsDebug: databaseConfig.Debug,
-			},
-			Host:         databaseConfig.Host,
-			Port:         databaseConfig.Port,
-			DbName:       databaseConfig.DbName,
-			User:         databaseConfig.User,
-			Password:     databaseConfig.Password,
-			ExtraOptions: databaseConfig.ExtraOptions,
-		}, migrateScope)
-		db, err := gorm.Open(postgresConfigProvider.GetType(), postgresConfigProvider.GetArgs())
+		dbConfigProvider := config.NewDbConnectionConfigProvider(config.NewDbConfig(databaseConfig), migrateScope)
+		db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
 		if err != nil {
 			logger.Fatal(ctx, err)
 		}
@@ -70,19 +72,9 @@ var rollbackCmd = &cobra.Command{
 		ctx := context.Background()
 		configuration := runtime.NewConfigurationProvider()
 		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
-		postgresConfigProvider := config.NewPostgresConfigProvider(config.DbConfig{
-			BaseConfig: config.BaseConfig{
-				IsDebug: databaseConfig.Debug,
-			},
-			Host:         databaseConfig.Host,
-			Port:         databaseConfig.Port,
-			DbName:       databaseConfig.DbName,
-			User:         databaseConfig.User,
-			Password:     databaseConfig.Password,
-			ExtraOptions: databaseConfig.ExtraOptions,
-		}, rollbackScope)
-
-		db, err := gorm.Open(postgresConfigProvider.GetType(), postgresConfigProvider.GetArgs())
+		dbConfigProvider := config.NewDbConnectionConfigProvider(config.NewDbConfig(databaseConfig), rollbackScope)
+
+		db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
 		if err != nil {
 			logger.Fatal(ctx, err)
 		}
@@ -109,18 +101,8 @@ var seedProjectsCmd = &cobra.Command{
 		ctx := context.Background()
 		configuration := runtime.NewConfigurationProvider()
 		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
-		postgresConfigProvider := config.NewPostgresConfigProvider(config.DbConfig{
-			BaseConfig: config.BaseConfig{
-				IsDebug: databaseConfig.Debug,
-			},
-			Host:         databaseConfig.Host,
-			Port:         databaseConfig.Port,
-			DbName:       databaseConfig.DbName,
-			User:         databaseConfig.User,
-			Password:     databaseConfig.Password,
-			ExtraOptions: databaseConfig.ExtraOptions,
-		}, migrateScope)
-		db, err := gorm.Open(postgresConfigProvider.GetType(), postgresConfigProvider.GetArgs())
+		dbConfigProvider := config.NewDbConnectionConfigProvider(config.NewDbConfig(databaseConfig), migrateScope)
+		db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
 		if err != nil {
 			logger.Fatal(ctx, err)
 		}
@@ -134,9 +116,155 @@ var seedProjectsCmd = &cobra.Command{
 	},
 }
 
+// This creates upcoming partitions and expires old ones
+var partitionsCmd = &cobra.Command{
+	Use:   "partitions",
+	Short: "Create the partitions of upcoming months and expire those of old months for partitioned tables.",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		configuration := runtime.NewConfigurationProvider()
+		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
+		dbConfigProvider := config.NewDbConnectionConfigProvider(config.NewDbConfig(databaseConfig), partitionsScope)
+		db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
+		if err != nil {
+			logger.Fatal(ctx, err)
+		}
+		defer db.Close()
+		db.LogMode(true)
+		if err = db.DB().Ping(); err != nil {
+			logger.Fatal(ctx, err)
+		}
+
+		if err = config.MaintainPartitions(ctx, db, time.Now(), partitionMaintenanceOptions); err != nil {
+			logger.Fatalf(ctx, "Could not maintain partitions: %v", err)
+		}
+		logger.Infof(ctx, "Maintained partitions successfully")
+	},
+}
+
+// This moves closures exceeding the offloading threshold which are stored in the database to blob storage
+var offloadClosuresCmd = &cobra.Command{
+	Use:   "offload-closures",
+	Short: "Move execution closures exceeding the configured offloading threshold to blob storage.",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		configuration := runtime.NewConfigurationProvider()
+		applicationConfiguration := configuration.ApplicationConfiguration().GetTopLevelConfig()
+		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
+		dbConfigProvider := config.NewDbConnectionConfigProvider(config.NewDbConfig(databaseConfig), offloadClosuresScope)
+		db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
+		if err != nil {
+			logger.Fatal(ctx, err)
+		}
+		defer db.Close()
+		if err = db.DB().Ping(); err != nil {
+			logger.Fatal(ctx, err)
+		}
+		store, err := storage.NewDataStore(storage.GetConfig(), offloadClosuresScope.NewSubScope("storage"))
+		if err != nil {
+			logger.Fatalf(ctx, "Could not initialize storage: %v", err)
+		}
+		blobDeleter, err := data.GetBlobDeleter(storage.GetConfig())
+		if err != nil {
+			logger.Fatalf(ctx, "Could not initialize blob deletion: %v", err)
+		}
+
+		offloader := repositories.NewClosureOffloader(store, blobDeleter, applicationConfiguration.MetadataStoragePrefix,
+			applicationConfiguration.ClosureOffloadingThresholdBytes, offloadClosuresScope)
+		offloaded, err := offloader.OffloadExistingClosures(ctx, db, offloadClosuresBatchSize)
+		if err != nil {
+			logger.Fatalf(ctx, "Could not offload closures: %v", err)
+		}
+		logger.Infof(ctx, "Offloaded %d closures successfully", offloaded)
+	},
+}
+
+// This lists the applied and pending migrations
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List the migrations applied to the database and those pending.",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		configuration := runtime.NewConfigurationProvider()
+		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
+		dbConfigProvider := config.NewDbConnectionConfigProvider(config.NewDbConfig(databaseConfig), statusScope)
+		db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
+		if err != nil {
+			logger.Fatal(ctx, err)
+		}
+		defer db.Close()
+		if err = db.DB().Ping(); err != nil {
+			logger.Fatal(ctx, err)
+		}
+
+		statuses, err := config.GetMigrationStatus(db)
+		if err != nil {
+			logger.Fatalf(ctx, "Could not read the applied migrations: %v", err)
+		}
+		pending := 0
+		for _, status := range statuses {
+			state := "applied"
+			if !status.Known {
+				state = "applied (unknown)"
+			} else if !status.Applied {
+				state = "pending"
+				pending++
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%-60s %s\n", status.ID, state)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%d of %d migrations pending\n", pending, len(config.Migrations))
+	},
+}
+
+// This compares the live schema with the models
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Compare the tables, columns and indexes of the database with those defined by the models.",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		configuration := runtime.NewConfigurationProvider()
+		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
+		dbConfigProvider := config.NewDbConnectionConfigProvider(config.NewDbConfig(databaseConfig), verifyScope)
+		db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
+		if err != nil {
+			logger.Fatal(ctx, err)
+		}
+		defer db.Close()
+		if err = db.DB().Ping(); err != nil {
+			logger.Fatal(ctx, err)
+		}
+
+		differences, err := config.VerifySchema(db)
+		if err != nil {
+			logger.Fatalf(ctx, "Could not verify the schema: %v", err)
+		}
+		for _, difference := range differences {
+			fmt.Fprintln(cmd.OutOrStdout(), difference.String())
+		}
+		if len(differences) > 0 {
+			logger.Fatalf(ctx, "Found %d differences between the schema and the models", len(differences))
+		}
+		logger.Infof(ctx, "Schema matches the models")
+	},
+}
+
 func init() {
 	RootCmd.AddCommand(parentMigrateCmd)
 	parentMigrateCmd.AddCommand(migrateCmd)
 	parentMigrateCmd.AddCommand(rollbackCmd)
 	parentMigrateCmd.AddCommand(seedProjectsCmd)
+	parentMigrateCmd.AddCommand(partitionsCmd)
+	parentMigrateCmd.AddCommand(offloadClosuresCmd)
+	parentMigrateCmd.AddCommand(statusCmd)
+	parentMigrateCmd.AddCommand(verifyCmd)
+
+	partitionsCmd.Flags().IntVar(&partitionMaintenanceOptions.PremakeMonths, "premakeMonths", 3,
+		"Number of months after the current one to create partitions for")
+	partitionsCmd.Flags().IntVar(&partitionMaintenanceOptions.RetentionMonths, "retentionMonths", 0,
+		"Number of months, including the current one, to retain partitions for. Partitions are never expired when 0")
+	partitionsCmd.Flags().BoolVar(&partitionMaintenanceOptions.DropExpired, "dropExpired", false,
+		"Drops expired partitions rather than only detaching them")
+
+	offloadClosuresCmd.Flags().IntVar(&offloadClosuresBatchSize, "batchSize", 100,
+		"Number of rows of each table to read from the database at a time")
 }
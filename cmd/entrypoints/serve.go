@@ -4,9 +4,6 @@ import (
 	"context"
 	"crypto/tls"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
 	"github.com/flyteorg/flytepropeller/pkg/controller/nodes/task/secretmanager"
 
 	authConfig "github.com/flyteorg/flyteadmin/auth/config"
@@ -23,8 +20,13 @@ import (
 	"net"
 	"net/http"
 	_ "net/http/pprof" // Required to serve application.
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
+	"github.com/flyteorg/flyteadmin/pkg/audit"
 	"github.com/flyteorg/flyteadmin/pkg/server"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/credentials"
@@ -49,6 +51,9 @@ import (
 
 var defaultCorsHeaders = []string{"Content-Type"}
 
+// The time in-flight requests are given to complete when the server shuts down.
+const shutdownTimeout = 30 * time.Second
+
 // serveCmd represents the serve command
 var serveCmd = &cobra.Command{
 	Use:   "serve",
@@ -76,24 +81,9 @@ func init() {
 		contextutils.TaskTypeKey, common.RuntimeTypeKey, common.RuntimeVersionKey)
 }
 
-func blanketAuthorization(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
-	resp interface{}, err error) {
-
-	identityContext := auth.IdentityContextFromContext(ctx)
-	if identityContext.IsEmpty() {
-		return handler(ctx, req)
-	}
-
-	if !identityContext.Scopes().Has(auth.ScopeAll) {
-		return nil, status.Errorf(codes.Unauthenticated, "authenticated user doesn't have required scope")
-	}
-
-	return handler(ctx, req)
-}
-
 // Creates a new gRPC Server with all the configuration
 func newGRPCServer(ctx context.Context, cfg *config.ServerConfig, authCtx interfaces.AuthenticationContext,
-	opts ...grpc.ServerOption) (*grpc.Server, error) {
+	adminServer *adminservice.AdminService, opts ...grpc.ServerOption) (*grpc.Server, error) {
 	// Not yet implemented for streaming
 	var chainedUnaryInterceptors grpc.UnaryServerInterceptor
 	if cfg.Security.UseAuth {
@@ -102,11 +92,15 @@ func newGRPCServer(ctx context.Context, cfg *config.ServerConfig, authCtx interf
 			auth.GetAuthenticationCustomMetadataInterceptor(authCtx),
 			grpcauth.UnaryServerInterceptor(auth.GetAuthenticationInterceptor(authCtx)),
 			auth.AuthenticationLoggingInterceptor,
-			blanketAuthorization,
+			audit.UnaryServerInterceptor,
+			adminServer.Authorizer.UnaryServerInterceptor,
+			adminservice.ReadPreferenceInterceptor,
 		)
 	} else {
 		logger.Infof(ctx, "Creating gRPC server without authentication")
-		chainedUnaryInterceptors = grpc_middleware.ChainUnaryServer(grpcPrometheus.UnaryServerInterceptor)
+		chainedUnaryInterceptors = grpc_middleware.ChainUnaryServer(grpcPrometheus.UnaryServerInterceptor,
+			audit.UnaryServerInterceptor,
+			adminservice.ReadPreferenceInterceptor)
 	}
 
 	serverOpts := []grpc.ServerOption{
@@ -116,7 +110,7 @@ func newGRPCServer(ctx context.Context, cfg *config.ServerConfig, authCtx interf
 	serverOpts = append(serverOpts, opts...)
 	grpcServer := grpc.NewServer(serverOpts...)
 	grpcPrometheus.Register(grpcServer)
-	flyteService.RegisterAdminServiceServer(grpcServer, adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master))
+	flyteService.RegisterAdminServiceServer(grpcServer, adminServer)
 	if cfg.Security.UseAuth {
 		flyteService.RegisterAuthMetadataServiceServer(grpcServer, authCtx.AuthMetadataService())
 		flyteService.RegisterIdentityServiceServer(grpcServer, authCtx.IdentityService())
@@ -152,18 +146,23 @@ func healthCheckFunc(w http.ResponseWriter, r *http.Request) {
 }
 
 func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authCfg *authConfig.Config, authCtx interfaces.AuthenticationContext,
-	grpcAddress string, grpcConnectionOpts ...grpc.DialOption) (*http.ServeMux, error) {
+	adminServer *adminservice.AdminService, grpcAddress string, grpcConnectionOpts ...grpc.DialOption) (*http.ServeMux, error) {
 
 	// Register the server that will serve HTTP/REST Traffic
 	mux := http.NewServeMux()
 
-	// Register healthcheck
+	// Register healthcheck, which only checks the liveness of the server
 	mux.HandleFunc("/healthcheck", healthCheckFunc)
+	// Register readiness check, which checks the database is reachable and migrated
+	mux.HandleFunc("/readiness", adminServer.HandleReadinessCheck)
 
 	// Register OpenAPI endpoint
 	// This endpoint will serve the OpenAPI2 spec generated by the swagger protoc plugin, and bundled by go-bindata
 	mux.HandleFunc("/api/v1/openapi", GetHandleOpenapiSpec(ctx))
 
+	// Register the admin endpoints which aren't served through the grpc-gateway
+	adminServer.RegisterHTTPHandlers(mux, authCtx)
+
 	var gwmuxOptions = make([]runtime.ServeMuxOption, 0)
 	// This option means that http requests are served with protobufs, instead of json. We always want this.
 	gwmuxOptions = append(gwmuxOptions, runtime.WithMarshalerOption("application/octet-stream", &runtime.ProtoMarshaller{}))
@@ -209,6 +208,7 @@ func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authCfg *authC
 func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg *authConfig.Config) error {
 	logger.Infof(ctx, "Serving Flyte Admin Insecure")
 
+	adminServer := adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master)
 	// This will parse configuration and create the necessary objects for dealing with auth
 	var authCtx interfaces.AuthenticationContext
 	var err error
@@ -221,7 +221,8 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 		var oauth2Provider interfaces.OAuth2Provider
 		var oauth2ResourceServer interfaces.OAuth2ResourceServer
 		if authCfg.AppAuth.AuthServerType == authConfig.AuthorizationServerTypeSelf {
-			oauth2Provider, err = authzserver.NewProvider(ctx, authCfg.AppAuth.SelfAuthServer, sm)
+			oauth2Provider, err = authzserver.NewProvider(ctx, authCfg.AppAuth.SelfAuthServer, sm,
+				adminServer.SigningKeyStore(), adminServer.RevokedTokenStore())
 			if err != nil {
 				logger.Errorf(ctx, "Error creating authorization server %s", err)
 				return err
@@ -236,6 +237,9 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 			}
 		}
 
+		// Access tokens minted by the admin service are validated along with the JWTs of the authorization server.
+		oauth2ResourceServer = auth.NewAccessTokenResourceServer(oauth2ResourceServer, adminServer.AccessTokenManager)
+
 		oauth2MetadataProvider := authzserver.NewService(authCfg)
 		oidcUserInfoProvider := auth.NewUserInfoProvider()
 
@@ -246,7 +250,7 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 		}
 	}
 
-	grpcServer, err := newGRPCServer(ctx, cfg, authCtx)
+	grpcServer, err := newGRPCServer(ctx, cfg, authCtx, adminServer)
 	if err != nil {
 		return errors.Wrap(err, "failed to create GRPC server")
 	}
@@ -258,12 +262,14 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 	}
 
 	go func() {
-		err := grpcServer.Serve(lis)
-		logger.Fatalf(ctx, "Failed to create GRPC Server, Err: ", err)
+		// Serve only returns without an error once the server was stopped.
+		if err := grpcServer.Serve(lis); err != nil {
+			logger.Fatalf(ctx, "Failed to create GRPC Server, Err: ", err)
+		}
 	}()
 
 	logger.Infof(ctx, "Starting HTTP/1 Gateway server on %s", cfg.GetHostAddress())
-	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, cfg.GetGrpcHostAddress(), grpc.WithInsecure(),
+	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, adminServer, cfg.GetGrpcHostAddress(), grpc.WithInsecure(),
 		grpc.WithMaxHeaderListSize(common.MaxResponseStatusBytes))
 	if err != nil {
 		return err
@@ -281,7 +287,11 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 		handler = httpServer
 	}
 
-	err = http.ListenAndServe(cfg.GetHostAddress(), handler)
+	srv := &http.Server{
+		Addr:    cfg.GetHostAddress(),
+		Handler: handler,
+	}
+	err = serveUntilTerminated(ctx, srv, grpcServer, adminServer, srv.ListenAndServe)
 	if err != nil {
 		return errors.Wrapf(err, "failed to Start HTTP Server")
 	}
@@ -289,6 +299,36 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 	return nil
 }
 
+// Serves until serving fails or the process is asked to terminate. On termination the servers stop accepting requests
+// and complete the in-flight ones, after which the admin service writes the events it queued.
+func serveUntilTerminated(ctx context.Context, srv *http.Server, grpcServer *grpc.Server,
+	adminServer *adminservice.AdminService, serve func() error) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	served := make(chan error, 1)
+	go func() {
+		served <- serve()
+	}()
+	select {
+	case err := <-served:
+		return err
+	case sig := <-signals:
+		logger.Infof(ctx, "Received signal [%v], shutting down", sig)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.Warnf(ctx, "Failed to complete in-flight HTTP requests before shutting down: %v", err)
+	}
+	grpcServer.GracefulStop()
+	adminServer.Stop()
+	logger.Infof(ctx, "Shut down successfully")
+	return nil
+}
+
 // grpcHandlerFunc returns an http.Handler that delegates to grpcServer on incoming gRPC
 // connections or otherHandler otherwise.
 // See https://github.com/philips/grpc-gateway-example/blob/master/cmd/serve.go for reference
@@ -308,6 +348,7 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 	if err != nil {
 		return err
 	}
+	adminServer := adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master)
 	// This will parse configuration and create the necessary objects for dealing with auth
 	var authCtx interfaces.AuthenticationContext
 	if cfg.Security.UseAuth {
@@ -315,7 +356,8 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 		var oauth2Provider interfaces.OAuth2Provider
 		var oauth2ResourceServer interfaces.OAuth2ResourceServer
 		if authCfg.AppAuth.AuthServerType == authConfig.AuthorizationServerTypeSelf {
-			oauth2Provider, err = authzserver.NewProvider(ctx, authCfg.AppAuth.SelfAuthServer, sm)
+			oauth2Provider, err = authzserver.NewProvider(ctx, authCfg.AppAuth.SelfAuthServer, sm,
+				adminServer.SigningKeyStore(), adminServer.RevokedTokenStore())
 			if err != nil {
 				logger.Errorf(ctx, "Error creating authorization server %s", err)
 				return err
@@ -330,6 +372,9 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 			}
 		}
 
+		// Access tokens minted by the admin service are validated along with the JWTs of the authorization server.
+		oauth2ResourceServer = auth.NewAccessTokenResourceServer(oauth2ResourceServer, adminServer.AccessTokenManager)
+
 		oauth2MetadataProvider := authzserver.NewService(authCfg)
 		oidcUserInfoProvider := auth.NewUserInfoProvider()
 
@@ -340,7 +385,7 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 		}
 	}
 
-	grpcServer, err := newGRPCServer(ctx, cfg, authCtx,
+	grpcServer, err := newGRPCServer(ctx, cfg, authCtx, adminServer,
 		grpc.Creds(credentials.NewServerTLSFromCert(cert)))
 	if err != nil {
 		return errors.Wrap(err, "failed to create GRPC server")
@@ -351,7 +396,8 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 		ServerName: cfg.GetHostAddress(),
 		RootCAs:    certPool,
 	})
-	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, cfg.GetHostAddress(), grpc.WithTransportCredentials(dialCreds))
+	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, adminServer, cfg.GetHostAddress(),
+		grpc.WithTransportCredentials(dialCreds))
 	if err != nil {
 		return err
 	}
@@ -370,8 +416,9 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 		},
 	}
 
-	err = srv.Serve(tls.NewListener(conn, srv.TLSConfig))
-
+	err = serveUntilTerminated(ctx, srv, grpcServer, adminServer, func() error {
+		return srv.Serve(tls.NewListener(conn, srv.TLSConfig))
+	})
 	if err != nil {
 		return errors.Wrapf(err, "failed to Start HTTP/2 Server")
 	}
@@ -42,7 +42,7 @@ var schedulerRunCmd = &cobra.Command{
 		dbConfigValues := configuration.ApplicationConfiguration().GetDbConfig()
 		dbConfig := repositoryCommonConfig.NewDbConfig(dbConfigValues)
 		db := schdulerRepoConfig.GetRepository(
-			schdulerRepoConfig.POSTGRES, dbConfig, schedulerScope.NewSubScope("database"))
+			schdulerRepoConfig.GetRepoConfig(dbConfig), dbConfig, schedulerScope.NewSubScope("database"))
 
 		clientSet, err := admin.ClientSetBuilder().WithConfig(admin.GetConfig(ctx)).Build(ctx)
 		if err != nil {
@@ -10,6 +10,7 @@ require (
 	github.com/Azure/go-autorest/autorest v0.11.18 // indirect
 	github.com/NYTimes/gizmo v1.3.6
 	github.com/Selvatico/go-mocket v1.0.7
+	github.com/Shopify/sarama v1.26.4
 	github.com/avast/retry-go v3.0.0+incompatible
 	github.com/aws/aws-sdk-go v1.37.31
 	github.com/benbjohnson/clock v1.1.0
@@ -22,6 +23,7 @@ require (
 	github.com/flyteorg/flytepropeller v0.14.2
 	github.com/flyteorg/flytestdlib v0.3.34
 	github.com/ghodss/yaml v1.0.0
+	github.com/go-sql-driver/mysql v1.5.0
 	github.com/gofrs/uuid v4.0.0+incompatible // indirect
 	github.com/gogo/protobuf v1.3.2
 	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b
@@ -41,6 +43,7 @@ require (
 	github.com/lestrrat-go/jwx v1.1.6
 	github.com/lib/pq v1.10.0
 	github.com/magiconair/properties v1.8.4
+	github.com/mattn/go-sqlite3 v2.0.3+incompatible
 	github.com/mitchellh/mapstructure v1.4.1
 	github.com/ory/fosite v0.39.0
 	github.com/ory/x v0.0.162
@@ -78,3 +81,6 @@ require (
 )
 
 replace github.com/robfig/cron/v3 => github.com/unionai/cron/v3 v3.0.2-0.20210825070134-bfc34418fe84
+
+// The v2 tags of go-sqlite3 were published by mistake and bundle an older SQLite, without support for DROP COLUMN.
+replace github.com/mattn/go-sqlite3 => github.com/mattn/go-sqlite3 v1.14.8
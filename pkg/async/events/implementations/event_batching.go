@@ -0,0 +1,123 @@
+package implementations
+
+import (
+	"context"
+	"time"
+
+	repositoryErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	defaultEventBatchSize     = 100
+	defaultEventFlushInterval = 500 * time.Millisecond
+	defaultEventWriteRetries  = 3
+	defaultEventRetryBackoff  = 100 * time.Millisecond
+)
+
+type eventBatchingOptions struct {
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    int
+	retryBackoff  time.Duration
+}
+
+func newEventBatchingOptions(config runtimeInterfaces.AsyncEventsBatchConfig) eventBatchingOptions {
+	options := eventBatchingOptions{
+		batchSize:     config.BatchSize,
+		flushInterval: time.Duration(config.FlushIntervalMillis) * time.Millisecond,
+		maxRetries:    config.MaxRetries,
+		retryBackoff:  time.Duration(config.RetryBackoffMillis) * time.Millisecond,
+	}
+	if options.batchSize <= 0 {
+		options.batchSize = defaultEventBatchSize
+	}
+	if options.flushInterval <= 0 {
+		options.flushInterval = defaultEventFlushInterval
+	}
+	if options.maxRetries <= 0 {
+		options.maxRetries = defaultEventWriteRetries
+	}
+	if options.retryBackoff <= 0 {
+		options.retryBackoff = defaultEventRetryBackoff
+	}
+	return options
+}
+
+type eventWriterMetrics struct {
+	Scope promutils.Scope
+	// Number of events queued to be written, as of the latest write or dequeue.
+	QueueDepth prometheus.Gauge
+	Written    prometheus.Counter
+	// Events which weren't written because an identical event was queued before them in the same batch.
+	Coalesced prometheus.Counter
+	Dropped   prometheus.Counter
+	Retries   prometheus.Counter
+	// Time spent writing a batch, including retries.
+	BatchWriteDuration promutils.StopWatch
+}
+
+func newEventWriterMetrics(scope promutils.Scope) eventWriterMetrics {
+	return eventWriterMetrics{
+		Scope:      scope,
+		QueueDepth: scope.MustNewGauge("queue_depth", "number of events queued to be written"),
+		Written:    scope.MustNewCounter("events_written", "count of events written to the database"),
+		Coalesced: scope.MustNewCounter("events_coalesced",
+			"count of events not written because an identical event was queued in the same batch"),
+		Dropped: scope.MustNewCounter("events_dropped", "count of events which couldn't be written"),
+		Retries: scope.MustNewCounter("write_retries", "count of writes retried after transient database errors"),
+		BatchWriteDuration: scope.MustNewStopWatch("batch_write_duration",
+			"time spent writing a batch of events, including retries", time.Millisecond),
+	}
+}
+
+// Retries the write for as long as it fails with transient database errors, up to the maximum number of retries.
+func retryTransientErrors(options eventBatchingOptions, metrics eventWriterMetrics, write func() error) error {
+	backoff := options.retryBackoff
+	for attempt := 0; ; attempt++ {
+		err := write()
+		if err == nil || !repositoryErrors.IsTransientError(err) || attempt >= options.maxRetries {
+			return err
+		}
+		metrics.Retries.Inc()
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// Writes a batch of count events all at once. When that fails for a reason other than a transient error, for instance
+// because one of the events was written already, the events are written one at a time so that only the events which
+// can't be written are dropped.
+func writeEventBatch(ctx context.Context, options eventBatchingOptions, metrics eventWriterMetrics, count int,
+	writeBatch func() error, writeEvent func(index int) error) {
+	timer := metrics.BatchWriteDuration.Start()
+	defer timer.Stop()
+	err := retryTransientErrors(options, metrics, writeBatch)
+	if err == nil {
+		metrics.Written.Add(float64(count))
+		return
+	}
+	if count == 1 || repositoryErrors.IsTransientError(err) {
+		// It's okay to be lossy here. These events aren't used to fetch execution state but rather as a convenience
+		// to replay and understand the event execution timeline.
+		metrics.Dropped.Add(float64(count))
+		logger.Warnf(ctx, "Failed to write %d events to database with err [%+v]", count, err)
+		return
+	}
+	logger.Infof(ctx, "Failed to write batch of %d events to database with err [%+v], writing them one at a time",
+		count, err)
+	for i := 0; i < count; i++ {
+		index := i
+		if err := retryTransientErrors(options, metrics, func() error {
+			return writeEvent(index)
+		}); err != nil {
+			metrics.Dropped.Inc()
+			logger.Warnf(ctx, "Failed to write event to database with err [%+v]", err)
+			continue
+		}
+		metrics.Written.Inc()
+	}
+}
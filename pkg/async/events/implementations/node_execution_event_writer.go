@@ -2,44 +2,128 @@ package implementations
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
 )
 
 // This event writer acts to asynchronously persist node execution events. As flytepropeller sends node
-// events, node execution processing doesn't have to wait on these to be committed.
+// events, node execution processing doesn't have to wait on these to be committed. Queued events are inserted in
+// batches.
 type nodeExecutionEventWriter struct {
-	db     repositories.RepositoryInterface
-	events chan admin.NodeExecutionEventRequest
+	db      repositories.RepositoryInterface
+	events  chan admin.NodeExecutionEventRequest
+	options eventBatchingOptions
+	metrics eventWriterMetrics
+	// Guards against writes to the events channel once Stop closed it.
+	mutex   sync.RWMutex
+	stopped bool
+	// Closed once Run has written all the queued events.
+	done chan struct{}
 }
 
 func (w *nodeExecutionEventWriter) Write(event admin.NodeExecutionEventRequest) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	if w.stopped {
+		w.metrics.Dropped.Inc()
+		logger.Warnf(context.Background(), "Dropping event [%+v] written after the event writer stopped", event)
+		return
+	}
 	w.events <- event
+	w.metrics.QueueDepth.Set(float64(len(w.events)))
 }
 
 func (w *nodeExecutionEventWriter) Run() {
-	for event := range w.events {
-		eventModel, err := transformers.CreateNodeExecutionEventModel(event)
-		if err != nil {
-			logger.Warnf(context.TODO(), "Failed to transform event [%+v] to database model with err [%+v]", event, err)
-			continue
+	defer close(w.done)
+	ticker := time.NewTicker(w.options.flushInterval)
+	defer ticker.Stop()
+	batch := make([]models.NodeExecutionEvent, 0, w.options.batchSize)
+	for {
+		select {
+		case event, ok := <-w.events:
+			if !ok {
+				w.flush(batch)
+				return
+			}
+			w.metrics.QueueDepth.Set(float64(len(w.events)))
+			eventModel, err := transformers.CreateNodeExecutionEventModel(event)
+			if err != nil {
+				w.metrics.Dropped.Inc()
+				logger.Warnf(context.Background(), "Failed to transform event [%+v] to database model with err [%+v]",
+					event, err)
+				continue
+			}
+			batch = append(batch, *eventModel)
+			if len(batch) >= w.options.batchSize {
+				w.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			w.flush(batch)
+			batch = batch[:0]
 		}
-		err = w.db.NodeExecutionEventRepo().Create(context.TODO(), *eventModel)
-		if err != nil {
-			// It's okay to be lossy here. These events aren't used to fetch execution state but rather as a convenience
-			// to replay and understand the event execution timeline.
-			logger.Warnf(context.TODO(), "Failed to write event [%+v] to database with err [%+v]", event, err)
+	}
+}
+
+func (w *nodeExecutionEventWriter) Stop() {
+	w.mutex.Lock()
+	if !w.stopped {
+		w.stopped = true
+		close(w.events)
+	}
+	w.mutex.Unlock()
+	<-w.done
+}
+
+func (w *nodeExecutionEventWriter) flush(batch []models.NodeExecutionEvent) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx := context.Background()
+	events := coalesceNodeExecutionEvents(batch)
+	w.metrics.Coalesced.Add(float64(len(batch) - len(events)))
+	writeEventBatch(ctx, w.options, w.metrics, len(events), func() error {
+		return w.db.NodeExecutionEventRepo().BatchCreate(ctx, events)
+	}, func(index int) error {
+		return w.db.NodeExecutionEventRepo().Create(ctx, events[index])
+	})
+}
+
+// Only one event is recorded per node execution phase, so later events of the same phase are dropped from the batch.
+func coalesceNodeExecutionEvents(batch []models.NodeExecutionEvent) []models.NodeExecutionEvent {
+	type eventKey struct {
+		models.NodeExecutionKey
+		Phase string
+	}
+	seen := make(map[eventKey]bool, len(batch))
+	events := make([]models.NodeExecutionEvent, 0, len(batch))
+	for _, event := range batch {
+		key := eventKey{NodeExecutionKey: event.NodeExecutionKey, Phase: event.Phase}
+		if seen[key] {
+			continue
 		}
+		seen[key] = true
+		events = append(events, event)
 	}
+	return events
 }
 
-func NewNodeExecutionEventWriter(db repositories.RepositoryInterface, bufferSize int) interfaces.NodeExecutionEventWriter {
+func NewNodeExecutionEventWriter(db repositories.RepositoryInterface, bufferSize int,
+	batchConfig runtimeInterfaces.AsyncEventsBatchConfig, scope promutils.Scope) interfaces.NodeExecutionEventWriter {
 	return &nodeExecutionEventWriter{
-		db:     db,
-		events: make(chan admin.NodeExecutionEventRequest, bufferSize),
+		db:      db,
+		events:  make(chan admin.NodeExecutionEventRequest, bufferSize),
+		options: newEventBatchingOptions(batchConfig),
+		metrics: newEventWriterMetrics(scope),
+		done:    make(chan struct{}),
 	}
 }
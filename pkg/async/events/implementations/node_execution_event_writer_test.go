@@ -1,37 +1,131 @@
 package implementations
 
 import (
+	"context"
 	"testing"
 
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	event2 "github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
 )
 
-func TestNodeExecutionEventWriter(t *testing.T) {
-	db := mocks.NewMockRepository()
+// Batches are only written once full or when the writer stops.
+var testBatchConfig = runtimeInterfaces.AsyncEventsBatchConfig{
+	BatchSize:           2,
+	FlushIntervalMillis: 60 * 60 * 1000,
+	RetryBackoffMillis:  1,
+}
 
-	event := admin.NodeExecutionEventRequest{
+func getNodeExecutionEventRequest(nodeID string, phase core.NodeExecution_Phase) admin.NodeExecutionEventRequest {
+	return admin.NodeExecutionEventRequest{
 		RequestId: "request_id",
 		Event: &event2.NodeExecutionEvent{
 			Id: &core.NodeExecutionIdentifier{
-				NodeId: "node_id",
+				NodeId: nodeID,
 				ExecutionId: &core.WorkflowExecutionIdentifier{
 					Project: "project",
 					Domain:  "domain",
 					Name:    "exec_name",
 				},
 			},
+			Phase:      phase,
+			OccurredAt: ptypes.TimestampNow(),
 		},
 	}
+}
+
+func getNodeIDs(events []models.NodeExecutionEvent) []string {
+	nodeIDs := make([]string, len(events))
+	for i, event := range events {
+		nodeIDs[i] = event.NodeID
+	}
+	return nodeIDs
+}
 
+func TestNodeExecutionEventWriter(t *testing.T) {
+	db := mocks.NewMockRepository()
 	nodeExecEventRepo := mocks.NodeExecutionEventRepoInterface{}
-	nodeExecEventRepo.On("Create", event).Return(nil)
+	var batches [][]string
+	nodeExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		batches = append(batches, getNodeIDs(args.Get(1).([]models.NodeExecutionEvent)))
+	}).Return(nil)
 	db.(*mocks.MockRepository).NodeExecutionEventRepoIface = &nodeExecEventRepo
-	writer := NewNodeExecutionEventWriter(db, 100)
+	writer := NewNodeExecutionEventWriter(db, 100, testBatchConfig, promutils.NewTestScope())
 	// Assert we can write an event using the buffered channel without holding up this process.
-	writer.Write(event)
+	writer.Write(getNodeExecutionEventRequest("a", core.NodeExecution_RUNNING))
+	writer.Write(getNodeExecutionEventRequest("b", core.NodeExecution_RUNNING))
+	writer.Write(getNodeExecutionEventRequest("c", core.NodeExecution_RUNNING))
+	go func() { writer.Run() }()
+	writer.Stop()
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, batches)
+
+	// Events written after the writer stopped are dropped.
+	writer.Write(getNodeExecutionEventRequest("d", core.NodeExecution_RUNNING))
+	assert.Len(t, batches, 2)
+}
+
+func TestNodeExecutionEventWriter_Coalesced(t *testing.T) {
+	db := mocks.NewMockRepository()
+	nodeExecEventRepo := mocks.NodeExecutionEventRepoInterface{}
+	var batches [][]string
+	nodeExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		batches = append(batches, getNodeIDs(args.Get(1).([]models.NodeExecutionEvent)))
+	}).Return(nil)
+	db.(*mocks.MockRepository).NodeExecutionEventRepoIface = &nodeExecEventRepo
+	writer := NewNodeExecutionEventWriter(db, 100, runtimeInterfaces.AsyncEventsBatchConfig{
+		FlushIntervalMillis: testBatchConfig.FlushIntervalMillis,
+	}, promutils.NewTestScope())
+	writer.Write(getNodeExecutionEventRequest("a", core.NodeExecution_RUNNING))
+	writer.Write(getNodeExecutionEventRequest("a", core.NodeExecution_RUNNING))
+	writer.Write(getNodeExecutionEventRequest("a", core.NodeExecution_SUCCEEDED))
+	go func() { writer.Run() }()
+	writer.Stop()
+	assert.Equal(t, [][]string{{"a", "a"}}, batches)
+}
+
+func TestNodeExecutionEventWriter_RetriesTransientErrors(t *testing.T) {
+	db := mocks.NewMockRepository()
+	nodeExecEventRepo := mocks.NodeExecutionEventRepoInterface{}
+	nodeExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Return(
+		flyteAdminErrors.NewFlyteAdminError(codes.Unavailable, "connection reset")).Twice()
+	nodeExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Return(nil).Once()
+	db.(*mocks.MockRepository).NodeExecutionEventRepoIface = &nodeExecEventRepo
+	writer := NewNodeExecutionEventWriter(db, 100, testBatchConfig, promutils.NewTestScope())
+	writer.Write(getNodeExecutionEventRequest("a", core.NodeExecution_RUNNING))
+	go func() { writer.Run() }()
+	writer.Stop()
+	nodeExecEventRepo.AssertNumberOfCalls(t, "BatchCreate", 3)
+}
+
+func TestNodeExecutionEventWriter_WritesFailedBatchEventsIndividually(t *testing.T) {
+	db := mocks.NewMockRepository()
+	nodeExecEventRepo := mocks.NodeExecutionEventRepoInterface{}
+	nodeExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Return(
+		flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "already exists"))
+	var written []string
+	nodeExecEventRepo.OnCreateMatch(mock.Anything, mock.Anything).Call.Return(
+		func(ctx context.Context, event models.NodeExecutionEvent) error {
+			if event.NodeID == "a" {
+				return flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "already exists")
+			}
+			written = append(written, event.NodeID)
+			return nil
+		})
+	db.(*mocks.MockRepository).NodeExecutionEventRepoIface = &nodeExecEventRepo
+	writer := NewNodeExecutionEventWriter(db, 100, testBatchConfig, promutils.NewTestScope())
+	writer.Write(getNodeExecutionEventRequest("a", core.NodeExecution_RUNNING))
+	writer.Write(getNodeExecutionEventRequest("b", core.NodeExecution_RUNNING))
 	go func() { writer.Run() }()
-	close(writer.(*nodeExecutionEventWriter).events)
+	writer.Stop()
+	assert.Equal(t, []string{"b"}, written)
+	nodeExecEventRepo.AssertNumberOfCalls(t, "BatchCreate", 1)
 }
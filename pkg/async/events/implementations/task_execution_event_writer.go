@@ -0,0 +1,142 @@
+package implementations
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+)
+
+// This event writer acts to asynchronously persist task execution events. As flytepropeller sends task
+// events, task execution processing doesn't have to wait on these to be committed. Queued events are inserted in
+// batches.
+type taskExecutionEventWriter struct {
+	db      repositories.RepositoryInterface
+	events  chan admin.TaskExecutionEventRequest
+	options eventBatchingOptions
+	metrics eventWriterMetrics
+	// Guards against writes to the events channel once Stop closed it.
+	mutex   sync.RWMutex
+	stopped bool
+	// Closed once Run has written all the queued events.
+	done chan struct{}
+}
+
+func (w *taskExecutionEventWriter) Write(event admin.TaskExecutionEventRequest) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	if w.stopped {
+		w.metrics.Dropped.Inc()
+		logger.Warnf(context.Background(), "Dropping event [%+v] written after the event writer stopped", event)
+		return
+	}
+	w.events <- event
+	w.metrics.QueueDepth.Set(float64(len(w.events)))
+}
+
+func (w *taskExecutionEventWriter) Run() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.options.flushInterval)
+	defer ticker.Stop()
+	batch := make([]models.TaskExecutionEvent, 0, w.options.batchSize)
+	for {
+		select {
+		case event, ok := <-w.events:
+			if !ok {
+				w.flush(batch)
+				return
+			}
+			w.metrics.QueueDepth.Set(float64(len(w.events)))
+			eventModel, err := transformers.CreateTaskExecutionEventModel(event)
+			if err != nil {
+				w.metrics.Dropped.Inc()
+				logger.Warnf(context.Background(), "Failed to transform event [%+v] to database model with err [%+v]",
+					event, err)
+				continue
+			}
+			batch = append(batch, *eventModel)
+			if len(batch) >= w.options.batchSize {
+				w.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			w.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+func (w *taskExecutionEventWriter) Stop() {
+	w.mutex.Lock()
+	if !w.stopped {
+		w.stopped = true
+		close(w.events)
+	}
+	w.mutex.Unlock()
+	<-w.done
+}
+
+func (w *taskExecutionEventWriter) flush(batch []models.TaskExecutionEvent) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx := context.Background()
+	events := coalesceTaskExecutionEvents(batch)
+	w.metrics.Coalesced.Add(float64(len(batch) - len(events)))
+	writeEventBatch(ctx, w.options, w.metrics, len(events), func() error {
+		return w.db.TaskExecutionEventRepo().BatchCreate(ctx, events)
+	}, func(index int) error {
+		return w.db.TaskExecutionEventRepo().Create(ctx, events[index])
+	})
+}
+
+// Only one event is recorded per task execution phase and phase version, so later events of the same phase and phase
+// version are dropped from the batch.
+func coalesceTaskExecutionEvents(batch []models.TaskExecutionEvent) []models.TaskExecutionEvent {
+	type eventKey struct {
+		models.TaskKey
+		models.NodeExecutionKey
+		RetryAttempt uint32
+		Phase        string
+		PhaseVersion uint32
+	}
+	seen := make(map[eventKey]bool, len(batch))
+	events := make([]models.TaskExecutionEvent, 0, len(batch))
+	for _, event := range batch {
+		key := eventKey{
+			TaskKey:          event.TaskKey,
+			NodeExecutionKey: event.NodeExecutionKey,
+			Phase:            event.Phase,
+			PhaseVersion:     event.PhaseVersion,
+		}
+		// The retry attempt is a pointer, so its value is compared instead.
+		if event.RetryAttempt != nil {
+			key.RetryAttempt = *event.RetryAttempt
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		events = append(events, event)
+	}
+	return events
+}
+
+func NewTaskExecutionEventWriter(db repositories.RepositoryInterface, bufferSize int,
+	batchConfig runtimeInterfaces.AsyncEventsBatchConfig, scope promutils.Scope) interfaces.TaskExecutionEventWriter {
+	return &taskExecutionEventWriter{
+		db:      db,
+		events:  make(chan admin.TaskExecutionEventRequest, bufferSize),
+		options: newEventBatchingOptions(batchConfig),
+		metrics: newEventWriterMetrics(scope),
+		done:    make(chan struct{}),
+	}
+}
@@ -0,0 +1,65 @@
+package implementations
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	event2 "github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getTaskExecutionEventRequest(retryAttempt, phaseVersion uint32) admin.TaskExecutionEventRequest {
+	return admin.TaskExecutionEventRequest{
+		RequestId: "request_id",
+		Event: &event2.TaskExecutionEvent{
+			TaskId: &core.Identifier{
+				ResourceType: core.ResourceType_TASK,
+				Project:      "project",
+				Domain:       "domain",
+				Name:         "task",
+				Version:      "version",
+			},
+			ParentNodeExecutionId: &core.NodeExecutionIdentifier{
+				NodeId: "node",
+				ExecutionId: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "exec_name",
+				},
+			},
+			RetryAttempt: retryAttempt,
+			Phase:        core.TaskExecution_RUNNING,
+			PhaseVersion: phaseVersion,
+			OccurredAt:   ptypes.TimestampNow(),
+		},
+	}
+}
+
+func TestTaskExecutionEventWriter(t *testing.T) {
+	db := mocks.NewMockRepository()
+	taskExecEventRepo := mocks.TaskExecutionEventRepoInterface{}
+	var batches [][]uint32
+	taskExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		var phaseVersions []uint32
+		for _, event := range args.Get(1).([]models.TaskExecutionEvent) {
+			phaseVersions = append(phaseVersions, event.PhaseVersion)
+		}
+		batches = append(batches, phaseVersions)
+	}).Return(nil)
+	db.(*mocks.MockRepository).TaskExecutionEventRepoIface = &taskExecEventRepo
+	writer := NewTaskExecutionEventWriter(db, 100, testBatchConfig, promutils.NewTestScope())
+	writer.Write(getTaskExecutionEventRequest(0, 0))
+	writer.Write(getTaskExecutionEventRequest(0, 0))
+	writer.Write(getTaskExecutionEventRequest(0, 1))
+	writer.Write(getTaskExecutionEventRequest(1, 1))
+	go func() { writer.Run() }()
+	writer.Stop()
+	// The duplicate event of the first batch is coalesced, events of another phase version or retry attempt aren't.
+	assert.Equal(t, [][]uint32{{0}, {1, 1}}, batches)
+}
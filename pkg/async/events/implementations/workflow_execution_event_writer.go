@@ -2,44 +2,128 @@ package implementations
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
 )
 
 // This event writer acts to asynchronously persist workflow execution events. As flytepropeller sends workflow
-// events, workflow execution processing doesn't have to wait on these to be committed.
+// events, workflow execution processing doesn't have to wait on these to be committed. Queued events are inserted in
+// batches.
 type workflowExecutionEventWriter struct {
-	db     repositories.RepositoryInterface
-	events chan admin.WorkflowExecutionEventRequest
+	db      repositories.RepositoryInterface
+	events  chan admin.WorkflowExecutionEventRequest
+	options eventBatchingOptions
+	metrics eventWriterMetrics
+	// Guards against writes to the events channel once Stop closed it.
+	mutex   sync.RWMutex
+	stopped bool
+	// Closed once Run has written all the queued events.
+	done chan struct{}
 }
 
 func (w *workflowExecutionEventWriter) Write(event admin.WorkflowExecutionEventRequest) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	if w.stopped {
+		w.metrics.Dropped.Inc()
+		logger.Warnf(context.Background(), "Dropping event [%+v] written after the event writer stopped", event)
+		return
+	}
 	w.events <- event
+	w.metrics.QueueDepth.Set(float64(len(w.events)))
 }
 
 func (w *workflowExecutionEventWriter) Run() {
-	for event := range w.events {
-		eventModel, err := transformers.CreateExecutionEventModel(event)
-		if err != nil {
-			logger.Warnf(context.TODO(), "Failed to transform event [%+v] to database model with err [%+v]", event, err)
-			continue
+	defer close(w.done)
+	ticker := time.NewTicker(w.options.flushInterval)
+	defer ticker.Stop()
+	batch := make([]models.ExecutionEvent, 0, w.options.batchSize)
+	for {
+		select {
+		case event, ok := <-w.events:
+			if !ok {
+				w.flush(batch)
+				return
+			}
+			w.metrics.QueueDepth.Set(float64(len(w.events)))
+			eventModel, err := transformers.CreateExecutionEventModel(event)
+			if err != nil {
+				w.metrics.Dropped.Inc()
+				logger.Warnf(context.Background(), "Failed to transform event [%+v] to database model with err [%+v]",
+					event, err)
+				continue
+			}
+			batch = append(batch, *eventModel)
+			if len(batch) >= w.options.batchSize {
+				w.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			w.flush(batch)
+			batch = batch[:0]
 		}
-		err = w.db.ExecutionEventRepo().Create(context.TODO(), *eventModel)
-		if err != nil {
-			// It's okay to be lossy here. These events aren't used to fetch execution state but rather as a convenience
-			// to replay and understand the event execution timeline.
-			logger.Warnf(context.TODO(), "Failed to write event [%+v] to database with err [%+v]", event, err)
+	}
+}
+
+func (w *workflowExecutionEventWriter) Stop() {
+	w.mutex.Lock()
+	if !w.stopped {
+		w.stopped = true
+		close(w.events)
+	}
+	w.mutex.Unlock()
+	<-w.done
+}
+
+func (w *workflowExecutionEventWriter) flush(batch []models.ExecutionEvent) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx := context.Background()
+	events := coalesceExecutionEvents(batch)
+	w.metrics.Coalesced.Add(float64(len(batch) - len(events)))
+	writeEventBatch(ctx, w.options, w.metrics, len(events), func() error {
+		return w.db.ExecutionEventRepo().BatchCreate(ctx, events)
+	}, func(index int) error {
+		return w.db.ExecutionEventRepo().Create(ctx, events[index])
+	})
+}
+
+// Only one event is recorded per execution phase, so later events of the same phase are dropped from the batch.
+func coalesceExecutionEvents(batch []models.ExecutionEvent) []models.ExecutionEvent {
+	type eventKey struct {
+		models.ExecutionKey
+		Phase string
+	}
+	seen := make(map[eventKey]bool, len(batch))
+	events := make([]models.ExecutionEvent, 0, len(batch))
+	for _, event := range batch {
+		key := eventKey{ExecutionKey: event.ExecutionKey, Phase: event.Phase}
+		if seen[key] {
+			continue
 		}
+		seen[key] = true
+		events = append(events, event)
 	}
+	return events
 }
 
-func NewWorkflowExecutionEventWriter(db repositories.RepositoryInterface, bufferSize int) interfaces.WorkflowExecutionEventWriter {
+func NewWorkflowExecutionEventWriter(db repositories.RepositoryInterface, bufferSize int,
+	batchConfig runtimeInterfaces.AsyncEventsBatchConfig, scope promutils.Scope) interfaces.WorkflowExecutionEventWriter {
 	return &workflowExecutionEventWriter{
-		db:     db,
-		events: make(chan admin.WorkflowExecutionEventRequest, bufferSize),
+		db:      db,
+		events:  make(chan admin.WorkflowExecutionEventRequest, bufferSize),
+		options: newEventBatchingOptions(batchConfig),
+		metrics: newEventWriterMetrics(scope),
+		done:    make(chan struct{}),
 	}
 }
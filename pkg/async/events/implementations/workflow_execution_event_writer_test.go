@@ -4,15 +4,18 @@ import (
 	"testing"
 
 	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	event2 "github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
-func TestWorkflowExecutionEventWriter(t *testing.T) {
-	db := mocks.NewMockRepository()
-
-	event := admin.WorkflowExecutionEventRequest{
+func getWorkflowExecutionEventRequest(phase core.WorkflowExecution_Phase) admin.WorkflowExecutionEventRequest {
+	return admin.WorkflowExecutionEventRequest{
 		RequestId: "request_id",
 		Event: &event2.WorkflowExecutionEvent{
 			ExecutionId: &core.WorkflowExecutionIdentifier{
@@ -20,15 +23,32 @@ func TestWorkflowExecutionEventWriter(t *testing.T) {
 				Domain:  "domain",
 				Name:    "exec_name",
 			},
+			Phase:      phase,
+			OccurredAt: ptypes.TimestampNow(),
 		},
 	}
+}
 
+func TestWorkflowExecutionEventWriter(t *testing.T) {
+	db := mocks.NewMockRepository()
 	workflowExecEventRepo := mocks.ExecutionEventRepoInterface{}
-	workflowExecEventRepo.On("Create", event).Return(nil)
+	var batches [][]string
+	workflowExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		var phases []string
+		for _, event := range args.Get(1).([]models.ExecutionEvent) {
+			phases = append(phases, event.Phase)
+		}
+		batches = append(batches, phases)
+	}).Return(nil)
 	db.(*mocks.MockRepository).ExecutionEventRepoIface = &workflowExecEventRepo
-	writer := NewWorkflowExecutionEventWriter(db, 100)
+	writer := NewWorkflowExecutionEventWriter(db, 100, testBatchConfig, promutils.NewTestScope())
 	// Assert we can write an event using the buffered channel without holding up this process.
-	writer.Write(event)
+	writer.Write(getWorkflowExecutionEventRequest(core.WorkflowExecution_RUNNING))
+	writer.Write(getWorkflowExecutionEventRequest(core.WorkflowExecution_RUNNING))
+	writer.Write(getWorkflowExecutionEventRequest(core.WorkflowExecution_SUCCEEDING))
+	writer.Write(getWorkflowExecutionEventRequest(core.WorkflowExecution_SUCCEEDED))
 	go func() { writer.Run() }()
-	close(writer.(*workflowExecutionEventWriter).events)
+	writer.Stop()
+	// The duplicate event of the first batch is coalesced.
+	assert.Equal(t, [][]string{{"RUNNING"}, {"SUCCEEDING", "SUCCEEDED"}}, batches)
 }
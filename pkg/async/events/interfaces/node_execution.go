@@ -7,6 +7,10 @@ import (
 //go:generate mockery -name=NodeExecutionEventWriter -output=../mocks -case=underscore
 
 type NodeExecutionEventWriter interface {
+	// Writes the queued events until Stop is called.
 	Run()
+	// Queues an event to be written.
 	Write(nodeExecutionEvent admin.NodeExecutionEventRequest)
+	// Stops accepting events and blocks until Run has written the events queued so far.
+	Stop()
 }
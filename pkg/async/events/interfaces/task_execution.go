@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+//go:generate mockery -name=TaskExecutionEventWriter -output=../mocks -case=underscore
+
+type TaskExecutionEventWriter interface {
+	// Writes the queued events until Stop is called.
+	Run()
+	// Queues an event to be written.
+	Write(taskExecutionEvent admin.TaskExecutionEventRequest)
+	// Stops accepting events and blocks until Run has written the events queued so far.
+	Stop()
+}
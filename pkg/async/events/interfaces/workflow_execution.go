@@ -7,6 +7,10 @@ import (
 //go:generate mockery -name=WorkflowExecutionEventWriter -output=../mocks -case=underscore
 
 type WorkflowExecutionEventWriter interface {
+	// Writes the queued events until Stop is called.
 	Run()
+	// Queues an event to be written.
 	Write(workflowExecutionEvent admin.WorkflowExecutionEventRequest)
+	// Stops accepting events and blocks until Run has written the events queued so far.
+	Stop()
 }
@@ -18,6 +18,11 @@ func (_m *NodeExecutionEventWriter) Run() {
 	_m.Called()
 }
 
+// Stop provides a mock function with given fields:
+func (_m *NodeExecutionEventWriter) Stop() {
+	_m.Called()
+}
+
 // Write provides a mock function with given fields: nodeExecutionEvent
 func (_m *NodeExecutionEventWriter) Write(nodeExecutionEvent admin.NodeExecutionEventRequest) {
 	_m.Called(nodeExecutionEvent)
@@ -18,6 +18,11 @@ func (_m *WorkflowExecutionEventWriter) Run() {
 	_m.Called()
 }
 
+// Stop provides a mock function with given fields:
+func (_m *WorkflowExecutionEventWriter) Stop() {
+	_m.Called()
+}
+
 // Write provides a mock function with given fields: workflowExecutionEvent
 func (_m *WorkflowExecutionEventWriter) Write(workflowExecutionEvent admin.WorkflowExecutionEventRequest) {
 	_m.Called(workflowExecutionEvent)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flytestdlib/logger"
 
@@ -74,7 +75,9 @@ func GetEmailer(config runtimeInterfaces.NotificationsConfig, scope promutils.Sc
 	}
 }
 
-func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Processor {
+// The delivery repo is optional and is used to record the history of every notification delivery attempt.
+func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig,
+	deliveries repositoryInterfaces.NotificationDeliveryRepoInterface, scope promutils.Scope) interfaces.Processor {
 	reconnectAttempts := config.ReconnectAttempts
 	reconnectDelay := time.Duration(config.ReconnectDelaySeconds) * time.Second
 	var sub pubsub.Subscriber
@@ -103,6 +106,23 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 			panic(err)
 		}
 		emailer = GetEmailer(config, scope)
+	case common.Kafka:
+		var err error
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+			sub, err = implementations.NewKafkaSubscriber(config.KafkaConfig, config.NotificationsPublisherConfig.TopicName)
+			if err != nil {
+				logger.Warnf(context.TODO(), "Failed to initialize new kafka subscriber with config [%+v] and err: %v",
+					config.KafkaConfig, err)
+			}
+			return err
+		})
+
+		if err != nil {
+			panic(err)
+		}
+		// Kafka messages hold the published notification as is.
+		return implementations.NewRawMessageProcessor(sub, GetEmailer(config, scope), deliveries,
+			config.NotificationsProcessorConfig, scope)
 	case common.Local:
 		fallthrough
 	default:
@@ -110,7 +130,7 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 			"Using default noop notifications processor implementation for config type [%s]", config.Type)
 		return implementations.NewNoopProcess()
 	}
-	return implementations.NewProcessor(sub, emailer, scope)
+	return implementations.NewProcessor(sub, emailer, deliveries, config.NotificationsProcessorConfig, scope)
 }
 
 func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Publisher {
@@ -151,6 +171,18 @@ func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, sco
 			return err
 		})
 
+		if err != nil {
+			panic(err)
+		}
+		return implementations.NewPublisher(publisher, scope)
+	case common.Kafka:
+		var publisher pubsub.Publisher
+		var err error
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+			publisher, err = implementations.NewKafkaPublisher(config.KafkaConfig, config.NotificationsPublisherConfig.TopicName)
+			return err
+		})
+
 		if err != nil {
 			panic(err)
 		}
@@ -164,7 +196,10 @@ func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, sco
 	}
 }
 
-func NewEventsPublisher(config runtimeInterfaces.ExternalEventsConfig, scope promutils.Scope) interfaces.Publisher {
+// Returns a publisher which emits events in the configured format. The execution repo is used to enrich CloudEvents
+// formatted events with execution metadata.
+func NewEventsPublisher(config runtimeInterfaces.ExternalEventsConfig,
+	executions repositoryInterfaces.ExecutionRepoInterface, scope promutils.Scope) interfaces.Publisher {
 	if !config.Enable {
 		return implementations.NewNoopPublish()
 	}
@@ -188,7 +223,7 @@ func NewEventsPublisher(config runtimeInterfaces.ExternalEventsConfig, scope pro
 		if err != nil {
 			panic(err)
 		}
-		return implementations.NewEventsPublisher(publisher, scope, config.EventsPublisherConfig.EventTypes)
+		return newFormattedEventsPublisher(config, publisher, executions, scope)
 	case common.GCP:
 		pubsubConfig := gizmoGCP.Config{
 			Topic: config.EventsPublisherConfig.TopicName,
@@ -204,7 +239,19 @@ func NewEventsPublisher(config runtimeInterfaces.ExternalEventsConfig, scope pro
 		if err != nil {
 			panic(err)
 		}
-		return implementations.NewEventsPublisher(publisher, scope, config.EventsPublisherConfig.EventTypes)
+		return newFormattedEventsPublisher(config, publisher, executions, scope)
+	case common.Kafka:
+		var publisher pubsub.Publisher
+		var err error
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+			publisher, err = implementations.NewKafkaPublisher(config.KafkaConfig, config.EventsPublisherConfig.TopicName)
+			return err
+		})
+
+		if err != nil {
+			panic(err)
+		}
+		return newFormattedEventsPublisher(config, publisher, executions, scope)
 	case common.Local:
 		fallthrough
 	default:
@@ -213,3 +260,28 @@ func NewEventsPublisher(config runtimeInterfaces.ExternalEventsConfig, scope pro
 		return implementations.NewNoopPublish()
 	}
 }
+
+func newFormattedEventsPublisher(config runtimeInterfaces.ExternalEventsConfig, publisher pubsub.Publisher,
+	executions repositoryInterfaces.ExecutionRepoInterface, scope promutils.Scope) interfaces.Publisher {
+	switch config.Format {
+	case implementations.CloudEventsFormat:
+		return implementations.NewCloudEventsPublisher(publisher, executions, scope,
+			config.EventsPublisherConfig.EventTypes, config.CloudEventsConfig)
+	case implementations.ProtobufFormat:
+		fallthrough
+	case "":
+		return implementations.NewEventsPublisher(publisher, scope, config.EventsPublisherConfig.EventTypes)
+	default:
+		panic(fmt.Errorf("unsupported external events format [%s]", config.Format))
+	}
+}
+
+// Returns the relay which publishes events written to the transactional outbox, or a no-op processor when external
+// events aren't written to the outbox.
+func NewOutboxRelay(config runtimeInterfaces.ExternalEventsConfig, outbox repositoryInterfaces.OutboxEventRepoInterface,
+	publisher interfaces.Publisher, scope promutils.Scope) interfaces.Processor {
+	if !config.Enable || !config.OutboxConfig.Enable {
+		return implementations.NewNoopProcess()
+	}
+	return implementations.NewOutboxRelay(outbox, publisher, config.OutboxConfig, scope)
+}
@@ -23,3 +23,13 @@ func TestGetEmailer(t *testing.T) {
 	// shouldn't reach here
 	t.Errorf("did not panic")
 }
+
+func TestNewEventsPublisher_UnsupportedFormat(t *testing.T) {
+	defer func() { r := recover(); assert.NotNil(t, r) }()
+	newFormattedEventsPublisher(runtimeInterfaces.ExternalEventsConfig{
+		Format: "avro",
+	}, nil, nil, promutils.NewTestScope())
+
+	// shouldn't reach here
+	t.Errorf("did not panic")
+}
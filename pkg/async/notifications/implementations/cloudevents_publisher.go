@@ -0,0 +1,276 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	ProtobufFormat    = "protobuf"
+	CloudEventsFormat = "cloudevents"
+)
+
+const (
+	cloudEventsSpecVersion       = "1.0"
+	cloudEventsContentType       = "application/json"
+	defaultCloudEventsSource     = "flyteadmin"
+	defaultCloudEventsTypePrefix = "org.flyte.event"
+)
+
+var cloudEventKinds = map[string]string{
+	supportedEvents[Workflow]: Workflow,
+	supportedEvents[Node]:     Node,
+	supportedEvents[Task]:     Task,
+	interfaces.AuditEventKey:  Audit,
+}
+
+// Execution metadata, absent from the raw event requests, which is attached to every published CloudEvent.
+type cloudEventExecution struct {
+	LaunchPlan  json.RawMessage   `json:"launchPlan,omitempty"`
+	Workflow    json.RawMessage   `json:"workflow,omitempty"`
+	Principal   string            `json:"principal,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type cloudEventData struct {
+	// The jsonpb serialized event request.
+	Event     json.RawMessage      `json:"event"`
+	Execution *cloudEventExecution `json:"execution,omitempty"`
+}
+
+// A CloudEvents 1.0 envelope in the structured JSON content mode.
+type cloudEvent struct {
+	SpecVersion     string         `json:"specversion"`
+	ID              string         `json:"id"`
+	Source          string         `json:"source"`
+	Type            string         `json:"type"`
+	Subject         string         `json:"subject,omitempty"`
+	Time            *time.Time     `json:"time,omitempty"`
+	DataContentType string         `json:"datacontenttype"`
+	DataSchema      string         `json:"dataschema,omitempty"`
+	Data            cloudEventData `json:"data"`
+}
+
+type cloudEventsPublisherSystemMetrics struct {
+	eventPublisherSystemMetrics
+	EnrichmentError prometheus.Counter
+	EncodingError   prometheus.Counter
+}
+
+// Publishes execution events as JSON CloudEvents, enriched with metadata from the corresponding execution model.
+type CloudEventsPublisher struct {
+	pub           pubsub.Publisher
+	executions    repositoryInterfaces.ExecutionRepoInterface
+	config        runtimeInterfaces.CloudEventsConfig
+	systemMetrics cloudEventsPublisherSystemMetrics
+	events        sets.String
+	marshaler     jsonpb.Marshaler
+}
+
+func (p *CloudEventsPublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	p.systemMetrics.PublishTotal.Inc()
+
+	if !p.events.Has(notificationType) {
+		return nil
+	}
+	event, err := p.toCloudEvent(ctx, notificationType, msg)
+	if err != nil {
+		p.systemMetrics.EncodingError.Inc()
+		logger.Errorf(ctx, "Failed to transform message with key [%s] to a cloud event with error: %v",
+			notificationType, err)
+		return err
+	}
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		p.systemMetrics.EncodingError.Inc()
+		logger.Errorf(ctx, "Failed to marshal cloud event [%s] with error: %v", event.ID, err)
+		return err
+	}
+	logger.Debugf(ctx, "Publishing the following cloud event [%s]", eventBytes)
+
+	if executionID := getEventExecutionID(msg); executionID != nil {
+		ctx = interfaces.WithPartitionKey(ctx, interfaces.ExecutionPartitionKey(executionID))
+	}
+	err = p.pub.PublishRaw(ctx, notificationType, eventBytes)
+	if err != nil {
+		p.systemMetrics.PublishError.Inc()
+		logger.Errorf(ctx, "Failed to publish a cloud event with key [%s] and id [%s] and error: %v",
+			notificationType, event.ID, err)
+	} else {
+		p.systemMetrics.PublishSuccess.Inc()
+	}
+	return err
+}
+
+func (p *CloudEventsPublisher) toCloudEvent(ctx context.Context, notificationType string, msg proto.Message) (
+	cloudEvent, error) {
+	var requestID, subject string
+	var executionID *core.WorkflowExecutionIdentifier
+	var occurredAt *timestamp.Timestamp
+	switch request := msg.(type) {
+	case *admin.WorkflowExecutionEventRequest:
+		requestID = request.RequestId
+		executionID = request.GetEvent().GetExecutionId()
+		occurredAt = request.GetEvent().GetOccurredAt()
+		subject = fmt.Sprintf("%s/%s", interfaces.ExecutionPartitionKey(executionID), request.GetEvent().GetPhase())
+	case *admin.NodeExecutionEventRequest:
+		requestID = request.RequestId
+		executionID = request.GetEvent().GetId().GetExecutionId()
+		occurredAt = request.GetEvent().GetOccurredAt()
+		subject = fmt.Sprintf("%s/%s/%s", interfaces.ExecutionPartitionKey(executionID),
+			request.GetEvent().GetId().GetNodeId(), request.GetEvent().GetPhase())
+	case *admin.TaskExecutionEventRequest:
+		requestID = request.RequestId
+		executionID = request.GetEvent().GetParentNodeExecutionId().GetExecutionId()
+		occurredAt = request.GetEvent().GetOccurredAt()
+		subject = fmt.Sprintf("%s/%s/%s/%d/%s", interfaces.ExecutionPartitionKey(executionID),
+			request.GetEvent().GetParentNodeExecutionId().GetNodeId(), request.GetEvent().GetTaskId().GetName(),
+			request.GetEvent().GetRetryAttempt(), request.GetEvent().GetPhase())
+	case *structpb.Struct:
+		if notificationType != interfaces.AuditEventKey {
+			return cloudEvent{}, fmt.Errorf("unsupported event message type [%s]", proto.MessageName(msg))
+		}
+		// Audit entries aren't identified by a request id, nor do they belong to an execution.
+		requestID = uuid.New().String()
+	default:
+		return cloudEvent{}, fmt.Errorf("unsupported event message type [%s]", proto.MessageName(msg))
+	}
+
+	var eventData bytes.Buffer
+	if err := p.marshaler.Marshal(&eventData, msg); err != nil {
+		return cloudEvent{}, err
+	}
+	event := cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              requestID,
+		Source:          p.config.Source,
+		Type:            fmt.Sprintf("%s.%s", p.config.TypePrefix, cloudEventKinds[notificationType]),
+		Subject:         subject,
+		DataContentType: cloudEventsContentType,
+		DataSchema:      p.config.DataSchema,
+		Data: cloudEventData{
+			Event:     eventData.Bytes(),
+			Execution: p.getExecutionMetadata(ctx, executionID),
+		},
+	}
+	if occurredAt != nil {
+		eventTime, err := ptypes.Timestamp(occurredAt)
+		if err != nil {
+			return cloudEvent{}, err
+		}
+		event.Time = &eventTime
+	}
+	if len(event.ID) == 0 {
+		// Event producers don't always populate a request id, in which case the subject, which includes the
+		// reported phase, and occurrence time uniquely identify the event.
+		event.ID = subject
+		if event.Time != nil {
+			event.ID = fmt.Sprintf("%s@%d", subject, event.Time.UnixNano())
+		}
+	}
+	return event, nil
+}
+
+// Looks up the execution an event belongs to. Failures are recorded but don't prevent the event from being
+// published without execution metadata. The execution is read from the primary database, as events are commonly
+// published right after the execution was created, before a read replica caught up.
+func (p *CloudEventsPublisher) getExecutionMetadata(
+	ctx context.Context, executionID *core.WorkflowExecutionIdentifier) *cloudEventExecution {
+	if p.executions == nil || executionID == nil {
+		return nil
+	}
+	executionModel, err := p.executions.Get(repositoryInterfaces.WithReadFromPrimary(ctx), repositoryInterfaces.Identifier{
+		Project: executionID.Project,
+		Domain:  executionID.Domain,
+		Name:    executionID.Name,
+	})
+	if err != nil {
+		p.systemMetrics.EnrichmentError.Inc()
+		logger.Warningf(ctx, "Failed to look up execution [%+v] to enrich cloud event with error: %v",
+			executionID, err)
+		return nil
+	}
+	execution, err := transformers.FromExecutionModel(executionModel)
+	if err != nil {
+		p.systemMetrics.EnrichmentError.Inc()
+		logger.Warningf(ctx, "Failed to transform execution [%+v] to enrich cloud event with error: %v",
+			executionID, err)
+		return nil
+	}
+	metadata := cloudEventExecution{
+		Principal:   execution.GetSpec().GetMetadata().GetPrincipal(),
+		Labels:      execution.GetSpec().GetLabels().GetValues(),
+		Annotations: execution.GetSpec().GetAnnotations().GetValues(),
+	}
+	if len(metadata.Principal) == 0 {
+		metadata.Principal = executionModel.User
+	}
+	if execution.GetSpec().GetLaunchPlan() != nil {
+		metadata.LaunchPlan = p.marshalIdentifier(ctx, execution.GetSpec().GetLaunchPlan())
+	}
+	if execution.GetClosure().GetWorkflowId() != nil {
+		metadata.Workflow = p.marshalIdentifier(ctx, execution.GetClosure().GetWorkflowId())
+	}
+	return &metadata
+}
+
+func (p *CloudEventsPublisher) marshalIdentifier(ctx context.Context, identifier *core.Identifier) json.RawMessage {
+	var buf bytes.Buffer
+	if err := p.marshaler.Marshal(&buf, identifier); err != nil {
+		p.systemMetrics.EnrichmentError.Inc()
+		logger.Warningf(ctx, "Failed to marshal identifier [%+v] with error: %v", identifier, err)
+		return nil
+	}
+	return buf.Bytes()
+}
+
+func newCloudEventsPublisherSystemMetrics(scope promutils.Scope) cloudEventsPublisherSystemMetrics {
+	return cloudEventsPublisherSystemMetrics{
+		eventPublisherSystemMetrics: newEventPublisherSystemMetrics(scope),
+		EnrichmentError: scope.MustNewCounter("event_enrichment_errors",
+			"count of failures looking up execution metadata for published events"),
+		EncodingError: scope.MustNewCounter("event_encoding_errors",
+			"count of failures encoding events as cloud events"),
+	}
+}
+
+// Returns a publisher of CloudEvents for the configured event types. The execution repo, when set, is used to enrich
+// each event with the metadata of the execution it belongs to.
+func NewCloudEventsPublisher(pub pubsub.Publisher, executions repositoryInterfaces.ExecutionRepoInterface,
+	scope promutils.Scope, eventTypes []string, config runtimeInterfaces.CloudEventsConfig) interfaces.Publisher {
+	if len(config.Source) == 0 {
+		config.Source = defaultCloudEventsSource
+	}
+	if len(config.TypePrefix) == 0 {
+		config.TypePrefix = defaultCloudEventsTypePrefix
+	}
+	return &CloudEventsPublisher{
+		pub:           pub,
+		executions:    executions,
+		config:        config,
+		systemMetrics: newCloudEventsPublisherSystemMetrics(scope.NewSubScope("cloudevents_publisher")),
+		events:        newEventSet(eventTypes),
+	}
+}
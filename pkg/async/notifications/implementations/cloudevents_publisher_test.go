@@ -0,0 +1,165 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func getMockCloudEventsExecutionRepo(t *testing.T) repositoryInterfaces.ExecutionRepoInterface {
+	spec, _ := proto.Marshal(&admin.ExecutionSpec{
+		LaunchPlan: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "launch_plan",
+			Version:      "v1",
+		},
+		Metadata: &admin.ExecutionMetadata{
+			Principal: "principal",
+		},
+		Labels: &admin.Labels{
+			Values: map[string]string{"team": "flyte"},
+		},
+	})
+	closure, _ := proto.Marshal(&admin.ExecutionClosure{
+		WorkflowId: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "workflow",
+			Version:      "v1",
+		},
+	})
+	repo := repositoryMocks.NewMockExecutionRepo()
+	repo.(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.Identifier) (models.Execution, error) {
+			assert.True(t, repositoryInterfaces.ReadFromPrimary(ctx))
+			assert.Equal(t, repositoryInterfaces.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			}, input)
+			return models.Execution{
+				Spec:    spec,
+				Closure: closure,
+			}, nil
+		})
+	return repo
+}
+
+func TestCloudEventsPublisher_Publish(t *testing.T) {
+	initializeEventPublisher()
+	publisher := NewCloudEventsPublisher(mockEventPublisher, getMockCloudEventsExecutionRepo(t),
+		promutils.NewTestScope(), []string{"*"}, runtimeInterfaces.CloudEventsConfig{
+			DataSchema: "https://example.com/schema.json",
+		})
+	for _, request := range []proto.Message{workflowRequest, nodeRequest, taskRequest} {
+		assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(request), request))
+	}
+	assert.Len(t, testEventPublisher.Published, 3)
+
+	var workflowEvent map[string]interface{}
+	assert.NoError(t, json.Unmarshal(testEventPublisher.Published[0].Body, &workflowEvent))
+	assert.Equal(t, proto.MessageName(workflowRequest), testEventPublisher.Published[0].Key)
+	assert.Equal(t, "1.0", workflowEvent["specversion"])
+	assert.Equal(t, "flyteadmin", workflowEvent["source"])
+	assert.Equal(t, "org.flyte.event.workflow", workflowEvent["type"])
+	assert.Equal(t, "project/domain/name/SUCCEEDED", workflowEvent["subject"])
+	assert.Equal(t, "project/domain/name/SUCCEEDED", workflowEvent["id"])
+	assert.Equal(t, "application/json", workflowEvent["datacontenttype"])
+	assert.Equal(t, "https://example.com/schema.json", workflowEvent["dataschema"])
+	data := workflowEvent["data"].(map[string]interface{})
+	assert.Equal(t, "somestring", data["event"].(map[string]interface{})["event"].(map[string]interface{})["outputUri"])
+	execution := data["execution"].(map[string]interface{})
+	assert.Equal(t, "principal", execution["principal"])
+	assert.Equal(t, "launch_plan", execution["launchPlan"].(map[string]interface{})["name"])
+	assert.Equal(t, "workflow", execution["workflow"].(map[string]interface{})["name"])
+	assert.Equal(t, map[string]interface{}{"team": "flyte"}, execution["labels"])
+
+	var nodeEvent map[string]interface{}
+	assert.NoError(t, json.Unmarshal(testEventPublisher.Published[1].Body, &nodeEvent))
+	assert.Equal(t, "org.flyte.event.node", nodeEvent["type"])
+	assert.Equal(t, requestID, nodeEvent["id"])
+	assert.Equal(t, "project/domain/name/node id/RUNNING", nodeEvent["subject"])
+	assert.NotEmpty(t, nodeEvent["time"])
+
+	var taskEvent map[string]interface{}
+	assert.NoError(t, json.Unmarshal(testEventPublisher.Published[2].Body, &taskEvent))
+	assert.Equal(t, "org.flyte.event.task", taskEvent["type"])
+	assert.Equal(t, "project/domain/name/node id/n/1/RUNNING", taskEvent["subject"])
+	assert.NotNil(t, taskEvent["data"].(map[string]interface{})["execution"])
+}
+
+func TestCloudEventsPublisher_EventTypes(t *testing.T) {
+	initializeEventPublisher()
+	publisher := NewCloudEventsPublisher(mockEventPublisher, nil, promutils.NewTestScope(), []string{"node"},
+		runtimeInterfaces.CloudEventsConfig{
+			Source:     "/flyte/admin",
+			TypePrefix: "com.example",
+		})
+	for _, request := range []proto.Message{workflowRequest, nodeRequest, taskRequest} {
+		assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(request), request))
+	}
+	assert.Len(t, testEventPublisher.Published, 1)
+	var nodeEvent map[string]interface{}
+	assert.NoError(t, json.Unmarshal(testEventPublisher.Published[0].Body, &nodeEvent))
+	assert.Equal(t, "/flyte/admin", nodeEvent["source"])
+	assert.Equal(t, "com.example.node", nodeEvent["type"])
+	assert.Nil(t, nodeEvent["data"].(map[string]interface{})["execution"])
+}
+
+func TestCloudEventsPublisher_AuditEvents(t *testing.T) {
+	auditEvent, err := structpb.NewStruct(map[string]interface{}{"Request": map[string]interface{}{"Method": "m"}})
+	assert.NoError(t, err)
+
+	initializeEventPublisher()
+	publisher := NewCloudEventsPublisher(mockEventPublisher, nil, promutils.NewTestScope(), []string{"audit"},
+		runtimeInterfaces.CloudEventsConfig{})
+	assert.NoError(t, publisher.Publish(context.Background(), interfaces.AuditEventKey, auditEvent))
+	assert.Len(t, testEventPublisher.Published, 1)
+	var event map[string]interface{}
+	assert.NoError(t, json.Unmarshal(testEventPublisher.Published[0].Body, &event))
+	assert.Equal(t, "org.flyte.event.audit", event["type"])
+	assert.NotEmpty(t, event["id"])
+	assert.Equal(t, "m", event["data"].(map[string]interface{})["event"].(map[string]interface{})["Request"].(map[string]interface{})["Method"])
+}
+
+func TestCloudEventsPublisher_EnrichmentError(t *testing.T) {
+	initializeEventPublisher()
+	repo := repositoryMocks.NewMockExecutionRepo()
+	repo.(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.Identifier) (models.Execution, error) {
+			return models.Execution{}, errors.New("not found")
+		})
+	publisher := NewCloudEventsPublisher(mockEventPublisher, repo, promutils.NewTestScope(), []string{"*"},
+		runtimeInterfaces.CloudEventsConfig{})
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(taskRequest), taskRequest))
+	assert.Len(t, testEventPublisher.Published, 1)
+	var taskEvent map[string]interface{}
+	assert.NoError(t, json.Unmarshal(testEventPublisher.Published[0].Body, &taskEvent))
+	assert.Nil(t, taskEvent["data"].(map[string]interface{})["execution"])
+}
+
+func TestCloudEventsPublisher_PublishError(t *testing.T) {
+	initializeEventPublisher()
+	publisher := NewCloudEventsPublisher(mockEventPublisher, nil, promutils.NewTestScope(), []string{"*"},
+		runtimeInterfaces.CloudEventsConfig{})
+	var publishError = errors.New("publish() returns an error")
+	testEventPublisher.GivenError = publishError
+	assert.Equal(t, publishError, publisher.Publish(context.Background(),
+		proto.MessageName(taskRequest), taskRequest))
+}
@@ -6,6 +6,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 
@@ -38,6 +39,7 @@ const (
 	Task          = "task"
 	Node          = "node"
 	Workflow      = "workflow"
+	Audit         = "audit"
 	AllTypes      = "all"
 	AllTypesShort = "*"
 )
@@ -48,6 +50,24 @@ var supportedEvents = map[string]string{
 	Workflow: proto.MessageName(&workflowExecutionReq),
 }
 
+// Event types which aren't included in "all" and are only published when configured explicitly.
+var explicitEvents = map[string]string{
+	Audit: interfaces.AuditEventKey,
+}
+
+// Returns the execution an event request belongs to, or nil for any other message.
+func getEventExecutionID(msg proto.Message) *core.WorkflowExecutionIdentifier {
+	switch request := msg.(type) {
+	case *admin.WorkflowExecutionEventRequest:
+		return request.GetEvent().GetExecutionId()
+	case *admin.NodeExecutionEventRequest:
+		return request.GetEvent().GetId().GetExecutionId()
+	case *admin.TaskExecutionEventRequest:
+		return request.GetEvent().GetParentNodeExecutionId().GetExecutionId()
+	}
+	return nil
+}
+
 // The key is the notification type as defined as an enum.
 func (p *EventPublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
 	p.systemMetrics.PublishTotal.Inc()
@@ -80,7 +100,8 @@ func newEventPublisherSystemMetrics(scope promutils.Scope) eventPublisherSystemM
 	}
 }
 
-func NewEventsPublisher(pub pubsub.Publisher, scope promutils.Scope, eventTypes []string) interfaces.Publisher {
+// Returns the set of supported proto message names corresponding to the configured event types.
+func newEventSet(eventTypes []string) sets.String {
 	eventSet := sets.NewString()
 
 	for _, event := range eventTypes {
@@ -88,18 +109,23 @@ func NewEventsPublisher(pub pubsub.Publisher, scope promutils.Scope, eventTypes
 			for _, e := range supportedEvents {
 				eventSet = eventSet.Insert(e)
 			}
-			break
+			continue
 		}
 		if e, found := supportedEvents[event]; found {
 			eventSet = eventSet.Insert(e)
+		} else if e, found := explicitEvents[event]; found {
+			eventSet = eventSet.Insert(e)
 		} else {
 			logger.Errorf(context.Background(), "Unsupported event type [%s] in the config")
 		}
 	}
+	return eventSet
+}
 
+func NewEventsPublisher(pub pubsub.Publisher, scope promutils.Scope, eventTypes []string) interfaces.Publisher {
 	return &EventPublisher{
 		pub:           pub,
 		systemMetrics: newEventPublisherSystemMetrics(scope.NewSubScope("events_publisher")),
-		events:        eventSet,
+		events:        newEventSet(eventTypes),
 	}
 }
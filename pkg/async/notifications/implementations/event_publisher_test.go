@@ -11,11 +11,14 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
 	"github.com/golang/protobuf/ptypes"
 
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+
 	"github.com/NYTimes/gizmo/pubsub"
 	"github.com/NYTimes/gizmo/pubsub/pubsubtest"
 	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 var testEventPublisher pubsubtest.TestPublisher
@@ -163,6 +166,22 @@ func TestNewEventsPublisher_EventTypes(t *testing.T) {
 	}
 }
 
+func TestNewEventsPublisher_AuditEvents(t *testing.T) {
+	auditEvent, err := structpb.NewStruct(map[string]interface{}{"Request": map[string]interface{}{"Method": "m"}})
+	assert.NoError(t, err)
+
+	initializeEventPublisher()
+	currentEventPublisher := NewEventsPublisher(mockEventPublisher, promutils.NewTestScope(), []string{"all"})
+	assert.NoError(t, currentEventPublisher.Publish(context.Background(), interfaces.AuditEventKey, auditEvent))
+	assert.Empty(t, testEventPublisher.Published)
+
+	currentEventPublisher = NewEventsPublisher(mockEventPublisher, promutils.NewTestScope(), []string{"all", "audit"})
+	assert.NoError(t, currentEventPublisher.Publish(context.Background(), interfaces.AuditEventKey, auditEvent))
+	assert.NoError(t, currentEventPublisher.Publish(context.Background(), proto.MessageName(taskRequest), taskRequest))
+	assert.Len(t, testEventPublisher.Published, 2)
+	assert.Equal(t, interfaces.AuditEventKey, testEventPublisher.Published[0].Key)
+}
+
 func TestEventPublisher_PublishError(t *testing.T) {
 	initializeEventPublisher()
 	currentEventPublisher := NewEventsPublisher(mockEventPublisher, promutils.NewTestScope(), []string{"*"})
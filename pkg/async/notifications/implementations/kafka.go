@@ -0,0 +1,216 @@
+package implementations
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/Shopify/sarama"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// Records the key messages were published with, i.e. the notification or event type, since the record key is
+	// used for partitioning.
+	kafkaMessageTypeHeader      = "flyte-message-type"
+	defaultKafkaConsumerGroup   = "flyteadmin-notifications"
+	defaultKafkaClientID        = "flyteadmin"
+	defaultKafkaProtocolVersion = "1.0.0"
+)
+
+func newSaramaConfig(config runtimeInterfaces.KafkaConfig) (*sarama.Config, error) {
+	version := config.Version
+	if len(version) == 0 {
+		version = defaultKafkaProtocolVersion
+	}
+	kafkaVersion, err := sarama.ParseKafkaVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.ClientID = defaultKafkaClientID
+	saramaConfig.Version = kafkaVersion
+	// Records sharing a key, i.e. belonging to the same execution, are written to the same partition.
+	saramaConfig.Producer.Partitioner = sarama.NewHashPartitioner
+	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+	saramaConfig.Producer.Return.Successes = true
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	return saramaConfig, nil
+}
+
+// Publishes messages to a Kafka topic. Records are keyed by the partition key in the publish context, or by the
+// execution of published event requests, so that consumers observe every message for an execution in order.
+type KafkaPublisher struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, key string, msg proto.Message) error {
+	if _, ok := interfaces.GetPartitionKey(ctx); !ok {
+		if executionID := getEventExecutionID(msg); executionID != nil {
+			ctx = interfaces.WithPartitionKey(ctx, interfaces.ExecutionPartitionKey(executionID))
+		}
+	}
+	msgBytes, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return p.PublishRaw(ctx, key, msgBytes)
+}
+
+func (p *KafkaPublisher) PublishRaw(ctx context.Context, key string, msg []byte) error {
+	partitionKey, ok := interfaces.GetPartitionKey(ctx)
+	if !ok {
+		partitionKey = key
+	}
+	_, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder(partitionKey),
+		Value: sarama.ByteEncoder(msg),
+		Headers: []sarama.RecordHeader{
+			{
+				Key:   []byte(kafkaMessageTypeHeader),
+				Value: []byte(key),
+			},
+		},
+	})
+	return err
+}
+
+func NewKafkaPublisher(config runtimeInterfaces.KafkaConfig, topic string) (pubsub.Publisher, error) {
+	saramaConfig, err := newSaramaConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducer(config.Brokers, saramaConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaPublisher{
+		producer: producer,
+		topic:    topic,
+	}, nil
+}
+
+type kafkaSubscriberMessage struct {
+	message *sarama.ConsumerMessage
+	session sarama.ConsumerGroupSession
+}
+
+func (m *kafkaSubscriberMessage) Message() []byte {
+	return m.message.Value
+}
+
+// Kafka has no per-message deadline, messages are redelivered only if the consumer leaves the group before they're
+// marked done.
+func (m *kafkaSubscriberMessage) ExtendDoneDeadline(time.Duration) error {
+	return nil
+}
+
+// Marks the message as consumed, the offset is committed asynchronously by the consumer group.
+func (m *kafkaSubscriberMessage) Done() error {
+	m.session.MarkMessage(m.message, "")
+	return nil
+}
+
+type kafkaConsumerGroupHandler struct {
+	output chan<- pubsub.SubscriberMessage
+}
+
+func (h *kafkaConsumerGroupHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *kafkaConsumerGroupHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// Forwards the messages of a claimed partition in order until the claim is revoked.
+func (h *kafkaConsumerGroupHandler) ConsumeClaim(
+	session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for message := range claim.Messages() {
+		select {
+		case h.output <- &kafkaSubscriberMessage{message: message, session: session}:
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+	return nil
+}
+
+// Consumes a Kafka topic as a member of a consumer group, so that partitions are balanced across flyteadmin replicas.
+type KafkaSubscriber struct {
+	group  sarama.ConsumerGroup
+	topics []string
+	ctx    context.Context
+	cancel context.CancelFunc
+	mutex  sync.Mutex
+	err    error
+}
+
+func (s *KafkaSubscriber) Start() <-chan pubsub.SubscriberMessage {
+	output := make(chan pubsub.SubscriberMessage)
+	go func() {
+		defer close(output)
+		handler := &kafkaConsumerGroupHandler{output: output}
+		for {
+			// Consume returns whenever the group rebalances and must be called again to rejoin it.
+			if err := s.group.Consume(s.ctx, s.topics, handler); err != nil {
+				logger.Errorf(s.ctx, "Failed to consume topics [%v] with err: %v", s.topics, err)
+				s.setErr(err)
+				return
+			}
+			if s.ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+	return output
+}
+
+func (s *KafkaSubscriber) setErr(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.err = err
+}
+
+func (s *KafkaSubscriber) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.err
+}
+
+func (s *KafkaSubscriber) Stop() error {
+	s.cancel()
+	return s.group.Close()
+}
+
+func newKafkaSubscriber(group sarama.ConsumerGroup, topic string) *KafkaSubscriber {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &KafkaSubscriber{
+		group:  group,
+		topics: []string{topic},
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func NewKafkaSubscriber(config runtimeInterfaces.KafkaConfig, topic string) (pubsub.Subscriber, error) {
+	saramaConfig, err := newSaramaConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	groupID := config.ConsumerGroup
+	if len(groupID) == 0 {
+		groupID = defaultKafkaConsumerGroup
+	}
+	group, err := sarama.NewConsumerGroup(config.Brokers, groupID, saramaConfig)
+	if err != nil {
+		return nil, err
+	}
+	return newKafkaSubscriber(group, topic), nil
+}
@@ -0,0 +1,199 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/Shopify/sarama"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+const kafkaTestTopic = "flyte-events"
+
+type mockSyncProducer struct {
+	sarama.SyncProducer
+	messages         []*sarama.ProducerMessage
+	sendMessageError error
+}
+
+func (p *mockSyncProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	p.messages = append(p.messages, msg)
+	return 0, int64(len(p.messages)), p.sendMessageError
+}
+
+func getRecordKey(t *testing.T, msg *sarama.ProducerMessage) string {
+	key, err := msg.Key.Encode()
+	assert.NoError(t, err)
+	return string(key)
+}
+
+func TestKafkaPublisher_PartitionsByExecution(t *testing.T) {
+	producer := mockSyncProducer{}
+	publisher := &KafkaPublisher{producer: &producer, topic: kafkaTestTopic}
+
+	for _, request := range []proto.Message{workflowRequest, nodeRequest, taskRequest} {
+		assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(request), request))
+	}
+	assert.Len(t, producer.messages, 3)
+	partitioner := sarama.NewHashPartitioner(kafkaTestTopic)
+	var partitions []int32
+	for idx, msg := range producer.messages {
+		assert.Equal(t, kafkaTestTopic, msg.Topic)
+		assert.Equal(t, "project/domain/name", getRecordKey(t, msg))
+		assert.Equal(t, []sarama.RecordHeader{
+			{
+				Key:   []byte(kafkaMessageTypeHeader),
+				Value: []byte(proto.MessageName([]proto.Message{workflowRequest, nodeRequest, taskRequest}[idx])),
+			},
+		}, msg.Headers)
+		partition, err := partitioner.Partition(msg, 8)
+		assert.NoError(t, err)
+		partitions = append(partitions, partition)
+	}
+	assert.Equal(t, partitions[0], partitions[1])
+	assert.Equal(t, partitions[0], partitions[2])
+
+	value, err := producer.messages[0].Value.Encode()
+	assert.NoError(t, err)
+	var published admin.WorkflowExecutionEventRequest
+	assert.NoError(t, proto.Unmarshal(value, &published))
+	assert.True(t, proto.Equal(workflowRequest, &published))
+}
+
+func TestKafkaPublisher_PartitionKey(t *testing.T) {
+	producer := mockSyncProducer{}
+	publisher := &KafkaPublisher{producer: &producer, topic: kafkaTestTopic}
+
+	ctx := interfaces.WithPartitionKey(context.Background(), "p/d/n")
+	assert.NoError(t, publisher.Publish(ctx, "email", &testEmail))
+	assert.NoError(t, publisher.PublishRaw(context.Background(), "email", []byte("raw")))
+	assert.Len(t, producer.messages, 2)
+	assert.Equal(t, "p/d/n", getRecordKey(t, producer.messages[0]))
+	// Without a partition key, records are keyed by the message type.
+	assert.Equal(t, "email", getRecordKey(t, producer.messages[1]))
+}
+
+func TestKafkaPublisher_PublishError(t *testing.T) {
+	publishErr := errors.New("broker unavailable")
+	publisher := &KafkaPublisher{producer: &mockSyncProducer{sendMessageError: publishErr}, topic: kafkaTestTopic}
+	assert.Equal(t, publishErr, publisher.Publish(context.Background(), "email", &testEmail))
+}
+
+func TestNewKafkaPublisher_MockBroker(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(kafkaTestTopic, 0, broker.BrokerID()).
+			SetLeader(kafkaTestTopic, 1, broker.BrokerID()),
+		// Record headers are sent with version 3 produce requests.
+		"ProduceRequest": sarama.NewMockProduceResponse(t).SetVersion(3),
+	})
+
+	publisher, err := NewKafkaPublisher(runtimeInterfaces.KafkaConfig{
+		Brokers: []string{broker.Addr()},
+	}, kafkaTestTopic)
+	assert.NoError(t, err)
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(nodeRequest), nodeRequest))
+	assert.NoError(t, publisher.(*KafkaPublisher).producer.Close())
+}
+
+func TestNewKafkaPublisher_InvalidVersion(t *testing.T) {
+	_, err := NewKafkaPublisher(runtimeInterfaces.KafkaConfig{
+		Brokers: []string{"localhost:9092"},
+		Version: "not a version",
+	}, kafkaTestTopic)
+	assert.Error(t, err)
+}
+
+type mockConsumerGroupSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	marked []*sarama.ConsumerMessage
+}
+
+func (s *mockConsumerGroupSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockConsumerGroupSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.marked = append(s.marked, msg)
+}
+
+type mockConsumerGroupClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *mockConsumerGroupClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+type mockConsumerGroup struct {
+	sarama.ConsumerGroup
+	consumeFunc func(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error
+	closed      bool
+}
+
+func (g *mockConsumerGroup) Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+	return g.consumeFunc(ctx, topics, handler)
+}
+
+func (g *mockConsumerGroup) Close() error {
+	g.closed = true
+	return nil
+}
+
+func TestKafkaSubscriber(t *testing.T) {
+	session := &mockConsumerGroupSession{ctx: context.Background()}
+	claim := &mockConsumerGroupClaim{messages: make(chan *sarama.ConsumerMessage, 2)}
+	claim.messages <- &sarama.ConsumerMessage{Value: []byte("first"), Offset: 1}
+	claim.messages <- &sarama.ConsumerMessage{Value: []byte("second"), Offset: 2}
+	close(claim.messages)
+
+	var subscriber *KafkaSubscriber
+	group := &mockConsumerGroup{}
+	group.consumeFunc = func(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+		assert.Equal(t, []string{kafkaTestTopic}, topics)
+		assert.NoError(t, handler.ConsumeClaim(session, claim))
+		// Stops consuming once the claim has been drained.
+		subscriber.cancel()
+		return nil
+	}
+	subscriber = newKafkaSubscriber(group, kafkaTestTopic)
+
+	var received []pubsub.SubscriberMessage
+	for msg := range subscriber.Start() {
+		received = append(received, msg)
+		assert.NoError(t, msg.Done())
+	}
+	assert.NoError(t, subscriber.Err())
+	assert.Len(t, received, 2)
+	assert.Equal(t, []byte("first"), received[0].Message())
+	assert.Equal(t, []byte("second"), received[1].Message())
+	assert.Len(t, session.marked, 2)
+	assert.Equal(t, int64(2), session.marked[1].Offset)
+
+	assert.NoError(t, subscriber.Stop())
+	assert.True(t, group.closed)
+}
+
+func TestKafkaSubscriber_ConsumeError(t *testing.T) {
+	consumeErr := errors.New("group coordinator unavailable")
+	subscriber := newKafkaSubscriber(&mockConsumerGroup{
+		consumeFunc: func(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+			return consumeErr
+		},
+	}, kafkaTestTopic)
+	for range subscriber.Start() {
+		t.Errorf("unexpected message")
+	}
+	assert.Equal(t, consumeErr, subscriber.Err())
+}
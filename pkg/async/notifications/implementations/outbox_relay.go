@@ -0,0 +1,226 @@
+package implementations
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	defaultOutboxPollInterval  = time.Second
+	defaultOutboxBatchSize     = 100
+	defaultOutboxMaxAttempts   = 10
+	defaultOutboxRetention     = 24 * time.Hour
+	defaultOutboxLeaseDuration = 30 * time.Second
+	// How often published events past the retention period are deleted.
+	outboxPruneInterval = time.Minute
+)
+
+type outboxRelaySystemMetrics struct {
+	Scope          promutils.Scope
+	PublishSuccess prometheus.Counter
+	PublishError   prometheus.Counter
+	DecodingError  prometheus.Counter
+	DeadLettered   prometheus.Counter
+	OutboxError    prometheus.Counter
+	Pruned         prometheus.Counter
+	// Age of the oldest unpublished event, as of the latest poll.
+	Lag prometheus.Gauge
+	// Number of unpublished events found by the latest poll, capped at the batch size.
+	Pending prometheus.Gauge
+}
+
+// Relays events from the transactional outbox to the events publisher. Events are published at least once: an event
+// is marked published only after it has been published. Only the flyteadmin replica holding the relay lease relays the
+// outbox. Events are relayed in the order they were written; when an event fails to publish, the later events of its
+// execution wait for it to be retried on the next poll, so that the events of an execution are never published out of
+// order, while the events of other executions are still relayed. An event failing maxAttempts times is dead-lettered.
+type OutboxRelay struct {
+	outbox        repositoryInterfaces.OutboxEventRepoInterface
+	publisher     interfaces.Publisher
+	pollInterval  time.Duration
+	batchSize     int
+	maxAttempts   uint32
+	retention     time.Duration
+	leaseDuration time.Duration
+	// Identifies this relay as the lease holder.
+	holder        string
+	lastPruned    time.Time
+	stop          chan struct{}
+	systemMetrics outboxRelaySystemMetrics
+}
+
+func (r *OutboxRelay) StartProcessing() {
+	logger.Infof(context.Background(), "Starting outbox relay polling every [%v]", r.pollInterval)
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.relay(context.Background())
+		}
+	}
+}
+
+func (r *OutboxRelay) StopProcessing() error {
+	close(r.stop)
+	return nil
+}
+
+// Publishes a batch of unpublished events, oldest first, if this relay holds the lease. Reads are served by the
+// primary database, as a read replica may lag behind the lease and the events just written or published.
+func (r *OutboxRelay) relay(ctx context.Context) {
+	ctx = repositoryInterfaces.WithReadFromPrimary(ctx)
+	acquired, err := r.outbox.AcquireLease(ctx, r.holder, r.leaseDuration)
+	if err != nil {
+		r.systemMetrics.OutboxError.Inc()
+		logger.Warningf(ctx, "failed to acquire the outbox relay lease with err: %v", err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	r.prune(ctx)
+
+	events, err := r.outbox.ListPending(ctx, r.batchSize)
+	if err != nil {
+		r.systemMetrics.OutboxError.Inc()
+		logger.Warningf(ctx, "failed to list pending outbox events with err: %v", err)
+		return
+	}
+	r.systemMetrics.Pending.Set(float64(len(events)))
+	if len(events) == 0 {
+		r.systemMetrics.Lag.Set(0)
+		return
+	}
+	r.systemMetrics.Lag.Set(time.Since(events[0].CreatedAt).Seconds())
+
+	published := make([]uint, 0, len(events))
+	// Partition keys of the events which failed to publish in this batch.
+	blocked := make(map[string]bool)
+	for _, event := range events {
+		if blocked[event.PartitionKey] {
+			continue
+		}
+		msg, err := transformers.FromOutboxEventModel(event)
+		if err != nil {
+			// The event can never be published, skip it rather than blocking the events of its execution.
+			r.systemMetrics.DecodingError.Inc()
+			logger.Errorf(ctx, "dead-lettering undecodable outbox event [%d] with err: %v", event.ID, err)
+			r.deadLetter(ctx, event.ID, err)
+			continue
+		}
+		if err = r.publisher.Publish(interfaces.WithPartitionKey(ctx, event.PartitionKey), event.EventType, msg); err != nil {
+			r.systemMetrics.PublishError.Inc()
+			if event.Attempts+1 >= r.maxAttempts {
+				logger.Errorf(ctx, "dead-lettering outbox event [%d] after %d attempts with err: %v",
+					event.ID, event.Attempts+1, err)
+				r.deadLetter(ctx, event.ID, err)
+				continue
+			}
+			logger.Infof(ctx, "failed to publish outbox event [%d] on attempt %d with err: %v",
+				event.ID, event.Attempts+1, err)
+			r.recordFailure(ctx, event.ID, err)
+			blocked[event.PartitionKey] = true
+			continue
+		}
+		r.systemMetrics.PublishSuccess.Inc()
+		published = append(published, event.ID)
+	}
+	if err = r.outbox.MarkPublished(ctx, published); err != nil {
+		// The events will be published again on the next poll.
+		r.systemMetrics.OutboxError.Inc()
+		logger.Warningf(ctx, "failed to mark %d outbox events as published with err: %v", len(published), err)
+	}
+}
+
+func (r *OutboxRelay) recordFailure(ctx context.Context, id uint, err error) {
+	if recordErr := r.outbox.RecordFailure(ctx, id, err.Error()); recordErr != nil {
+		r.systemMetrics.OutboxError.Inc()
+		logger.Warningf(ctx, "failed to record failure of outbox event [%d] with err: %v", id, recordErr)
+	}
+}
+
+func (r *OutboxRelay) deadLetter(ctx context.Context, id uint, err error) {
+	r.systemMetrics.DeadLettered.Inc()
+	if deadLetterErr := r.outbox.DeadLetter(ctx, id, err.Error()); deadLetterErr != nil {
+		r.systemMetrics.OutboxError.Inc()
+		logger.Warningf(ctx, "failed to dead-letter outbox event [%d] with err: %v", id, deadLetterErr)
+	}
+}
+
+// Deletes the events published before the retention period, at most once per prune interval.
+func (r *OutboxRelay) prune(ctx context.Context) {
+	if time.Since(r.lastPruned) < outboxPruneInterval {
+		return
+	}
+	r.lastPruned = time.Now()
+	deleted, err := r.outbox.DeletePublished(ctx, r.lastPruned.Add(-r.retention))
+	if err != nil {
+		r.systemMetrics.OutboxError.Inc()
+		logger.Warningf(ctx, "failed to delete published outbox events with err: %v", err)
+		return
+	}
+	r.systemMetrics.Pruned.Add(float64(deleted))
+}
+
+func newOutboxRelaySystemMetrics(scope promutils.Scope) outboxRelaySystemMetrics {
+	return outboxRelaySystemMetrics{
+		Scope:          scope,
+		PublishSuccess: scope.MustNewCounter("publish_success", "count of outbox events published"),
+		PublishError:   scope.MustNewCounter("publish_errors", "count of failed attempts to publish outbox events"),
+		DecodingError:  scope.MustNewCounter("decoding_errors", "count of outbox events which couldn't be decoded"),
+		DeadLettered:   scope.MustNewCounter("dead_lettered", "count of outbox events which are no longer relayed"),
+		OutboxError:    scope.MustNewCounter("outbox_errors", "count of failures reading or updating the outbox"),
+		Pruned:         scope.MustNewCounter("pruned", "count of published outbox events deleted after retention"),
+		Lag:            scope.MustNewGauge("lag_seconds", "age in seconds of the oldest unpublished outbox event"),
+		Pending: scope.MustNewGauge("pending",
+			"number of unpublished outbox events found by the latest poll, capped at the batch size"),
+	}
+}
+
+func NewOutboxRelay(outbox repositoryInterfaces.OutboxEventRepoInterface, publisher interfaces.Publisher,
+	config runtimeInterfaces.OutboxConfig, scope promutils.Scope) interfaces.Processor {
+	pollInterval := time.Duration(config.PollIntervalSeconds) * time.Second
+	if pollInterval <= 0 {
+		pollInterval = defaultOutboxPollInterval
+	}
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultOutboxBatchSize
+	}
+	maxAttempts := uint32(defaultOutboxMaxAttempts)
+	if config.MaxAttempts > 0 {
+		maxAttempts = uint32(config.MaxAttempts)
+	}
+	retention := time.Duration(config.RetentionHours) * time.Hour
+	if retention <= 0 {
+		retention = defaultOutboxRetention
+	}
+	leaseDuration := time.Duration(config.LeaseDurationSeconds) * time.Second
+	if leaseDuration <= 0 {
+		leaseDuration = defaultOutboxLeaseDuration
+	}
+	return &OutboxRelay{
+		outbox:        outbox,
+		publisher:     publisher,
+		pollInterval:  pollInterval,
+		batchSize:     batchSize,
+		maxAttempts:   maxAttempts,
+		retention:     retention,
+		leaseDuration: leaseDuration,
+		holder:        uuid.New().String(),
+		stop:          make(chan struct{}),
+		systemMetrics: newOutboxRelaySystemMetrics(scope.NewSubScope("outbox_relay")),
+	}
+}
@@ -0,0 +1,246 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type publishedOutboxEvent struct {
+	partitionKey string
+	eventType    string
+	msg          proto.Message
+}
+
+type mockOutboxPublisher struct {
+	published []publishedOutboxEvent
+	errors    map[string]error
+}
+
+func (p *mockOutboxPublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	partitionKey, _ := interfaces.GetPartitionKey(ctx)
+	if err, ok := p.errors[partitionKey]; ok {
+		return err
+	}
+	p.published = append(p.published, publishedOutboxEvent{
+		partitionKey: partitionKey,
+		eventType:    notificationType,
+		msg:          msg,
+	})
+	return nil
+}
+
+func getTestOutboxEvents(t *testing.T) []models.OutboxEvent {
+	var events []models.OutboxEvent
+	for idx, request := range []proto.Message{workflowRequest, nodeRequest, taskRequest} {
+		event, err := transformers.CreateOutboxEventModel("project/domain/name", request)
+		assert.NoError(t, err)
+		event.ID = uint(idx + 1)
+		event.CreatedAt = time.Now().Add(-time.Minute)
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestOutboxRelay_Relay(t *testing.T) {
+	events := getTestOutboxEvents(t)
+	var markedPublished []uint
+	outbox := &repositoryMocks.MockOutboxEventRepo{
+		ListPendingFunction: func(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+			assert.True(t, repositoryInterfaces.ReadFromPrimary(ctx))
+			assert.Equal(t, 10, limit)
+			return events, nil
+		},
+		MarkPublishedFunction: func(ctx context.Context, ids []uint) error {
+			markedPublished = ids
+			return nil
+		},
+	}
+	publisher := mockOutboxPublisher{}
+	relay := NewOutboxRelay(outbox, &publisher, runtimeInterfaces.OutboxConfig{BatchSize: 10},
+		promutils.NewTestScope())
+
+	relay.(*OutboxRelay).relay(context.Background())
+	assert.Equal(t, []uint{1, 2, 3}, markedPublished)
+	assert.Len(t, publisher.published, 3)
+	assert.Equal(t, "project/domain/name", publisher.published[0].partitionKey)
+	assert.Equal(t, proto.MessageName(workflowRequest), publisher.published[0].eventType)
+	assert.True(t, proto.Equal(workflowRequest, publisher.published[0].msg))
+	assert.True(t, proto.Equal(taskRequest, publisher.published[2].msg))
+}
+
+func TestOutboxRelay_PublishErrorBlocksExecution(t *testing.T) {
+	events := getTestOutboxEvents(t)
+	events[1].PartitionKey = "project/domain/other"
+	events[2].PartitionKey = "project/domain/other"
+	event := events[0]
+	event.ID = 4
+	events = append(events, event)
+	var markedPublished []uint
+	var failed []uint
+	outbox := &repositoryMocks.MockOutboxEventRepo{
+		ListPendingFunction: func(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+			return events, nil
+		},
+		MarkPublishedFunction: func(ctx context.Context, ids []uint) error {
+			markedPublished = ids
+			return nil
+		},
+		RecordFailureFunction: func(ctx context.Context, id uint, errorMessage string) error {
+			assert.Equal(t, "topic unavailable", errorMessage)
+			failed = append(failed, id)
+			return nil
+		},
+	}
+	publisher := mockOutboxPublisher{
+		errors: map[string]error{"project/domain/other": errors.New("topic unavailable")},
+	}
+	relay := NewOutboxRelay(outbox, &publisher, runtimeInterfaces.OutboxConfig{}, promutils.NewTestScope())
+
+	relay.(*OutboxRelay).relay(context.Background())
+	// The later events of the failed execution are left for the next poll to preserve ordering, the events of other
+	// executions are relayed.
+	assert.Equal(t, []uint{1, 4}, markedPublished)
+	assert.Equal(t, []uint{2}, failed)
+	assert.Len(t, publisher.published, 2)
+}
+
+func TestOutboxRelay_DeadLetter(t *testing.T) {
+	events := getTestOutboxEvents(t)
+	events[1].PartitionKey = "project/domain/other"
+	events[1].Attempts = 2
+	events[2].PartitionKey = "project/domain/other"
+	var markedPublished []uint
+	var deadLettered []uint
+	var failed []uint
+	outbox := &repositoryMocks.MockOutboxEventRepo{
+		ListPendingFunction: func(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+			return events, nil
+		},
+		MarkPublishedFunction: func(ctx context.Context, ids []uint) error {
+			markedPublished = ids
+			return nil
+		},
+		RecordFailureFunction: func(ctx context.Context, id uint, errorMessage string) error {
+			failed = append(failed, id)
+			return nil
+		},
+		DeadLetterFunction: func(ctx context.Context, id uint, errorMessage string) error {
+			assert.Equal(t, "topic unavailable", errorMessage)
+			deadLettered = append(deadLettered, id)
+			return nil
+		},
+	}
+	publisher := mockOutboxPublisher{
+		errors: map[string]error{"project/domain/other": errors.New("topic unavailable")},
+	}
+	relay := NewOutboxRelay(outbox, &publisher, runtimeInterfaces.OutboxConfig{MaxAttempts: 3},
+		promutils.NewTestScope())
+
+	relay.(*OutboxRelay).relay(context.Background())
+	// The dead-lettered event no longer holds back the later events of its execution.
+	assert.Equal(t, []uint{1}, markedPublished)
+	assert.Equal(t, []uint{2}, deadLettered)
+	assert.Equal(t, []uint{3}, failed)
+}
+
+func TestOutboxRelay_UndecodableEvent(t *testing.T) {
+	events := getTestOutboxEvents(t)
+	events[0].EventType = "flyteidl.admin.Unknown"
+	var markedPublished []uint
+	var deadLettered []uint
+	outbox := &repositoryMocks.MockOutboxEventRepo{
+		ListPendingFunction: func(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+			return events, nil
+		},
+		MarkPublishedFunction: func(ctx context.Context, ids []uint) error {
+			markedPublished = ids
+			return nil
+		},
+		DeadLetterFunction: func(ctx context.Context, id uint, errorMessage string) error {
+			deadLettered = append(deadLettered, id)
+			return nil
+		},
+	}
+	publisher := mockOutboxPublisher{}
+	relay := NewOutboxRelay(outbox, &publisher, runtimeInterfaces.OutboxConfig{}, promutils.NewTestScope())
+
+	relay.(*OutboxRelay).relay(context.Background())
+	assert.Equal(t, []uint{2, 3}, markedPublished)
+	assert.Equal(t, []uint{1}, deadLettered)
+	assert.Len(t, publisher.published, 2)
+}
+
+func TestOutboxRelay_LeaseHeldByOtherReplica(t *testing.T) {
+	outbox := &repositoryMocks.MockOutboxEventRepo{
+		AcquireLeaseFunction: func(ctx context.Context, holder string, duration time.Duration) (bool, error) {
+			assert.NotEmpty(t, holder)
+			assert.Equal(t, 45*time.Second, duration)
+			return false, nil
+		},
+		ListPendingFunction: func(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+			assert.Fail(t, "only the lease holder relays the outbox")
+			return nil, nil
+		},
+		DeletePublishedFunction: func(ctx context.Context, before time.Time) (int64, error) {
+			assert.Fail(t, "only the lease holder prunes the outbox")
+			return 0, nil
+		},
+	}
+	relay := NewOutboxRelay(outbox, &mockOutboxPublisher{},
+		runtimeInterfaces.OutboxConfig{LeaseDurationSeconds: 45}, promutils.NewTestScope())
+
+	relay.(*OutboxRelay).relay(context.Background())
+}
+
+func TestOutboxRelay_Prune(t *testing.T) {
+	var pruned []time.Time
+	outbox := &repositoryMocks.MockOutboxEventRepo{
+		DeletePublishedFunction: func(ctx context.Context, before time.Time) (int64, error) {
+			pruned = append(pruned, before)
+			return 5, nil
+		},
+	}
+	relay := NewOutboxRelay(outbox, &mockOutboxPublisher{}, runtimeInterfaces.OutboxConfig{RetentionHours: 2},
+		promutils.NewTestScope())
+
+	relay.(*OutboxRelay).relay(context.Background())
+	relay.(*OutboxRelay).relay(context.Background())
+	// Published events are pruned at most once per prune interval.
+	assert.Len(t, pruned, 1)
+	assert.WithinDuration(t, time.Now().Add(-2*time.Hour), pruned[0], time.Minute)
+}
+
+func TestOutboxRelay_StartStopProcessing(t *testing.T) {
+	polled := make(chan struct{}, 1)
+	outbox := &repositoryMocks.MockOutboxEventRepo{
+		ListPendingFunction: func(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+			select {
+			case polled <- struct{}{}:
+			default:
+			}
+			return nil, nil
+		},
+	}
+	relay := NewOutboxRelay(outbox, &mockOutboxPublisher{}, runtimeInterfaces.OutboxConfig{}, promutils.NewTestScope())
+	relay.(*OutboxRelay).pollInterval = time.Millisecond
+	done := make(chan struct{})
+	go func() {
+		relay.StartProcessing()
+		close(done)
+	}()
+	<-polled
+	assert.NoError(t, relay.StopProcessing())
+	<-done
+}
@@ -5,6 +5,12 @@ import (
 	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/async"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"google.golang.org/grpc/codes"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 
@@ -27,15 +33,28 @@ type processorSystemMetrics struct {
 	MessageDataError      prometheus.Counter
 	MessageProcessorError prometheus.Counter
 	MessageSuccess        prometheus.Counter
+	MessageRetry          prometheus.Counter
+	DeliveryRecordError   prometheus.Counter
 	ChannelClosedError    prometheus.Counter
 	StopError             prometheus.Counter
 }
 
+const (
+	defaultMaxDeliveryAttempts = 3
+	defaultDeliveryRetryDelay  = time.Second
+)
+
 // TODO: Add a counter that encompasses the publisher stats grouped by project and domain.
 type Processor struct {
-	sub           pubsub.Subscriber
-	email         interfaces.Emailer
-	systemMetrics processorSystemMetrics
+	sub   pubsub.Subscriber
+	email interfaces.Emailer
+	// Optional, when set every delivery attempt is recorded.
+	deliveries          repositoryInterfaces.NotificationDeliveryRepoInterface
+	maxDeliveryAttempts int
+	retryDelay          time.Duration
+	systemMetrics       processorSystemMetrics
+	// Whether messages hold the published notification as is, rather than wrapped in an SNS message.
+	rawMessages bool
 }
 
 // Currently only email is the supported notification because slack and pagerduty both use
@@ -58,55 +77,23 @@ func (p *Processor) run() error {
 		p.systemMetrics.MessageTotal.Inc()
 		// Currently this is safe because Gizmo takes a string and casts it to a byte array.
 		var stringMsg = string(msg.Message())
-		// Amazon doesn't provide a struct that can be used to unmarshall into. A generic JSON struct is used in its place.
-		var snsJSONFormat map[string]interface{}
-
-		// At Lyft, SNS populates SQS. This results in the message body of SQS having the SNS message format.
-		// The message format is documented here: https://docs.aws.amazon.com/sns/latest/dg/sns-message-and-json-formats.html
-		// The notification published is stored in the message field after unmarshalling the SQS message.
-		if err := json.Unmarshal(msg.Message(), &snsJSONFormat); err != nil {
-			p.systemMetrics.MessageDecodingError.Inc()
-			logger.Errorf(context.Background(), "failed to unmarshall JSON message [%s] from processor with err: %v", stringMsg, err)
-			p.markMessageDone(msg)
-			continue
-		}
-
-		var value interface{}
-		var ok bool
-		var valueString string
-
-		if value, ok = snsJSONFormat["Message"]; !ok {
-			logger.Errorf(context.Background(), "failed to retrieve message from unmarshalled JSON object [%s]", stringMsg)
-			p.systemMetrics.MessageDataError.Inc()
-			p.markMessageDone(msg)
-			continue
-		}
-
-		if valueString, ok = value.(string); !ok {
-			p.systemMetrics.MessageDataError.Inc()
-			logger.Errorf(context.Background(), "failed to retrieve notification message (in string format) from unmarshalled JSON object for message [%s]", stringMsg)
-			p.markMessageDone(msg)
-			continue
-		}
-
-		// The Publish method for SNS Encodes the notification using Base64 then stringifies it before
-		// setting that as the message body for SNS. Do the inverse to retrieve the notification.
-		notificationBytes, err := base64.StdEncoding.DecodeString(valueString)
-		if err != nil {
-			logger.Errorf(context.Background(), "failed to Base64 decode from message string [%s] from message [%s] with err: %v", valueString, stringMsg, err)
-			p.systemMetrics.MessageDecodingError.Inc()
-			p.markMessageDone(msg)
-			continue
+		notificationBytes := msg.Message()
+		if !p.rawMessages {
+			var ok bool
+			if notificationBytes, ok = p.unwrapSNSMessage(msg.Message()); !ok {
+				p.markMessageDone(msg)
+				continue
+			}
 		}
 
 		if err = proto.Unmarshal(notificationBytes, &emailMessage); err != nil {
-			logger.Debugf(context.Background(), "failed to unmarshal to notification object from decoded string[%s] from message [%s] with err: %v", valueString, stringMsg, err)
+			logger.Debugf(context.Background(), "failed to unmarshal to notification object from message [%s] with err: %v", stringMsg, err)
 			p.systemMetrics.MessageDecodingError.Inc()
 			p.markMessageDone(msg)
 			continue
 		}
 
-		if err = p.email.SendEmail(context.Background(), emailMessage); err != nil {
+		if err = p.deliver(context.Background(), emailMessage, notificationBytes); err != nil {
 			p.systemMetrics.MessageProcessorError.Inc()
 			logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v", emailMessage.String(), err)
 		} else {
@@ -129,6 +116,120 @@ func (p *Processor) run() error {
 	return err
 }
 
+// Returns the notification published to SNS from the SQS message body. Reports false when the message is malformed.
+func (p *Processor) unwrapSNSMessage(message []byte) ([]byte, bool) {
+	var stringMsg = string(message)
+	// Amazon doesn't provide a struct that can be used to unmarshall into. A generic JSON struct is used in its place.
+	var snsJSONFormat map[string]interface{}
+
+	// At Lyft, SNS populates SQS. This results in the message body of SQS having the SNS message format.
+	// The message format is documented here: https://docs.aws.amazon.com/sns/latest/dg/sns-message-and-json-formats.html
+	// The notification published is stored in the message field after unmarshalling the SQS message.
+	if err := json.Unmarshal(message, &snsJSONFormat); err != nil {
+		p.systemMetrics.MessageDecodingError.Inc()
+		logger.Errorf(context.Background(), "failed to unmarshall JSON message [%s] from processor with err: %v", stringMsg, err)
+		return nil, false
+	}
+
+	var value interface{}
+	var ok bool
+	var valueString string
+
+	if value, ok = snsJSONFormat["Message"]; !ok {
+		logger.Errorf(context.Background(), "failed to retrieve message from unmarshalled JSON object [%s]", stringMsg)
+		p.systemMetrics.MessageDataError.Inc()
+		return nil, false
+	}
+
+	if valueString, ok = value.(string); !ok {
+		p.systemMetrics.MessageDataError.Inc()
+		logger.Errorf(context.Background(), "failed to retrieve notification message (in string format) from unmarshalled JSON object for message [%s]", stringMsg)
+		return nil, false
+	}
+
+	// The Publish method for SNS Encodes the notification using Base64 then stringifies it before
+	// setting that as the message body for SNS. Do the inverse to retrieve the notification.
+	notificationBytes, err := base64.StdEncoding.DecodeString(valueString)
+	if err != nil {
+		logger.Errorf(context.Background(), "failed to Base64 decode from message string [%s] from message [%s] with err: %v", valueString, stringMsg, err)
+		p.systemMetrics.MessageDecodingError.Inc()
+		return nil, false
+	}
+	return notificationBytes, true
+}
+
+// Looks up the delivery recorded when the notification was published. Notifications published without a recorded
+// delivery get a new one, which is assumed to be an email.
+func (p *Processor) getDelivery(ctx context.Context, emailMessage admin.EmailMessage,
+	notificationBytes []byte) (models.NotificationDelivery, error) {
+	delivery, err := p.deliveries.GetQueuedByDigest(ctx, transformers.NotificationMessageDigest(notificationBytes))
+	if err == nil {
+		return delivery, nil
+	}
+	if flyteAdminError, ok := err.(errors.FlyteAdminError); !ok || flyteAdminError.Code() != codes.NotFound {
+		return models.NotificationDelivery{}, err
+	}
+	return transformers.CreateNotificationDeliveryModel(nil, models.NotificationChannelEmail, &emailMessage)
+}
+
+func (p *Processor) recordDelivery(ctx context.Context, delivery *models.NotificationDelivery) {
+	var err error
+	if delivery.ID == 0 {
+		err = p.deliveries.Create(ctx, delivery)
+	} else {
+		err = p.deliveries.Update(ctx, *delivery)
+	}
+	if err != nil {
+		p.systemMetrics.DeliveryRecordError.Inc()
+		logger.Warningf(ctx, "failed to record notification delivery to [%s] with err: %v", delivery.Recipients, err)
+	}
+}
+
+// Sends the email, retrying failures with exponential backoff. When a delivery repo is configured the outcome of
+// every attempt is recorded. Failing to record an attempt never prevents the email from being sent.
+func (p *Processor) deliver(ctx context.Context, emailMessage admin.EmailMessage, notificationBytes []byte) error {
+	var delivery *models.NotificationDelivery
+	if p.deliveries != nil {
+		model, err := p.getDelivery(ctx, emailMessage, notificationBytes)
+		if err != nil {
+			p.systemMetrics.DeliveryRecordError.Inc()
+			logger.Warningf(ctx, "failed to look up notification delivery with err: %v", err)
+		} else {
+			delivery = &model
+		}
+	}
+
+	retryDelay := p.retryDelay
+	for attempt := 1; ; attempt++ {
+		err := p.email.SendEmail(ctx, emailMessage)
+		if delivery != nil {
+			attemptedAt := time.Now()
+			delivery.Attempts++
+			delivery.LastAttemptAt = &attemptedAt
+			switch {
+			case err == nil:
+				delivery.Status = models.NotificationDeliverySucceeded
+				delivery.Error = ""
+			case attempt < p.maxDeliveryAttempts:
+				delivery.Status = models.NotificationDeliveryRetrying
+				delivery.Error = err.Error()
+			default:
+				delivery.Status = models.NotificationDeliveryFailed
+				delivery.Error = err.Error()
+			}
+			p.recordDelivery(ctx, delivery)
+		}
+		if err == nil || attempt >= p.maxDeliveryAttempts {
+			return err
+		}
+		p.systemMetrics.MessageRetry.Inc()
+		logger.Infof(ctx, "failed to send email to [%v] on attempt %d, retrying in %v with err: %v",
+			emailMessage.RecipientsEmail, attempt, retryDelay, err)
+		time.Sleep(retryDelay)
+		retryDelay *= 2
+	}
+}
+
 func (p *Processor) markMessageDone(message pubsub.SubscriberMessage) {
 	if err := message.Done(); err != nil {
 		p.systemMetrics.MessageDoneError.Inc()
@@ -158,15 +259,44 @@ func newProcessorSystemMetrics(scope promutils.Scope) processorSystemMetrics {
 			"count of errors when interacting with notification processor"),
 		MessageSuccess: scope.MustNewCounter("message_ok",
 			"count of messages successfully processed by underlying notification mechanism"),
+		MessageRetry: scope.MustNewCounter("message_retry",
+			"count of retried attempts to deliver a message with the underlying notification mechanism"),
+		DeliveryRecordError: scope.MustNewCounter("delivery_record_error",
+			"count of errors when recording notification delivery attempts"),
 		ChannelClosedError: scope.MustNewCounter("channel_closed_error", "count of channel closing errors"),
 		StopError:          scope.MustNewCounter("stop_error", "count of errors in Stop() method"),
 	}
 }
 
-func NewProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, scope promutils.Scope) interfaces.Processor {
+// NewProcessor returns a processor which sends dequeued notifications with the emailer. The delivery repo is
+// optional; when nil no delivery history is recorded.
+func NewProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer,
+	deliveries repositoryInterfaces.NotificationDeliveryRepoInterface,
+	config runtimeInterfaces.NotificationsProcessorConfig, scope promutils.Scope) interfaces.Processor {
+	maxDeliveryAttempts := config.MaxDeliveryAttempts
+	if maxDeliveryAttempts <= 0 {
+		maxDeliveryAttempts = defaultMaxDeliveryAttempts
+	}
+	retryDelay := time.Duration(config.DeliveryRetryDelaySeconds) * time.Second
+	if retryDelay <= 0 {
+		retryDelay = defaultDeliveryRetryDelay
+	}
 	return &Processor{
-		sub:           sub,
-		email:         emailer,
-		systemMetrics: newProcessorSystemMetrics(scope.NewSubScope("processor")),
+		sub:                 sub,
+		email:               emailer,
+		deliveries:          deliveries,
+		maxDeliveryAttempts: maxDeliveryAttempts,
+		retryDelay:          retryDelay,
+		systemMetrics:       newProcessorSystemMetrics(scope.NewSubScope("processor")),
 	}
 }
+
+// Returns a processor for subscribers, such as Kafka, which receive the published notification as is rather than
+// wrapped in an SNS message.
+func NewRawMessageProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer,
+	deliveries repositoryInterfaces.NotificationDeliveryRepoInterface,
+	config runtimeInterfaces.NotificationsProcessorConfig, scope promutils.Scope) interfaces.Processor {
+	processor := NewProcessor(sub, emailer, deliveries, config, scope).(*Processor)
+	processor.rawMessages = true
+	return processor
+}
@@ -7,8 +7,17 @@ import (
 
 	"encoding/base64"
 
+	"github.com/NYTimes/gizmo/pubsub/pubsubtest"
 	"github.com/aws/aws-sdk-go/aws"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
 	"github.com/stretchr/testify/assert"
@@ -138,3 +147,123 @@ func TestProcessor_StopProcessingError(t *testing.T) {
 	testSubscriber.GivenStopError = stopError
 	assert.Equal(t, stopError, testProcessor.StopProcessing())
 }
+
+func newDeliveryProcessor(emailer *mocks.MockEmailer,
+	deliveries *repositoryMocks.MockNotificationDeliveryRepo) *Processor {
+	subscriber := &pubsubtest.TestSubscriber{
+		JSONMessages: []interface{}{testSubscriberMessage},
+	}
+	processor := NewProcessor(subscriber, emailer, deliveries, runtimeInterfaces.NotificationsProcessorConfig{
+		MaxDeliveryAttempts: 3,
+	}, promutils.NewTestScope()).(*Processor)
+	processor.retryDelay = 0
+	return processor
+}
+
+func TestProcessor_DeliveryRetried(t *testing.T) {
+	var attempts int
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("temporarily unavailable")
+		}
+		return nil
+	})
+	message, _ := proto.Marshal(&testEmail)
+	var recorded []models.NotificationDelivery
+	processor := newDeliveryProcessor(&emailer, &repositoryMocks.MockNotificationDeliveryRepo{
+		GetQueuedByDigestFunction: func(ctx context.Context, digest string) (models.NotificationDelivery, error) {
+			assert.Equal(t, transformers.NotificationMessageDigest(message), digest)
+			return models.NotificationDelivery{
+				ID:            1,
+				ExecutionName: "name",
+				Status:        models.NotificationDeliveryQueued,
+			}, nil
+		},
+		UpdateFunction: func(ctx context.Context, input models.NotificationDelivery) error {
+			recorded = append(recorded, input)
+			return nil
+		},
+	})
+
+	assert.Nil(t, processor.run())
+	assert.Equal(t, 3, attempts)
+	assert.Len(t, recorded, 3)
+	assert.Equal(t, models.NotificationDeliveryRetrying, recorded[0].Status)
+	assert.Equal(t, "temporarily unavailable", recorded[1].Error)
+	final := recorded[2]
+	assert.Equal(t, uint(1), final.ID)
+	assert.Equal(t, "name", final.ExecutionName)
+	assert.Equal(t, models.NotificationDeliverySucceeded, final.Status)
+	assert.Equal(t, 3, final.Attempts)
+	assert.Empty(t, final.Error)
+	assert.NotNil(t, final.LastAttemptAt)
+}
+
+func TestProcessor_DeliveryFailed(t *testing.T) {
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		return errors.New("error sending email")
+	})
+	var created *models.NotificationDelivery
+	var updated models.NotificationDelivery
+	processor := newDeliveryProcessor(&emailer, &repositoryMocks.MockNotificationDeliveryRepo{
+		GetQueuedByDigestFunction: func(ctx context.Context, digest string) (models.NotificationDelivery, error) {
+			return models.NotificationDelivery{}, adminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		},
+		CreateFunction: func(ctx context.Context, input *models.NotificationDelivery) error {
+			created = input
+			input.ID = 2
+			return nil
+		},
+		UpdateFunction: func(ctx context.Context, input models.NotificationDelivery) error {
+			updated = input
+			return nil
+		},
+	})
+
+	assert.Nil(t, processor.run())
+	assert.NotNil(t, created)
+	assert.Equal(t, "email", created.Channel)
+	assert.Empty(t, created.ExecutionName)
+	assert.Equal(t, uint(2), updated.ID)
+	assert.Equal(t, models.NotificationDeliveryFailed, updated.Status)
+	assert.Equal(t, 3, updated.Attempts)
+	assert.Equal(t, "error sending email", updated.Error)
+}
+
+func TestProcessor_DeliveryRecordError(t *testing.T) {
+	var sent bool
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		sent = true
+		return nil
+	})
+	processor := newDeliveryProcessor(&emailer, &repositoryMocks.MockNotificationDeliveryRepo{
+		GetQueuedByDigestFunction: func(ctx context.Context, digest string) (models.NotificationDelivery, error) {
+			return models.NotificationDelivery{}, adminErrors.NewFlyteAdminError(codes.Unavailable, "db down")
+		},
+	})
+
+	assert.Nil(t, processor.run())
+	assert.True(t, sent)
+}
+
+func TestRawMessageProcessor_StartProcessing(t *testing.T) {
+	initializeProcessor()
+	// Raw message subscribers, such as Kafka, receive the published notification as is.
+	testSubscriber.ProtoMessages = append(testSubscriber.ProtoMessages, &testEmail)
+	var sent bool
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		assert.True(t, proto.Equal(&testEmail, &email))
+		sent = true
+		return nil
+	})
+	processor := NewRawMessageProcessor(&testSubscriber, &emailer, nil,
+		runtimeInterfaces.NotificationsProcessorConfig{}, promutils.NewTestScope())
+
+	assert.Nil(t, processor.(*Processor).run())
+	assert.True(t, sent)
+}
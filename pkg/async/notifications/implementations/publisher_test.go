@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 
 	"encoding/base64"
 
@@ -48,7 +49,8 @@ var testSubscriberMessage = map[string]interface{}{
 var testSubscriber pubsubtest.TestSubscriber
 var mockSub pubsub.Subscriber = &testSubscriber
 var mockEmail mocks.MockEmailer
-var testProcessor = NewProcessor(mockSub, &mockEmail, promutils.NewTestScope())
+var testProcessor = NewProcessor(mockSub, &mockEmail, nil, runtimeInterfaces.NotificationsProcessorConfig{},
+	promutils.NewTestScope())
 
 // This method should be invoked before every test around Publisher.
 func initializePublisher() {
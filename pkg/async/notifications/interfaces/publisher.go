@@ -2,7 +2,9 @@ package interfaces
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -25,3 +27,25 @@ type Publisher interface {
 	// The notification type is inferred from the Notification object in the Execution Spec.
 	Publish(ctx context.Context, notificationType string, msg proto.Message) error
 }
+
+// The key audit entries of admin service requests are published with, as a google.protobuf.Struct.
+const AuditEventKey = "flyteadmin.audit.Message"
+
+type partitionKeyContextKey struct{}
+
+// Returns a context which asks publishers that support partitioning, such as Kafka, to publish messages under the
+// given key. Messages sharing a partition key are delivered in the order they were published.
+func WithPartitionKey(ctx context.Context, partitionKey string) context.Context {
+	return context.WithValue(ctx, partitionKeyContextKey{}, partitionKey)
+}
+
+// Returns the partition key set with WithPartitionKey, if any.
+func GetPartitionKey(ctx context.Context) (string, bool) {
+	partitionKey, ok := ctx.Value(partitionKeyContextKey{}).(string)
+	return partitionKey, ok && len(partitionKey) > 0
+}
+
+// Returns the partition key which keeps all messages for an execution in order.
+func ExecutionPartitionKey(executionID *core.WorkflowExecutionIdentifier) string {
+	return fmt.Sprintf("%s/%s/%s", executionID.GetProject(), executionID.GetDomain(), executionID.GetName())
+}
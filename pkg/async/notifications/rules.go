@@ -0,0 +1,157 @@
+package notifications
+
+import (
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const nodeSubjectFormat = "Notice: Node \"%s\" of execution \"%s\" in \"%s\" is %s."
+const taskSubjectFormat = "Notice: Task \"%s\" (attempt %d) of node \"%s\" in execution \"%s\" in \"%s\" is %s."
+const nodeBodyFormat = "Node \"%s\" of execution %s/%s/%s is %s."
+const taskBodyFormat = "Task \"%s\" (attempt %d) of node \"%s\" in execution %s/%s/%s is %s."
+const ruleErrorFormat = " It failed with error: [%s]."
+const ruleConsoleURLFormat = " See %s for details."
+
+// RuleChannel returns the channel a rule notifies, defaulting to email.
+func RuleChannel(rule runtimeInterfaces.NotificationRule) string {
+	if len(rule.Channel) == 0 {
+		return models.NotificationChannelEmail
+	}
+	return rule.Channel
+}
+
+func validateRule(rule runtimeInterfaces.NotificationRule, phases map[string]int32) error {
+	if len(rule.Phases) == 0 {
+		return fmt.Errorf("at least one phase is required")
+	}
+	for _, phase := range rule.Phases {
+		if _, ok := phases[phase]; !ok {
+			return fmt.Errorf("unrecognized phase [%s]", phase)
+		}
+	}
+	if len(rule.Recipients) == 0 {
+		return fmt.Errorf("at least one recipient is required")
+	}
+	switch RuleChannel(rule) {
+	case models.NotificationChannelEmail, models.NotificationChannelSlack, models.NotificationChannelPagerDuty:
+	default:
+		return fmt.Errorf("unrecognized channel [%s]", rule.Channel)
+	}
+	return nil
+}
+
+// ValidateRules checks that every rule names valid phases, a known channel and at least one recipient.
+func ValidateRules(rules runtimeInterfaces.NotificationRules) error {
+	for idx, rule := range rules.Nodes {
+		if len(rule.TaskName) > 0 || rule.MinRetryAttempt > 0 {
+			return fmt.Errorf("node rule %d: task name and minimum retry attempt only apply to task rules", idx)
+		}
+		if err := validateRule(rule, core.NodeExecution_Phase_value); err != nil {
+			return fmt.Errorf("node rule %d: %v", idx, err)
+		}
+	}
+	for idx, rule := range rules.Tasks {
+		if err := validateRule(rule, core.TaskExecution_Phase_value); err != nil {
+			return fmt.Errorf("task rule %d: %v", idx, err)
+		}
+	}
+	return nil
+}
+
+func matchesPhase(rule runtimeInterfaces.NotificationRule, phase string) bool {
+	for _, rulePhase := range rule.Phases {
+		if rulePhase == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchNodeRules returns the node rules which match a node execution reaching the phase.
+func MatchNodeRules(rules runtimeInterfaces.NotificationRules, nodeID string,
+	phase core.NodeExecution_Phase) []runtimeInterfaces.NotificationRule {
+	var matched []runtimeInterfaces.NotificationRule
+	for _, rule := range rules.Nodes {
+		if len(rule.NodeID) > 0 && rule.NodeID != nodeID {
+			continue
+		}
+		if matchesPhase(rule, phase.String()) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+// MatchTaskRules returns the task rules which match a task execution attempt reaching the phase.
+func MatchTaskRules(rules runtimeInterfaces.NotificationRules, nodeID, taskName string,
+	phase core.TaskExecution_Phase, retryAttempt uint32) []runtimeInterfaces.NotificationRule {
+	var matched []runtimeInterfaces.NotificationRule
+	for _, rule := range rules.Tasks {
+		if len(rule.NodeID) > 0 && rule.NodeID != nodeID {
+			continue
+		}
+		if len(rule.TaskName) > 0 && rule.TaskName != taskName {
+			continue
+		}
+		if retryAttempt < rule.MinRetryAttempt {
+			continue
+		}
+		if matchesPhase(rule, phase.String()) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+func appendErrorAndConsoleURL(body string, executionError *core.ExecutionError, consoleURL string,
+	executionID *core.WorkflowExecutionIdentifier) string {
+	if executionError != nil {
+		body += fmt.Sprintf(ruleErrorFormat, executionError.Message)
+	}
+	if url := executionConsoleURL(consoleURL, executionID); len(url) > 0 {
+		body += fmt.Sprintf(ruleConsoleURLFormat, url)
+	}
+	return body
+}
+
+// ToEmailMessageFromNodeExecutionEvent converts a node execution event which matched a notification rule into an
+// admin.EmailMessage proto.
+func ToEmailMessageFromNodeExecutionEvent(config runtimeInterfaces.NotificationsConfig,
+	rule runtimeInterfaces.NotificationRule, request admin.NodeExecutionEventRequest) *admin.EmailMessage {
+	nodeID := request.Event.Id.NodeId
+	executionID := request.Event.Id.ExecutionId
+	phase := request.Event.Phase.String()
+	body := fmt.Sprintf(nodeBodyFormat, nodeID, executionID.Project, executionID.Domain, executionID.Name, phase)
+	return &admin.EmailMessage{
+		SubjectLine:     fmt.Sprintf(nodeSubjectFormat, nodeID, executionID.Name, executionID.Domain, phase),
+		SenderEmail:     config.NotificationsEmailerConfig.Sender,
+		RecipientsEmail: rule.Recipients,
+		Body: appendErrorAndConsoleURL(body, request.Event.GetError(),
+			config.NotificationsEmailerConfig.ConsoleURL, executionID),
+	}
+}
+
+// ToEmailMessageFromTaskExecutionEvent converts a task execution event which matched a notification rule into an
+// admin.EmailMessage proto.
+func ToEmailMessageFromTaskExecutionEvent(config runtimeInterfaces.NotificationsConfig,
+	rule runtimeInterfaces.NotificationRule, request admin.TaskExecutionEventRequest) *admin.EmailMessage {
+	taskName := request.Event.TaskId.Name
+	attempt := request.Event.RetryAttempt
+	nodeID := request.Event.ParentNodeExecutionId.NodeId
+	executionID := request.Event.ParentNodeExecutionId.ExecutionId
+	phase := request.Event.Phase.String()
+	body := fmt.Sprintf(taskBodyFormat, taskName, attempt, nodeID,
+		executionID.Project, executionID.Domain, executionID.Name, phase)
+	return &admin.EmailMessage{
+		SubjectLine: fmt.Sprintf(taskSubjectFormat, taskName, attempt, nodeID, executionID.Name,
+			executionID.Domain, phase),
+		SenderEmail:     config.NotificationsEmailerConfig.Sender,
+		RecipientsEmail: rule.Recipients,
+		Body: appendErrorAndConsoleURL(body, request.Event.GetError(),
+			config.NotificationsEmailerConfig.ConsoleURL, executionID),
+	}
+}
@@ -0,0 +1,100 @@
+package notifications
+
+import (
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRules = runtimeInterfaces.NotificationRules{
+	Nodes: []runtimeInterfaces.NotificationRule{
+		{NodeID: "train", Phases: []string{"FAILED", "TIMED_OUT"}, Recipients: []string{"ml@example.com"}},
+		{Phases: []string{"FAILED"}, Channel: "slack", Recipients: []string{"alerts@example.slack.com"}},
+	},
+	Tasks: []runtimeInterfaces.NotificationRule{
+		{TaskName: "flaky", Phases: []string{"RUNNING"}, MinRetryAttempt: 3, Recipients: []string{"a@example.com"}},
+	},
+}
+
+var nodeExecutionID = &core.NodeExecutionIdentifier{
+	NodeId: "train",
+	ExecutionId: &core.WorkflowExecutionIdentifier{
+		Project: executionProjectValue,
+		Domain:  executionDomainValue,
+		Name:    executionNameValue,
+	},
+}
+
+func TestValidateRules(t *testing.T) {
+	assert.NoError(t, ValidateRules(testRules))
+	for _, rules := range []runtimeInterfaces.NotificationRules{
+		{Nodes: []runtimeInterfaces.NotificationRule{{Recipients: []string{"a@example.com"}}}},
+		{Nodes: []runtimeInterfaces.NotificationRule{{Phases: []string{"failed"}, Recipients: []string{"a@example.com"}}}},
+		{Nodes: []runtimeInterfaces.NotificationRule{{Phases: []string{"FAILED"}}}},
+		{Nodes: []runtimeInterfaces.NotificationRule{{Phases: []string{"FAILED"}, TaskName: "task", Recipients: []string{"a@example.com"}}}},
+		{Tasks: []runtimeInterfaces.NotificationRule{{Phases: []string{"TIMED_OUT"}, Recipients: []string{"a@example.com"}}}},
+		{Tasks: []runtimeInterfaces.NotificationRule{{Phases: []string{"FAILED"}, Channel: "sms", Recipients: []string{"a@example.com"}}}},
+	} {
+		assert.Error(t, ValidateRules(rules))
+	}
+}
+
+func TestMatchNodeRules(t *testing.T) {
+	assert.Len(t, MatchNodeRules(testRules, "train", core.NodeExecution_FAILED), 2)
+	matched := MatchNodeRules(testRules, "train", core.NodeExecution_TIMED_OUT)
+	assert.Len(t, matched, 1)
+	assert.Equal(t, "email", RuleChannel(matched[0]))
+	matched = MatchNodeRules(testRules, "evaluate", core.NodeExecution_FAILED)
+	assert.Len(t, matched, 1)
+	assert.Equal(t, "slack", RuleChannel(matched[0]))
+	assert.Empty(t, MatchNodeRules(testRules, "train", core.NodeExecution_SUCCEEDED))
+}
+
+func TestMatchTaskRules(t *testing.T) {
+	assert.Len(t, MatchTaskRules(testRules, "train", "flaky", core.TaskExecution_RUNNING, 3), 1)
+	assert.Empty(t, MatchTaskRules(testRules, "train", "flaky", core.TaskExecution_RUNNING, 2))
+	assert.Empty(t, MatchTaskRules(testRules, "train", "other", core.TaskExecution_RUNNING, 5))
+	assert.Empty(t, MatchTaskRules(testRules, "train", "flaky", core.TaskExecution_FAILED, 5))
+}
+
+func TestToEmailMessageFromNodeExecutionEvent(t *testing.T) {
+	config := runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Sender:     "no-reply@example.com",
+			ConsoleURL: "https://flyte.example.com/console/",
+		},
+	}
+	email := ToEmailMessageFromNodeExecutionEvent(config, testRules.Nodes[0], admin.NodeExecutionEventRequest{
+		Event: &event.NodeExecutionEvent{
+			Id:    nodeExecutionID,
+			Phase: core.NodeExecution_FAILED,
+			OutputResult: &event.NodeExecutionEvent_Error{
+				Error: &core.ExecutionError{Message: "out of memory"},
+			},
+		},
+	})
+	assert.Equal(t, `Notice: Node "train" of execution "e124" in "prod" is FAILED.`, email.SubjectLine)
+	assert.Equal(t, `Node "train" of execution proj/prod/e124 is FAILED. It failed with error: [out of memory].`+
+		` See https://flyte.example.com/console/projects/proj/domains/prod/executions/e124 for details.`, email.Body)
+	assert.Equal(t, "no-reply@example.com", email.SenderEmail)
+	assert.Equal(t, []string{"ml@example.com"}, email.RecipientsEmail)
+}
+
+func TestToEmailMessageFromTaskExecutionEvent(t *testing.T) {
+	email := ToEmailMessageFromTaskExecutionEvent(runtimeInterfaces.NotificationsConfig{}, testRules.Tasks[0],
+		admin.TaskExecutionEventRequest{
+			Event: &event.TaskExecutionEvent{
+				TaskId:                &core.Identifier{Name: "flaky"},
+				ParentNodeExecutionId: nodeExecutionID,
+				RetryAttempt:          3,
+				Phase:                 core.TaskExecution_RUNNING,
+			},
+		})
+	assert.Equal(t, `Notice: Task "flaky" (attempt 3) of node "train" in execution "e124" in "prod" is RUNNING.`,
+		email.SubjectLine)
+	assert.Equal(t, `Task "flaky" (attempt 3) of node "train" in execution proj/prod/e124 is RUNNING.`, email.Body)
+}
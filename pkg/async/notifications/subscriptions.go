@@ -0,0 +1,103 @@
+package notifications
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+func notificationChannel(notification *admin.Notification) (string, []string) {
+	switch {
+	case notification.GetEmail() != nil:
+		return models.NotificationChannelEmail, notification.GetEmail().GetRecipientsEmail()
+	case notification.GetPagerDuty() != nil:
+		return models.NotificationChannelPagerDuty, notification.GetPagerDuty().GetRecipientsEmail()
+	case notification.GetSlack() != nil:
+		return models.NotificationChannelSlack, notification.GetSlack().GetRecipientsEmail()
+	}
+	return "", nil
+}
+
+func hasPhase(phases []core.WorkflowExecution_Phase, phase core.WorkflowExecution_Phase) bool {
+	for _, candidate := range phases {
+		if candidate == phase {
+			return true
+		}
+	}
+	return false
+}
+
+func newChannelNotification(
+	channel string, phase core.WorkflowExecution_Phase, recipients []string) *admin.Notification {
+	notification := &admin.Notification{
+		Phases: []core.WorkflowExecution_Phase{phase},
+	}
+	switch channel {
+	case models.NotificationChannelPagerDuty:
+		notification.Type = &admin.Notification_PagerDuty{
+			PagerDuty: &admin.PagerDutyNotification{RecipientsEmail: recipients},
+		}
+	case models.NotificationChannelSlack:
+		notification.Type = &admin.Notification_Slack{
+			Slack: &admin.SlackNotification{RecipientsEmail: recipients},
+		}
+	default:
+		notification.Type = &admin.Notification_Email{
+			Email: &admin.EmailNotification{RecipientsEmail: recipients},
+		}
+	}
+	return notification
+}
+
+// SubscriptionNotifications returns the notifications owed to subscribers of a workflow execution phase, in addition to
+// those defined by the execution spec. Recipients already notified of the phase over the same channel by the spec are
+// left out, and the remaining subscribers are grouped into a single notification per channel.
+func SubscriptionNotifications(phase core.WorkflowExecution_Phase, specNotifications []*admin.Notification,
+	subscriptions []models.NotificationSubscription) []*admin.Notification {
+	notified := make(map[string]map[string]bool)
+	markNotified := func(channel, recipient string) bool {
+		if notified[channel] == nil {
+			notified[channel] = make(map[string]bool)
+		}
+		if notified[channel][recipient] {
+			return false
+		}
+		notified[channel][recipient] = true
+		return true
+	}
+	for _, notification := range specNotifications {
+		if !hasPhase(notification.Phases, phase) {
+			continue
+		}
+		channel, recipients := notificationChannel(notification)
+		for _, recipient := range recipients {
+			markNotified(channel, recipient)
+		}
+	}
+
+	var channels []string
+	recipientsByChannel := make(map[string][]string)
+	for _, subscription := range subscriptions {
+		subscribed := false
+		for _, subscribedPhase := range transformers.FromNotificationSubscriptionModelPhases(subscription) {
+			if subscribedPhase == phase.String() {
+				subscribed = true
+			}
+		}
+		if !subscribed || !markNotified(subscription.Channel, subscription.Recipient) {
+			continue
+		}
+		if _, ok := recipientsByChannel[subscription.Channel]; !ok {
+			channels = append(channels, subscription.Channel)
+		}
+		recipientsByChannel[subscription.Channel] = append(
+			recipientsByChannel[subscription.Channel], subscription.Recipient)
+	}
+
+	notifications := make([]*admin.Notification, 0, len(channels))
+	for _, channel := range channels {
+		notifications = append(notifications, newChannelNotification(channel, phase, recipientsByChannel[channel]))
+	}
+	return notifications
+}
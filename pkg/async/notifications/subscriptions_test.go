@@ -0,0 +1,57 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionNotifications(t *testing.T) {
+	specNotifications := []*admin.Notification{
+		{
+			Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+			Type: &admin.Notification_Email{
+				Email: &admin.EmailNotification{RecipientsEmail: []string{"spec@example.com"}},
+			},
+		},
+		{
+			Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_SUCCEEDED},
+			Type: &admin.Notification_Slack{
+				Slack: &admin.SlackNotification{RecipientsEmail: []string{"@oncall"}},
+			},
+		},
+	}
+	subscriptions := []models.NotificationSubscription{
+		// Already notified by the spec.
+		{Channel: models.NotificationChannelEmail, Recipient: "spec@example.com", Phases: "FAILED"},
+		{Channel: models.NotificationChannelEmail, Recipient: "a@example.com", Phases: "FAILED,TIMED_OUT"},
+		// The spec only notifies this recipient of successes.
+		{Channel: models.NotificationChannelSlack, Recipient: "@oncall", Phases: "FAILED"},
+		// Not subscribed to failures.
+		{Channel: models.NotificationChannelEmail, Recipient: "b@example.com", Phases: "SUCCEEDED"},
+		{Channel: models.NotificationChannelEmail, Recipient: "c@example.com", Phases: "FAILED"},
+		// Subscribed twice, at different scopes.
+		{Channel: models.NotificationChannelEmail, Recipient: "c@example.com", Phases: "FAILED", Workflow: "workflow"},
+	}
+
+	notifications := SubscriptionNotifications(core.WorkflowExecution_FAILED, specNotifications, subscriptions)
+	assert.Len(t, notifications, 2)
+	assert.True(t, proto.Equal(&admin.Notification{
+		Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+		Type: &admin.Notification_Email{
+			Email: &admin.EmailNotification{RecipientsEmail: []string{"a@example.com", "c@example.com"}},
+		},
+	}, notifications[0]))
+	assert.True(t, proto.Equal(&admin.Notification{
+		Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+		Type: &admin.Notification_Slack{
+			Slack: &admin.SlackNotification{RecipientsEmail: []string{"@oncall"}},
+		},
+	}, notifications[1]))
+
+	assert.Empty(t, SubscriptionNotifications(core.WorkflowExecution_RUNNING, specNotifications, subscriptions))
+}
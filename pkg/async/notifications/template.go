@@ -0,0 +1,262 @@
+package notifications
+
+import (
+	"bytes"
+	"fmt"
+	htmlTemplate "html/template"
+	"sort"
+	"strings"
+	textTemplate "text/template"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// The template key which applies to all phases that don't have a more specific template.
+const DefaultTemplateKey = "default"
+
+const maxInputValueLength = 256
+const executionURLFormat = "%s/projects/%s/domains/%s/executions/%s"
+
+// TemplateData is the value notification templates are executed against, e.g. {{ .Name }} or {{ .Labels.team }}.
+type TemplateData struct {
+	Project string
+	Domain  string
+	Name    string
+	// The lower-cased phase of the execution, e.g. "failed".
+	Phase string
+	// The error message and code of failed executions. Both are empty otherwise.
+	Error     string
+	ErrorCode string
+	StartedAt time.Time
+	UpdatedAt time.Time
+	Duration  time.Duration
+	// The principal which launched the execution.
+	Principal   string
+	Workflow    *core.Identifier
+	LaunchPlan  *core.Identifier
+	Labels      map[string]string
+	Annotations map[string]string
+	// A summary of the execution inputs, keyed by input name.
+	Inputs map[string]string
+	// Link to the execution in the Flyte console. Empty unless a console url is configured.
+	ConsoleURL string
+	// The complete execution, for anything not promoted above.
+	Execution *admin.Execution
+}
+
+var templateFuncs = map[string]interface{}{
+	"lower":    strings.ToLower,
+	"upper":    strings.ToUpper,
+	"truncate": truncate,
+}
+
+func truncate(length int, value string) string {
+	if len(value) <= length {
+		return value
+	}
+	return value[:length] + "..."
+}
+
+func primitiveToString(primitive *core.Primitive) string {
+	switch primitive.GetValue().(type) {
+	case *core.Primitive_Integer:
+		return fmt.Sprintf("%d", primitive.GetInteger())
+	case *core.Primitive_FloatValue:
+		return fmt.Sprintf("%v", primitive.GetFloatValue())
+	case *core.Primitive_StringValue:
+		return primitive.GetStringValue()
+	case *core.Primitive_Boolean:
+		return fmt.Sprintf("%t", primitive.GetBoolean())
+	case *core.Primitive_Datetime:
+		datetime, err := ptypes.Timestamp(primitive.GetDatetime())
+		if err != nil {
+			return primitive.GetDatetime().String()
+		}
+		return datetime.Format(time.RFC3339)
+	case *core.Primitive_Duration:
+		duration, err := ptypes.Duration(primitive.GetDuration())
+		if err != nil {
+			return primitive.GetDuration().String()
+		}
+		return duration.String()
+	}
+	return primitive.String()
+}
+
+func literalToString(literal *core.Literal) string {
+	switch {
+	case literal.GetScalar().GetPrimitive() != nil:
+		return primitiveToString(literal.GetScalar().GetPrimitive())
+	case literal.GetScalar().GetBlob() != nil:
+		return literal.GetScalar().GetBlob().Uri
+	case literal.GetScalar().GetSchema() != nil:
+		return literal.GetScalar().GetSchema().Uri
+	case literal.GetScalar().GetNoneType() != nil:
+		return "none"
+	case literal.GetCollection() != nil:
+		values := make([]string, len(literal.GetCollection().Literals))
+		for idx, item := range literal.GetCollection().Literals {
+			values[idx] = literalToString(item)
+		}
+		return fmt.Sprintf("[%s]", strings.Join(values, ", "))
+	case literal.GetMap() != nil:
+		keys := make([]string, 0, len(literal.GetMap().Literals))
+		for key := range literal.GetMap().Literals {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		values := make([]string, len(keys))
+		for idx, key := range keys {
+			values[idx] = fmt.Sprintf("%s: %s", key, literalToString(literal.GetMap().Literals[key]))
+		}
+		return fmt.Sprintf("{%s}", strings.Join(values, ", "))
+	}
+	return literal.String()
+}
+
+// Produces a short, human readable representation of each input in the literal map.
+func summarizeInputs(inputs *core.LiteralMap) map[string]string {
+	summary := make(map[string]string, len(inputs.GetLiterals()))
+	for name, literal := range inputs.GetLiterals() {
+		summary[name] = truncate(maxInputValueLength, literalToString(literal))
+	}
+	return summary
+}
+
+// NewTemplateData populates the values available to notification templates from an execution (with an up to date
+// closure), its inputs and the optionally configured console url.
+func NewTemplateData(execution *admin.Execution, inputs *core.LiteralMap, consoleURL string) TemplateData {
+	data := TemplateData{
+		Project:     execution.GetId().GetProject(),
+		Domain:      execution.GetId().GetDomain(),
+		Name:        execution.GetId().GetName(),
+		Phase:       strings.ToLower(execution.GetClosure().GetPhase().String()),
+		Error:       execution.GetClosure().GetError().GetMessage(),
+		ErrorCode:   execution.GetClosure().GetError().GetCode(),
+		Principal:   execution.GetSpec().GetMetadata().GetPrincipal(),
+		Workflow:    execution.GetClosure().GetWorkflowId(),
+		LaunchPlan:  execution.GetSpec().GetLaunchPlan(),
+		Labels:      execution.GetSpec().GetLabels().GetValues(),
+		Annotations: execution.GetSpec().GetAnnotations().GetValues(),
+		Inputs:      summarizeInputs(inputs),
+		Execution:   execution,
+	}
+	if execution.GetClosure().GetStartedAt() != nil {
+		data.StartedAt, _ = ptypes.Timestamp(execution.GetClosure().GetStartedAt())
+	}
+	if execution.GetClosure().GetUpdatedAt() != nil {
+		data.UpdatedAt, _ = ptypes.Timestamp(execution.GetClosure().GetUpdatedAt())
+	}
+	if execution.GetClosure().GetDuration() != nil {
+		data.Duration, _ = ptypes.Duration(execution.GetClosure().GetDuration())
+	}
+	data.ConsoleURL = executionConsoleURL(consoleURL, execution.GetId())
+	return data
+}
+
+// Returns the link to an execution in the Flyte console, or an empty string when no console url is configured.
+func executionConsoleURL(consoleURL string, id *core.WorkflowExecutionIdentifier) string {
+	if len(consoleURL) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(executionURLFormat, strings.TrimSuffix(consoleURL, "/"),
+		id.GetProject(), id.GetDomain(), id.GetName())
+}
+
+// SelectTemplate returns the template to use for notifying on the given phase. Template sources are consulted in
+// order, and for each source a phase-specific template takes precedence over the default one.
+func SelectTemplate(phase core.WorkflowExecution_Phase, sources ...map[string]runtimeInterfaces.NotificationTemplate) (
+	runtimeInterfaces.NotificationTemplate, bool) {
+	phaseKey := strings.ToLower(phase.String())
+	for _, templates := range sources {
+		if template, ok := templates[phaseKey]; ok {
+			return template, true
+		}
+		if template, ok := templates[DefaultTemplateKey]; ok {
+			return template, true
+		}
+	}
+	return runtimeInterfaces.NotificationTemplate{}, false
+}
+
+// ValidateTemplate verifies that both the subject and body of a notification template parse.
+func ValidateTemplate(template runtimeInterfaces.NotificationTemplate) error {
+	if _, err := textTemplate.New("subject").Funcs(templateFuncs).Parse(template.Subject); err != nil {
+		return fmt.Errorf("invalid subject: %w", err)
+	}
+	var err error
+	if template.HTML {
+		_, err = htmlTemplate.New("body").Funcs(templateFuncs).Parse(template.Body)
+	} else {
+		_, err = textTemplate.New("body").Funcs(templateFuncs).Parse(template.Body)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid body: %w", err)
+	}
+	return nil
+}
+
+func renderTextTemplate(name, text string, data TemplateData) (string, error) {
+	parsed, err := textTemplate.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var rendered bytes.Buffer
+	if err = parsed.Execute(&rendered, data); err != nil {
+		return "", err
+	}
+	return rendered.String(), nil
+}
+
+func renderHTMLTemplate(name, text string, data TemplateData) (string, error) {
+	parsed, err := htmlTemplate.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var rendered bytes.Buffer
+	if err = parsed.Execute(&rendered, data); err != nil {
+		return "", err
+	}
+	return rendered.String(), nil
+}
+
+// RenderTemplate executes the subject and body of a notification template against the template data.
+func RenderTemplate(template runtimeInterfaces.NotificationTemplate, data TemplateData) (
+	subject string, body string, err error) {
+	subject, err = renderTextTemplate("subject", template.Subject, data)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to render subject: %w", err)
+	}
+	if template.HTML {
+		body, err = renderHTMLTemplate("body", template.Body, data)
+	} else {
+		body, err = renderTextTemplate("body", template.Body, data)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to render body: %w", err)
+	}
+	return subject, body, nil
+}
+
+// Converts an email notification to an admin.EmailMessage proto whose subject and body are rendered from a
+// notification template.
+func ToEmailMessageFromTemplate(
+	config runtimeInterfaces.NotificationsConfig,
+	emailNotification admin.EmailNotification,
+	template runtimeInterfaces.NotificationTemplate,
+	data TemplateData) (*admin.EmailMessage, error) {
+	subject, body, err := RenderTemplate(template, data)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.EmailMessage{
+		SubjectLine:     subject,
+		SenderEmail:     config.NotificationsEmailerConfig.Sender,
+		RecipientsEmail: emailNotification.GetRecipientsEmail(),
+		Body:            body,
+	}, nil
+}
@@ -0,0 +1,173 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var templateInputs = &core.LiteralMap{
+	Literals: map[string]*core.Literal{
+		"count": {
+			Value: &core.Literal_Scalar{
+				Scalar: &core.Scalar{
+					Value: &core.Scalar_Primitive{
+						Primitive: &core.Primitive{Value: &core.Primitive_Integer{Integer: 4}},
+					},
+				},
+			},
+		},
+		"names": {
+			Value: &core.Literal_Collection{
+				Collection: &core.LiteralCollection{
+					Literals: []*core.Literal{
+						{
+							Value: &core.Literal_Scalar{
+								Scalar: &core.Scalar{
+									Value: &core.Scalar_Primitive{
+										Primitive: &core.Primitive{Value: &core.Primitive_StringValue{StringValue: "a"}},
+									},
+								},
+							},
+						},
+						{
+							Value: &core.Literal_Scalar{
+								Scalar: &core.Scalar{
+									Value: &core.Scalar_Primitive{
+										Primitive: &core.Primitive{Value: &core.Primitive_StringValue{StringValue: "<b>"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func getFailedExecution() *admin.Execution {
+	execution := proto.Clone(workflowExecution).(*admin.Execution)
+	startedAt := time.Date(2021, 8, 5, 10, 0, 0, 0, time.UTC)
+	execution.Closure.Phase = core.WorkflowExecution_FAILED
+	execution.Closure.StartedAt, _ = ptypes.TimestampProto(startedAt)
+	execution.Closure.Duration = ptypes.DurationProto(time.Minute)
+	execution.Closure.OutputResult = &admin.ExecutionClosure_Error{
+		Error: &core.ExecutionError{Code: "USER:Error", Message: "<script>oops</script>"},
+	}
+	execution.Spec.Labels = &admin.Labels{Values: map[string]string{"team": "flyte"}}
+	return execution
+}
+
+func TestNewTemplateData(t *testing.T) {
+	data := NewTemplateData(getFailedExecution(), templateInputs, "https://flyte.example.com/console/")
+	assert.Equal(t, executionProjectValue, data.Project)
+	assert.Equal(t, executionDomainValue, data.Domain)
+	assert.Equal(t, executionNameValue, data.Name)
+	assert.Equal(t, "failed", data.Phase)
+	assert.Equal(t, "<script>oops</script>", data.Error)
+	assert.Equal(t, "USER:Error", data.ErrorCode)
+	assert.Equal(t, time.Minute, data.Duration)
+	assert.Equal(t, 2021, data.StartedAt.Year())
+	assert.Equal(t, workflowNameValue, data.Workflow.Name)
+	assert.Equal(t, launchPlanNameValue, data.LaunchPlan.Name)
+	assert.Equal(t, "flyte", data.Labels["team"])
+	assert.Equal(t, map[string]string{"count": "4", "names": "[a, <b>]"}, data.Inputs)
+	assert.Equal(t, "https://flyte.example.com/console/projects/proj/domains/prod/executions/e124", data.ConsoleURL)
+}
+
+func TestNewTemplateData_NoConsoleURL(t *testing.T) {
+	data := NewTemplateData(workflowExecution, nil, "")
+	assert.Empty(t, data.ConsoleURL)
+	assert.Empty(t, data.Inputs)
+	assert.Equal(t, "succeeded", data.Phase)
+}
+
+func TestSelectTemplate(t *testing.T) {
+	overrides := map[string]runtimeInterfaces.NotificationTemplate{
+		"failed": {Subject: "override failed"},
+	}
+	configured := map[string]runtimeInterfaces.NotificationTemplate{
+		"failed":           {Subject: "config failed"},
+		DefaultTemplateKey: {Subject: "config default"},
+	}
+
+	template, ok := SelectTemplate(core.WorkflowExecution_FAILED, overrides, configured)
+	assert.True(t, ok)
+	assert.Equal(t, "override failed", template.Subject)
+
+	template, ok = SelectTemplate(core.WorkflowExecution_SUCCEEDED, overrides, configured)
+	assert.True(t, ok)
+	assert.Equal(t, "config default", template.Subject)
+
+	template, ok = SelectTemplate(core.WorkflowExecution_FAILED, nil, configured)
+	assert.True(t, ok)
+	assert.Equal(t, "config failed", template.Subject)
+
+	_, ok = SelectTemplate(core.WorkflowExecution_SUCCEEDED, overrides, nil)
+	assert.False(t, ok)
+}
+
+func TestRenderTemplate(t *testing.T) {
+	data := NewTemplateData(getFailedExecution(), templateInputs, "https://flyte.example.com")
+	template := runtimeInterfaces.NotificationTemplate{
+		Subject: "{{ .Name | upper }} {{ .Phase }} after {{ .Duration }}",
+		Body:    "Team {{ .Labels.team }}: {{ .Error }} ({{ .Inputs.names }}) {{ truncate 3 .ConsoleURL }}",
+	}
+	subject, body, err := RenderTemplate(template, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "E124 failed after 1m0s", subject)
+	assert.Equal(t, "Team flyte: <script>oops</script> ([a, <b>]) htt...", body)
+
+	template.HTML = true
+	subject, body, err = RenderTemplate(template, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "E124 failed after 1m0s", subject)
+	assert.Equal(t, "Team flyte: &lt;script&gt;oops&lt;/script&gt; ([a, &lt;b&gt;]) htt...", body)
+}
+
+func TestRenderTemplate_Errors(t *testing.T) {
+	data := NewTemplateData(workflowExecution, nil, "")
+	_, _, err := RenderTemplate(runtimeInterfaces.NotificationTemplate{Subject: "{{ .Name "}, data)
+	assert.EqualError(t, err, "failed to render subject: template: subject:1: unclosed action")
+
+	_, _, err = RenderTemplate(runtimeInterfaces.NotificationTemplate{Body: "{{ .Unknown }}"}, data)
+	assert.Contains(t, err.Error(), "failed to render body")
+}
+
+func TestValidateTemplate(t *testing.T) {
+	assert.NoError(t, ValidateTemplate(runtimeInterfaces.NotificationTemplate{
+		Subject: "{{ .Name }}",
+		Body:    "<p>{{ .Error }}</p>",
+		HTML:    true,
+	}))
+	assert.Error(t, ValidateTemplate(runtimeInterfaces.NotificationTemplate{Subject: "{{ .Name "}))
+	assert.Error(t, ValidateTemplate(runtimeInterfaces.NotificationTemplate{Body: "{{ unknownFunc }}"}))
+}
+
+func TestToEmailMessageFromTemplate(t *testing.T) {
+	notificationsConfig := runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Sender: "no-reply@example.com",
+		},
+	}
+	emailNotification := admin.EmailNotification{
+		RecipientsEmail: []string{"a@example.com"},
+	}
+	email, err := ToEmailMessageFromTemplate(notificationsConfig, emailNotification,
+		runtimeInterfaces.NotificationTemplate{Subject: "{{ .Project }}", Body: "{{ .Domain }}"},
+		NewTemplateData(workflowExecution, nil, ""))
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&admin.EmailMessage{
+		SubjectLine:     executionProjectValue,
+		SenderEmail:     "no-reply@example.com",
+		RecipientsEmail: []string{"a@example.com"},
+		Body:            executionDomainValue,
+	}, email))
+}
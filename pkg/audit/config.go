@@ -0,0 +1,49 @@
+package audit
+
+import (
+	"github.com/flyteorg/flytestdlib/config"
+)
+
+const configSectionKey = "audit"
+
+// The sinks audit entries can be written to.
+const (
+	// Writes entries through the general logger, as "Recording request: [...]" lines.
+	LoggerSink = "logger"
+	// Appends entries as JSON lines to a dedicated file.
+	FileSink = "file"
+	// Inserts entries into the audit_logs table of the admin database.
+	DatabaseSink = "database"
+	// Publishes entries through the external events publisher, when it is configured with the "audit" event type.
+	EventsSink = "events"
+)
+
+// The placeholder written in place of the values of redacted request parameters.
+const RedactedValue = "[REDACTED]"
+
+type Config struct {
+	// The sinks every audit entry is written to.
+	Sinks []string `json:"sinks"`
+	// The path of the file the file sink appends entries to.
+	FilePath string `json:"filePath"`
+	// Request parameters, by name, the values of which are replaced with a placeholder before entries are written.
+	RedactedParameters []string `json:"redactedParameters"`
+	// Methods, like those recording execution events, which the file, database and events sinks don't record entries
+	// for. The logger sink records entries for all methods.
+	ExcludedMethods []string `json:"excludedMethods"`
+	// Whether the file, database and events sinks record entries for read only requests, like those of Get and List
+	// methods, rather than for mutating requests only. The logger sink records entries for all requests.
+	RecordReadOnly bool `json:"recordReadOnly"`
+}
+
+var defaultConfig = &Config{
+	Sinks: []string{LoggerSink},
+	// Execution events are reported by propeller at a rate that would drown out the requests of users.
+	ExcludedMethods: []string{"CreateWorkflowEvent", "CreateNodeEvent", "CreateTaskEvent"},
+}
+
+var configSection = config.MustRegisterSection(configSectionKey, defaultConfig)
+
+func GetConfig() *Config {
+	return configSection.GetConfig().(*Config)
+}
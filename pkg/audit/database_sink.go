@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Inserts audit entries into the admin database.
+type databaseSink struct {
+	repo repoInterfaces.AuditLogRepoInterface
+}
+
+func newAuditLogModel(message Message) (models.AuditLog, error) {
+	parameters, err := json.Marshal(message.Request.Parameters)
+	if err != nil {
+		return models.AuditLog{}, err
+	}
+	model := models.AuditLog{
+		Subject:      message.Principal.Subject,
+		ClientID:     message.Principal.ClientID,
+		ClientIP:     message.Client.ClientIP,
+		Method:       message.Request.Method,
+		Mode:         int(message.Request.Mode),
+		Project:      message.Request.Parameters[Project],
+		Domain:       message.Request.Parameters[Domain],
+		Parameters:   parameters,
+		ResponseCode: message.Response.ResponseCode,
+		ReceivedAt:   message.Request.ReceivedAt,
+		SentAt:       message.Response.SentAt,
+	}
+	if !message.Principal.TokenIssuedAt.IsZero() {
+		tokenIssuedAt := message.Principal.TokenIssuedAt
+		model.TokenIssuedAt = &tokenIssuedAt
+	}
+	return model, nil
+}
+
+func (s databaseSink) Write(ctx context.Context, message Message) error {
+	model, err := newAuditLogModel(message)
+	if err != nil {
+		return err
+	}
+	return s.repo.Create(ctx, &model)
+}
+
+// NewDatabaseSink returns a sink inserting audit entries with the audit log repo.
+func NewDatabaseSink(repo repoInterfaces.AuditLogRepoInterface) Sink {
+	return databaseSink{repo: repo}
+}
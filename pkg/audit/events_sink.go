@@ -0,0 +1,34 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Publishes audit entries, encoded as a google.protobuf.Struct, through the external events publisher.
+type eventsSink struct {
+	publisher notificationInterfaces.Publisher
+}
+
+func (s eventsSink) Write(ctx context.Context, message Message) error {
+	raw, err := json.Marshal(&message)
+	if err != nil {
+		return err
+	}
+	var event structpb.Struct
+	if err = jsonpb.Unmarshal(bytes.NewReader(raw), &event); err != nil {
+		return err
+	}
+	return s.publisher.Publish(ctx, notificationInterfaces.AuditEventKey, &event)
+}
+
+// NewEventsSink returns a sink publishing audit entries through the external events publisher. Entries are only
+// published when the publisher is configured with the "audit" event type.
+func NewEventsSink(publisher notificationInterfaces.Publisher) Sink {
+	return eventsSink{publisher: publisher}
+}
@@ -0,0 +1,38 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Appends audit entries to a dedicated file, one JSON object per line.
+type fileSink struct {
+	lock sync.Mutex
+	file *os.File
+}
+
+func (s *fileSink) Write(_ context.Context, message Message) error {
+	line, err := json.Marshal(&message)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+// NewFileSink returns a sink appending audit entries to the file of the path, which is created if it doesn't exist.
+func NewFileSink(path string) (Sink, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("the file sink requires a file path")
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file [%s]: %w", path, err)
+	}
+	return &fileSink{file: file}, nil
+}
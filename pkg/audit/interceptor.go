@@ -0,0 +1,63 @@
+package audit
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"google.golang.org/grpc"
+)
+
+// Methods with these prefixes change the state of admin.
+var mutatingMethodPrefixes = []string{
+	"Create", "Update", "Delete", "Register", "Relaunch", "Recover", "Terminate", "Resend", "Revoke",
+}
+
+func isMutatingMethod(method string) bool {
+	for _, prefix := range mutatingMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns a context in which recording an audit entry is noted.
+func withRecorder(ctx context.Context) (context.Context, *int32) {
+	recorded := new(int32)
+	return context.WithValue(ctx, common.AuditRecordedContextKey, recorded), recorded
+}
+
+func markRecorded(ctx context.Context) {
+	if recorded, ok := ctx.Value(common.AuditRecordedContextKey).(*int32); ok {
+		atomic.StoreInt32(recorded, 1)
+	}
+}
+
+// UnaryServerInterceptor records an audit entry for every call of a mutating method, unless its handler recorded one
+// already, as the handlers of the admin service do with the parameters of their requests. Calls rejected by
+// interceptors further down the chain, like the authorizer, are recorded as well. Sinks may skip the entries of
+// excluded methods.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	method := path.Base(info.FullMethod)
+	if !isMutatingMethod(method) {
+		return handler(ctx, req)
+	}
+
+	requestedAt := time.Now()
+	ctx, recorded := withRecorder(ctx)
+	resp, err := handler(ctx, req)
+	if atomic.LoadInt32(recorded) == 0 {
+		NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+			method,
+			ParametersFromRequest(req),
+			ReadWrite,
+			requestedAt,
+		).WithResponse(time.Now(), err).Log(ctx)
+	}
+	return resp, err
+}
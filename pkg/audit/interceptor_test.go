@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsMutatingMethod(t *testing.T) {
+	assert.True(t, isMutatingMethod("TerminateExecution"))
+	assert.True(t, isMutatingMethod("UpdateProjectDomainAttributes"))
+	assert.True(t, isMutatingMethod("RegisterProject"))
+	assert.False(t, isMutatingMethod("GetExecution"))
+	assert.False(t, isMutatingMethod("ListProjects"))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	sink := &recordingSink{}
+	SetSink(sink)
+	defer SetSink(NewLoggerSink())
+
+	request := &admin.ExecutionTerminateRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}
+	call := func(method string, handler grpc.UnaryHandler) {
+		_, _ = UnaryServerInterceptor(context.Background(), request, &grpc.UnaryServerInfo{
+			FullMethod: "/flyteidl.service.AdminService/" + method,
+		}, handler)
+	}
+
+	t.Run("Record mutating requests", func(t *testing.T) {
+		sink.messages = nil
+		call("TerminateExecution", func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.PermissionDenied, "denied")
+		})
+		assert.Len(t, sink.messages, 1)
+		assert.Equal(t, "TerminateExecution", sink.messages[0].Request.Method)
+		assert.Equal(t, ReadWrite, sink.messages[0].Request.Mode)
+		assert.Equal(t, map[string]string{Project: "project", Domain: "domain", Name: "name"},
+			sink.messages[0].Request.Parameters)
+		assert.Equal(t, codes.PermissionDenied.String(), sink.messages[0].Response.ResponseCode)
+	})
+
+	t.Run("Requests recorded by handlers aren't recorded again", func(t *testing.T) {
+		sink.messages = nil
+		call("TerminateExecution", func(ctx context.Context, req interface{}) (interface{}, error) {
+			NewLogBuilder().WithRequest("TerminateExecution", map[string]string{}, ReadWrite, time.Now()).
+				WithResponse(time.Now(), nil).Log(ctx)
+			return nil, nil
+		})
+		assert.Len(t, sink.messages, 1)
+	})
+
+	t.Run("Read only requests aren't recorded", func(t *testing.T) {
+		sink.messages = nil
+		call("GetExecution", func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		assert.Empty(t, sink.messages)
+	})
+}
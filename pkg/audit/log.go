@@ -10,6 +10,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flytestdlib/logger"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type LogBuilder interface {
@@ -63,7 +64,12 @@ func (b *logBuilder) WithResponse(sentAt time.Time, err error) LogBuilder {
 		case errors.FlyteAdminError:
 			responseCode = err.(errors.FlyteAdminError).Code().String()
 		default:
-			responseCode = codes.Internal.String()
+			// Errors returned by interceptors, like failed authorization, carry a gRPC status.
+			if s, ok := status.FromError(err); ok {
+				responseCode = s.Code().String()
+			} else {
+				responseCode = codes.Internal.String()
+			}
 		}
 	}
 	b.auditLog.Response = Response{
@@ -73,14 +79,36 @@ func (b *logBuilder) WithResponse(sentAt time.Time, err error) LogBuilder {
 	return b
 }
 
-func (b *logBuilder) formatLogString(ctx context.Context) string {
-	auditLog, err := json.Marshal(&b.auditLog)
+func formatLogString(ctx context.Context, message Message) string {
+	auditLog, err := json.Marshal(&message)
 	if err != nil {
 		logger.Warningf(ctx, "Failed to marshal audit log to protobuf with err: %v", err)
 	}
 	return fmt.Sprintf("Recording request: [%s]", auditLog)
 }
 
+func (b *logBuilder) formatLogString(ctx context.Context) string {
+	return formatLogString(ctx, b.auditLog)
+}
+
+// Returns a copy of the message in which the values of the redacted parameters are replaced with a placeholder.
+func redact(message Message, redactedParameters []string) Message {
+	if len(redactedParameters) == 0 || len(message.Request.Parameters) == 0 {
+		return message
+	}
+	parameters := make(map[string]string, len(message.Request.Parameters))
+	for name, value := range message.Request.Parameters {
+		parameters[name] = value
+	}
+	for _, name := range redactedParameters {
+		if _, ok := parameters[name]; ok {
+			parameters[name] = RedactedValue
+		}
+	}
+	message.Request.Parameters = parameters
+	return message
+}
+
 func (b *logBuilder) Log(ctx context.Context) {
 	if b.readOnly {
 		logger.Warningf(ctx, "Attempting to record audit log for request: [%+v] more than once. Aborting.", b.auditLog.Request)
@@ -88,7 +116,11 @@ func (b *logBuilder) Log(ctx context.Context) {
 	defer func() {
 		b.readOnly = true
 	}()
-	logger.Info(ctx, b.formatLogString(ctx))
+	markRecorded(ctx)
+	message := redact(b.auditLog, GetConfig().RedactedParameters)
+	if err := getSink().Write(ctx, message); err != nil {
+		logger.Warningf(ctx, "Failed to record audit log for request: [%+v] with err: %v", message.Request, err)
+	}
 }
 
 func NewLogBuilder() LogBuilder {
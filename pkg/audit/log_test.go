@@ -34,3 +34,20 @@ func TestLogBuilderLog(t *testing.T) {
 		"\"2020-01-05T10:30:00Z\"},\"Response\":{\"ResponseCode\":\"AlreadyExists\",\"SentAt\":"+
 		"\"2020-01-05T10:31:00Z\"}}]", builder.(*logBuilder).formatLogString(context.TODO()))
 }
+
+func TestRedact(t *testing.T) {
+	message := Message{
+		Request: Request{
+			Parameters: map[string]string{
+				"project": "proj",
+				"token":   "secret",
+			},
+		},
+	}
+	redacted := redact(message, []string{"token", "missing"})
+	assert.Equal(t, map[string]string{
+		"project": "proj",
+		"token":   RedactedValue,
+	}, redacted.Request.Parameters)
+	assert.Equal(t, "secret", message.Request.Parameters["token"])
+}
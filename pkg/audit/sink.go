@@ -0,0 +1,112 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Sink records audit entries.
+type Sink interface {
+	Write(ctx context.Context, message Message) error
+}
+
+type loggerSink struct{}
+
+func (s loggerSink) Write(ctx context.Context, message Message) error {
+	logger.Info(ctx, formatLogString(ctx, message))
+	return nil
+}
+
+// NewLoggerSink returns a sink writing audit entries through the general logger.
+func NewLoggerSink() Sink {
+	return loggerSink{}
+}
+
+// Writes the entries which the config persists to the wrapped sink and drops the others.
+type filteredSink struct {
+	Sink
+	excludedMethods sets.String
+	recordReadOnly  bool
+}
+
+func (s filteredSink) Write(ctx context.Context, message Message) error {
+	if s.excludedMethods.Has(message.Request.Method) || (message.Request.Mode == ReadOnly && !s.recordReadOnly) {
+		return nil
+	}
+	return s.Sink.Write(ctx, message)
+}
+
+type multiSink []Sink
+
+// Write writes the message to all the sinks, even when some fail, and returns the first error.
+func (s multiSink) Write(ctx context.Context, message Message) error {
+	var firstErr error
+	for _, sink := range s {
+		if err := sink.Write(ctx, message); err != nil {
+			logger.Warningf(ctx, "Failed to write audit log for request [%s] to sink [%T] with err: %v",
+				message.Request.Method, sink, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// NewSink returns a sink writing to all the sinks of the config. The database and events sinks write entries to the
+// audit log repo and through the events publisher. All sinks but the logger sink skip the entries of excluded methods
+// and, unless configured otherwise, of read only requests, since they are written synchronously by every handler.
+func NewSink(cfg Config, repo repoInterfaces.AuditLogRepoInterface,
+	publisher notificationInterfaces.Publisher) (Sink, error) {
+	filter := func(sink Sink) Sink {
+		return filteredSink{
+			Sink:            sink,
+			excludedMethods: sets.NewString(cfg.ExcludedMethods...),
+			recordReadOnly:  cfg.RecordReadOnly,
+		}
+	}
+	sinks := make(multiSink, 0, len(cfg.Sinks))
+	for _, sinkType := range cfg.Sinks {
+		switch sinkType {
+		case LoggerSink:
+			sinks = append(sinks, NewLoggerSink())
+		case FileSink:
+			sink, err := NewFileSink(cfg.FilePath)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, filter(sink))
+		case DatabaseSink:
+			sinks = append(sinks, filter(NewDatabaseSink(repo)))
+		case EventsSink:
+			sinks = append(sinks, filter(NewEventsSink(publisher)))
+		default:
+			return nil, fmt.Errorf("unsupported audit log sink [%s]", sinkType)
+		}
+	}
+	return sinks, nil
+}
+
+var (
+	sinkLock sync.RWMutex
+	sink     Sink = NewLoggerSink()
+)
+
+// SetSink replaces the sink audit entries are written to, which defaults to the logger sink.
+func SetSink(s Sink) {
+	sinkLock.Lock()
+	defer sinkLock.Unlock()
+	sink = s
+}
+
+func getSink() Sink {
+	sinkLock.RLock()
+	defer sinkLock.RUnlock()
+	return sink
+}
@@ -0,0 +1,192 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var testMessage = Message{
+	Principal: Principal{
+		Subject:       "prince",
+		ClientID:      "12345",
+		TokenIssuedAt: time.Date(2020, time.January, 5, 10, 15, 0, 0, time.UTC),
+	},
+	Client: Client{
+		ClientIP: "192.0.2.1:25",
+	},
+	Request: Request{
+		Method: "TerminateExecution",
+		Parameters: map[string]string{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Mode:       ReadWrite,
+		ReceivedAt: time.Date(2020, time.January, 5, 10, 30, 0, 0, time.UTC),
+	},
+	Response: Response{
+		ResponseCode: "OK",
+		SentAt:       time.Date(2020, time.January, 5, 10, 31, 0, 0, time.UTC),
+	},
+}
+
+type recordingSink struct {
+	messages []Message
+	err      error
+}
+
+func (s *recordingSink) Write(_ context.Context, message Message) error {
+	s.messages = append(s.messages, message)
+	return s.err
+}
+
+func TestNewSink(t *testing.T) {
+	sink, err := NewSink(Config{Sinks: []string{LoggerSink, DatabaseSink, EventsSink}},
+		repositoryMocks.NewMockAuditLogRepo(), &notificationMocks.MockPublisher{})
+	assert.NoError(t, err)
+	assert.Len(t, sink, 3)
+	assert.NoError(t, sink.Write(context.Background(), testMessage))
+
+	_, err = NewSink(Config{Sinks: []string{"unknown"}}, nil, nil)
+	assert.Error(t, err)
+
+	_, err = NewSink(Config{Sinks: []string{FileSink}}, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestNewSink_Filter(t *testing.T) {
+	repo := repositoryMocks.NewMockAuditLogRepo()
+	var created []string
+	repo.(*repositoryMocks.MockAuditLogRepo).CreateFunction = func(ctx context.Context, input *models.AuditLog) error {
+		created = append(created, input.Method)
+		return nil
+	}
+	log := func(method string, mode AccessMode) {
+		NewLogBuilder().WithRequest(method, map[string]string{}, mode, time.Now()).
+			WithResponse(time.Now(), nil).Log(context.Background())
+	}
+
+	t.Run("Excluded and read only requests aren't persisted", func(t *testing.T) {
+		created = nil
+		sink, err := NewSink(Config{
+			Sinks:           []string{LoggerSink, DatabaseSink},
+			ExcludedMethods: []string{"CreateNodeEvent"},
+		}, repo, nil)
+		assert.NoError(t, err)
+		SetSink(sink)
+		defer SetSink(NewLoggerSink())
+
+		// Handlers record entries themselves, bypassing the interceptor.
+		log("CreateNodeEvent", ReadWrite)
+		log("GetExecution", ReadOnly)
+		log("TerminateExecution", ReadWrite)
+		assert.Equal(t, []string{"TerminateExecution"}, created)
+	})
+
+	t.Run("Read only requests are persisted when configured", func(t *testing.T) {
+		created = nil
+		sink, err := NewSink(Config{
+			Sinks:          []string{DatabaseSink},
+			RecordReadOnly: true,
+		}, repo, nil)
+		assert.NoError(t, err)
+		SetSink(sink)
+		defer SetSink(NewLoggerSink())
+
+		log("GetExecution", ReadOnly)
+		assert.Equal(t, []string{"GetExecution"}, created)
+	})
+}
+
+func TestMultiSink_Write(t *testing.T) {
+	failing := &recordingSink{err: errors.New("failed")}
+	succeeding := &recordingSink{}
+	err := multiSink{failing, succeeding}.Write(context.Background(), testMessage)
+	assert.EqualError(t, err, "failed")
+	assert.Len(t, failing.messages, 1)
+	assert.Len(t, succeeding.messages, 1)
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	sink, err := NewFileSink(path)
+	assert.NoError(t, err)
+	assert.NoError(t, sink.Write(context.Background(), testMessage))
+	assert.NoError(t, sink.Write(context.Background(), testMessage))
+
+	raw, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	assert.Len(t, lines, 2)
+	var written Message
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &written))
+	assert.Equal(t, testMessage, written)
+}
+
+func TestDatabaseSink(t *testing.T) {
+	repo := repositoryMocks.NewMockAuditLogRepo()
+	var created models.AuditLog
+	repo.(*repositoryMocks.MockAuditLogRepo).CreateFunction = func(ctx context.Context, input *models.AuditLog) error {
+		created = *input
+		return nil
+	}
+
+	assert.NoError(t, NewDatabaseSink(repo).Write(context.Background(), testMessage))
+	assert.Equal(t, "prince", created.Subject)
+	assert.Equal(t, "12345", created.ClientID)
+	assert.Equal(t, "192.0.2.1:25", created.ClientIP)
+	assert.Equal(t, testMessage.Principal.TokenIssuedAt, *created.TokenIssuedAt)
+	assert.Equal(t, "TerminateExecution", created.Method)
+	assert.Equal(t, int(ReadWrite), created.Mode)
+	assert.Equal(t, "project", created.Project)
+	assert.Equal(t, "domain", created.Domain)
+	assert.JSONEq(t, `{"project":"project","domain":"domain","name":"name"}`, string(created.Parameters))
+	assert.Equal(t, "OK", created.ResponseCode)
+	assert.Equal(t, testMessage.Request.ReceivedAt, created.ReceivedAt)
+	assert.Equal(t, testMessage.Response.SentAt, created.SentAt)
+}
+
+func TestEventsSink(t *testing.T) {
+	publisher := &notificationMocks.MockPublisher{}
+	var published proto.Message
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Equal(t, notificationInterfaces.AuditEventKey, key)
+		published = msg
+		return nil
+	})
+
+	assert.NoError(t, NewEventsSink(publisher).Write(context.Background(), testMessage))
+	event := published.(*structpb.Struct).AsMap()
+	assert.Equal(t, "prince", event["Principal"].(map[string]interface{})["Subject"])
+	assert.Equal(t, "TerminateExecution", event["Request"].(map[string]interface{})["Method"])
+}
+
+func TestSetSink(t *testing.T) {
+	sink := &recordingSink{}
+	SetSink(sink)
+	defer SetSink(NewLoggerSink())
+
+	NewLogBuilder().WithRequest("TerminateExecution", map[string]string{Project: "project"}, ReadWrite,
+		time.Now()).WithResponse(time.Now(), nil).Log(context.Background())
+	assert.Len(t, sink.messages, 1)
+	assert.Equal(t, "TerminateExecution", sink.messages[0].Request.Method)
+}
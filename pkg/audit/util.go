@@ -17,6 +17,7 @@ const (
 	NodeID       = "node_id"
 	RetryAttempt = "retry_attempt"
 	ResourceType = "resource"
+	Role         = "role"
 
 	TaskProject = "task_project"
 	TaskDomain  = "task_domain"
@@ -91,3 +92,62 @@ func ParametersFromTaskExecutionIdentifier(identifier *core.TaskExecutionIdentif
 	params[TaskVersion] = identifier.TaskId.Version
 	return params
 }
+
+type projectDomainGetter interface {
+	GetProject() string
+	GetDomain() string
+}
+
+type nameGetter interface {
+	GetName() string
+}
+
+type identifierGetter interface {
+	GetId() *core.Identifier
+}
+
+type namedEntityIdentifierGetter interface {
+	GetId() *admin.NamedEntityIdentifier
+}
+
+type executionIdentifierGetter interface {
+	GetId() *core.WorkflowExecutionIdentifier
+}
+
+// ParametersFromRequest returns the parameters identifying what a request applies to, as far as they can be inferred
+// from its type.
+func ParametersFromRequest(request interface{}) requestParameters {
+	switch r := request.(type) {
+	case identifierGetter:
+		return ParametersFromIdentifier(r.GetId())
+	case namedEntityIdentifierGetter:
+		return ParametersFromNamedEntityIdentifier(r.GetId())
+	case executionIdentifierGetter:
+		return ParametersFromExecutionIdentifier(r.GetId())
+	case projectDomainGetter:
+		parameters := requestParameters{
+			Project: r.GetProject(),
+			Domain:  r.GetDomain(),
+		}
+		if named, ok := request.(nameGetter); ok {
+			parameters[Name] = named.GetName()
+		}
+		return parameters
+	case *admin.ProjectRegisterRequest:
+		return requestParameters{
+			Project: r.GetProject().GetId(),
+		}
+	case *admin.ProjectDomainAttributesUpdateRequest:
+		return requestParameters{
+			Project: r.GetAttributes().GetProject(),
+			Domain:  r.GetAttributes().GetDomain(),
+		}
+	case *admin.WorkflowAttributesUpdateRequest:
+		return requestParameters{
+			Project: r.GetAttributes().GetProject(),
+			Domain:  r.GetAttributes().GetDomain(),
+			Name:    r.GetAttributes().GetWorkflow(),
+		}
+	}
+	return requestParameters{}
+}
@@ -104,3 +104,38 @@ func TestParametersFromTaskExecutionIdentifier(t *testing.T) {
 		RetryAttempt: 1,
 	}))
 }
+
+func TestParametersFromRequest(t *testing.T) {
+	assert.EqualValues(t, map[string]string{
+		"project": "proj",
+		"domain":  "development",
+		"name":    "foo",
+		"version": "123",
+	}, ParametersFromRequest(&admin.TaskCreateRequest{
+		Id: &core.Identifier{
+			Project: "proj",
+			Domain:  "development",
+			Name:    "foo",
+			Version: "123",
+		},
+	}))
+	assert.EqualValues(t, map[string]string{
+		"project": "proj",
+		"domain":  "development",
+		"name":    "foo",
+	}, ParametersFromRequest(&admin.ExecutionCreateRequest{
+		Project: "proj",
+		Domain:  "development",
+		Name:    "foo",
+	}))
+	assert.EqualValues(t, map[string]string{
+		"project": "proj",
+		"domain":  "development",
+	}, ParametersFromRequest(&admin.ProjectDomainAttributesUpdateRequest{
+		Attributes: &admin.ProjectDomainAttributes{
+			Project: "proj",
+			Domain:  "development",
+		},
+	}))
+	assert.Empty(t, ParametersFromRequest(&admin.GetVersionRequest{}))
+}
@@ -7,6 +7,7 @@ type CloudProvider = string
 const (
 	AWS   CloudProvider = "aws"
 	GCP   CloudProvider = "gcp"
+	Kafka CloudProvider = "kafka"
 	Local CloudProvider = "local"
 	None  CloudProvider = "none"
 )
@@ -8,6 +8,8 @@ var RuntimeVersionKey = contextutils.Key("runtime_version")
 const (
 	AuditFieldsContextKey contextutils.Key = "audit_fields"
 	PrincipalContextKey   contextutils.Key = "principal"
+	// Set by the audit interceptor to note whether the handler of a request recorded an audit entry.
+	AuditRecordedContextKey contextutils.Key = "audit_recorded"
 )
 
 const MaxResponseStatusBytes = 32000
@@ -4,6 +4,7 @@ package common
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flytestdlib/logger"
@@ -33,7 +34,7 @@ const (
 // String formats for various filter expression queries
 const (
 	joinArgsFormat          = "%s.%s"
-	containsQuery           = "%s LIKE ?"
+	containsQuery           = "%s LIKE ? ESCAPE '!'"
 	containsArgs            = "%%%s%%"
 	greaterThanQuery        = "%s > ?"
 	greaterThanOrEqualQuery = "%s >= ?"
@@ -44,6 +45,11 @@ const (
 	valueInQuery            = "%s in (?)"
 )
 
+// Escapes LIKE wildcards in contains filter values so they're matched literally. Databases disagree on the default LIKE
+// escape character (SQLite has none, and MySQL treats backslashes in string literals as escapes), so an explicit
+// escape character is used instead.
+var containsValueEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
 // Set of available filters which exclusively accept a single argument value.
 var singleValueFilters = map[FilterExpression]bool{
 	Contains:           true,
@@ -138,6 +144,8 @@ type InlineFilter interface {
 	GetEntity() Entity
 	// Returns the column filtered on.
 	GetField() string
+	// Returns the expression the column is filtered with.
+	GetExpression() FilterExpression
 	// Generates fields necessary to add a filter to a gorm database query.
 	GetGormQueryExpr() (GormQueryExpr, error)
 	// Generates fields necessary to add a filter on a gorm database join query.
@@ -163,6 +171,10 @@ func (f *inlineFilterImpl) GetField() string {
 	return f.field
 }
 
+func (f *inlineFilterImpl) GetExpression() FilterExpression {
+	return f.function
+}
+
 func (f *inlineFilterImpl) getGormQueryExpr(formattedField string) (GormQueryExpr, error) {
 
 	// ValueIn is special because it uses repeating values.
@@ -180,7 +192,7 @@ func (f *inlineFilterImpl) getGormQueryExpr(formattedField string) (GormQueryExp
 			// WHERE field LIKE %value%
 			Query: fmt.Sprintf(containsQuery, formattedField),
 			// args renders to something like: "%value%"
-			Args: fmt.Sprintf(containsArgs, f.value),
+			Args: fmt.Sprintf(containsArgs, containsValueEscaper.Replace(fmt.Sprintf("%v", f.value))),
 		}, nil
 	case GreaterThan:
 		return GormQueryExpr{
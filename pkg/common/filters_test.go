@@ -3,6 +3,8 @@ package common
 import (
 	"testing"
 
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite" // Required to import database driver.
 	"github.com/stretchr/testify/assert"
 )
 
@@ -97,7 +99,7 @@ func TestGetGormJoinTableQueryExpr(t *testing.T) {
 }
 
 var expectedQueriesForFilters = map[FilterExpression]string{
-	Contains:           "field LIKE ?",
+	Contains:           "field LIKE ? ESCAPE '!'",
 	GreaterThan:        "field > ?",
 	GreaterThanOrEqual: "field >= ?",
 	LessThan:           "field < ?",
@@ -140,6 +142,45 @@ func TestQueryExpressions(t *testing.T) {
 	assert.EqualValues(t, []string{"value"}, gormQueryExpr.Args)
 }
 
+func TestContainsEscapesWildcards(t *testing.T) {
+	filter, err := NewSingleValueFilter(Workflow, Contains, "name", "100%_done!")
+	assert.NoError(t, err)
+
+	gormQueryExpr, err := filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "%100!%!_done!!%", gormQueryExpr.Args)
+}
+
+// Contains filters used to pass their values to LIKE verbatim, so % and _ in a value acted as wildcards. They're now
+// matched literally, along with the ! escape character.
+func TestContainsMatchesWildcardsLiterally(t *testing.T) {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.NoError(t, db.Exec("CREATE TABLE workflows (name TEXT)").Error)
+	for _, name := range []string{"50%_off!", "50% off", "500_off!", "50%_off", "sale 50%_off! today"} {
+		assert.NoError(t, db.Exec("INSERT INTO workflows (name) VALUES (?)", name).Error)
+	}
+
+	for value, expectedNames := range map[string][]string{
+		"50%_off!": {"50%_off!", "sale 50%_off! today"},
+		"%":        {"50%_off!", "50% off", "50%_off", "sale 50%_off! today"},
+		"_off":     {"50%_off!", "500_off!", "50%_off", "sale 50%_off! today"},
+		"!":        {"50%_off!", "500_off!", "sale 50%_off! today"},
+		"0_":       {"500_off!"},
+	} {
+		filter, err := NewSingleValueFilter(Workflow, Contains, "name", value)
+		assert.NoError(t, err)
+		gormQueryExpr, err := filter.GetGormQueryExpr()
+		assert.NoError(t, err)
+
+		var names []string
+		assert.NoError(t, db.Table("workflows").Where(gormQueryExpr.Query, gormQueryExpr.Args).Pluck("name",
+			&names).Error)
+		assert.ElementsMatch(t, expectedNames, names, value)
+	}
+}
+
 func TestMapFilter(t *testing.T) {
 	mapFilterValue := map[string]interface{}{
 		"foo": "bar",
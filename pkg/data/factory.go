@@ -92,3 +92,11 @@ func GetRemoteDataHandler(cfg RemoteDataHandlerConfig) RemoteDataHandler {
 		}
 	}
 }
+
+// Returns a deleter for the blobs of the data store configured with the given config.
+func GetBlobDeleter(cfg *storage.Config) (interfaces.BlobDeleterInterface, error) {
+	if cfg.Type == storage.TypeMemory {
+		return implementations.NewNoopBlobDeleter(), nil
+	}
+	return implementations.NewStowBlobDeleter(cfg)
+}
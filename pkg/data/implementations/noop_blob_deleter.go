@@ -0,0 +1,21 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/data/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/storage"
+)
+
+// No-op implementation of a BlobDeleterInterface, for data stores which don't outlive the process.
+type NoopBlobDeleter struct{}
+
+func (n *NoopBlobDeleter) Delete(ctx context.Context, reference storage.DataReference) error {
+	logger.Debugf(ctx, "Not deleting blob [%s]", reference)
+	return nil
+}
+
+func NewNoopBlobDeleter() interfaces.BlobDeleterInterface {
+	return &NoopBlobDeleter{}
+}
@@ -0,0 +1,71 @@
+package implementations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/data/interfaces"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/graymeta/stow"
+	"github.com/graymeta/stow/s3"
+)
+
+// Deletes blobs through the stow location the data store is configured with.
+type StowBlobDeleter struct {
+	location stow.Location
+}
+
+func (d *StowBlobDeleter) Delete(ctx context.Context, reference storage.DataReference) error {
+	_, containerName, key, err := reference.Split()
+	if err != nil {
+		return err
+	}
+	container, err := d.location.Container(containerName)
+	if err != nil {
+		return err
+	}
+	// Item ids aren't necessarily the keys items were written with, the local kind for instance uses their paths.
+	item, err := container.Item(key)
+	if err == stow.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return container.RemoveItem(item.ID())
+}
+
+// Returns the stow kind and config of the data store, which are derived from the legacy connection config when the
+// stow section is missing, like the data store does.
+func getStowConfig(cfg *storage.Config) (string, stow.ConfigMap) {
+	if len(cfg.Stow.Kind) > 0 && len(cfg.Stow.Config) > 0 {
+		return cfg.Stow.Kind, cfg.Stow.Config
+	}
+	configMap := stow.ConfigMap{
+		s3.ConfigAuthType: cfg.Connection.AuthType,
+		s3.ConfigRegion:   cfg.Connection.Region,
+	}
+	if endpoint := cfg.Connection.Endpoint.String(); endpoint != "" {
+		configMap[s3.ConfigEndpoint] = endpoint
+	}
+	if cfg.Connection.AccessKey != "" {
+		configMap[s3.ConfigAccessKeyID] = cfg.Connection.AccessKey
+	}
+	if cfg.Connection.SecretKey != "" {
+		configMap[s3.ConfigSecretKey] = cfg.Connection.SecretKey
+	}
+	if cfg.Connection.DisableSSL {
+		configMap[s3.ConfigDisableSSL] = "True"
+	}
+	return s3.Kind, configMap
+}
+
+func NewStowBlobDeleter(cfg *storage.Config) (interfaces.BlobDeleterInterface, error) {
+	kind, configMap := getStowConfig(cfg)
+	location, err := stow.Dial(kind, configMap)
+	if err != nil {
+		return nil, fmt.Errorf("unable to configure blob deletion for %s: %v", kind, err)
+	}
+	return &StowBlobDeleter{
+		location: location,
+	}, nil
+}
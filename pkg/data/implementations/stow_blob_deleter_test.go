@@ -0,0 +1,34 @@
+package implementations
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/graymeta/stow/local"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStowBlobDeleterDelete(t *testing.T) {
+	dir := t.TempDir()
+	blob := filepath.Join(dir, "bucket", "closures", "blob")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(blob), 0700))
+	assert.NoError(t, ioutil.WriteFile(blob, []byte("closure"), 0600))
+
+	deleter, err := NewStowBlobDeleter(&storage.Config{
+		Stow: storage.StowConfig{
+			Kind:   local.Kind,
+			Config: map[string]string{local.ConfigKeyPath: dir},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, deleter.Delete(context.Background(), "local://bucket/closures/blob"))
+	_, err = os.Stat(blob)
+	assert.True(t, os.IsNotExist(err))
+
+	// Blobs which don't exist are deleted already.
+	assert.NoError(t, deleter.Delete(context.Background(), "local://bucket/closures/blob"))
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flytestdlib/storage"
+)
+
+// Defines an interface for deleting blobs, which the data store doesn't support.
+type BlobDeleterInterface interface {
+	// Deletes the blob. Deleting a blob which doesn't exist succeeds.
+	Delete(ctx context.Context, reference storage.DataReference) error
+}
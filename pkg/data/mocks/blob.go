@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/data/interfaces"
+	"github.com/flyteorg/flytestdlib/storage"
+)
+
+// Mock implementation of a BlobDeleterInterface
+type MockBlobDeleter struct {
+	DeleteCallback func(ctx context.Context, reference storage.DataReference) error
+}
+
+func (m *MockBlobDeleter) Delete(ctx context.Context, reference storage.DataReference) error {
+	if m.DeleteCallback != nil {
+		return m.DeleteCallback(ctx, reference)
+	}
+	return nil
+}
+
+func NewMockBlobDeleter() interfaces.BlobDeleterInterface {
+	return &MockBlobDeleter{}
+}
@@ -0,0 +1,264 @@
+package impl
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	authConfig "github.com/flyteorg/flyteadmin/auth/config"
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	defaultAccessTokenLifetime = 30 * 24 * time.Hour
+	maxAccessTokenLifetime     = 365 * 24 * time.Hour
+	// The number of random bytes access tokens are generated from.
+	accessTokenBytes = 32
+)
+
+type AccessTokenManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+	// Names the claims of the owner carried over to personal access tokens, so that roles bound to the groups and email
+	// domain of the owner apply to them.
+	claims authConfig.ClaimsConfig
+	// The IDs of the configured clients, which service accounts can't be named after since they authenticate as apps
+	// of the same ID.
+	clientIDs sets.String
+}
+
+func hashAccessToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func generateAccessToken() (string, error) {
+	secret := make([]byte, accessTokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return auth.AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// Returns the user, or the app when no user authenticated, that access tokens are created and listed for.
+func getAccessTokenOwner(identity auth.IdentityContext) string {
+	if len(identity.UserID()) > 0 {
+		return identity.UserID()
+	}
+	return identity.AppID()
+}
+
+// Returns the IDs of the clients the config declares, which service accounts would otherwise be able to impersonate in
+// role bindings and audit logs.
+func getConfiguredClientIDs(cfg *authConfig.Config) sets.String {
+	clientIDs := sets.NewString()
+	for id, client := range cfg.AppAuth.SelfAuthServer.StaticClients {
+		clientIDs.Insert(id)
+		if client != nil {
+			clientIDs.Insert(client.ID)
+		}
+	}
+	for _, id := range []string{cfg.AppAuth.ThirdParty.FlyteClientConfig.ClientID, cfg.UserAuth.OpenID.ClientID} {
+		if len(id) > 0 {
+			clientIDs.Insert(id)
+		}
+	}
+	return clientIDs
+}
+
+func toAccessToken(model models.AccessToken) interfaces.AccessToken {
+	return interfaces.AccessToken{
+		ID:             model.ID,
+		Name:           model.Name,
+		Owner:          model.Owner,
+		ServiceAccount: model.ServiceAccount,
+		Scopes:         strings.Fields(model.Scopes),
+		CreatedAt:      model.CreatedAt,
+		ExpiresAt:      model.ExpiresAt,
+		RevokedAt:      model.RevokedAt,
+	}
+}
+
+func (m *AccessTokenManager) getLifetime(expiresIn string) (time.Duration, error) {
+	tokensConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().AccessTokens
+	maxLifetime := tokensConfig.MaxLifetime.Duration
+	if maxLifetime == 0 {
+		maxLifetime = maxAccessTokenLifetime
+	}
+	if len(expiresIn) == 0 {
+		if tokensConfig.DefaultLifetime.Duration > 0 {
+			return tokensConfig.DefaultLifetime.Duration, nil
+		}
+		return defaultAccessTokenLifetime, nil
+	}
+	lifetime, err := time.ParseDuration(expiresIn)
+	if err != nil {
+		return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid expiresIn [%s]: %v", expiresIn, err)
+	}
+	if lifetime <= 0 || lifetime > maxLifetime {
+		return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"expiresIn [%s] must be positive and at most [%s]", expiresIn, maxLifetime)
+	}
+	return lifetime, nil
+}
+
+func (m *AccessTokenManager) CreateAccessToken(ctx context.Context, request interfaces.AccessTokenCreateRequest) (
+	*interfaces.AccessTokenCreateResponse, error) {
+	identity := auth.IdentityContextFromContext(ctx)
+	owner := getAccessTokenOwner(identity)
+	if len(owner) == 0 || (len(request.ServiceAccount) == 0 && len(identity.UserID()) == 0) {
+		return nil, errors.NewFlyteAdminErrorf(codes.Unauthenticated,
+			"personal access tokens can only be created by authenticated users")
+	}
+	// Otherwise a leaked token could be used to mint tokens which outlive its expiry and revocation.
+	if identity.IsAccessToken() {
+		return nil, errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+			"access tokens can't be created by callers authenticated with an access token")
+	}
+	if err := validation.ValidateAccessTokenCreateRequest(request, identity.Scopes()); err != nil {
+		return nil, err
+	}
+	if m.clientIDs.Has(request.ServiceAccount) {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"service account [%s] can't be named after a configured client", request.ServiceAccount)
+	}
+	lifetime, err := m.getLifetime(request.ExpiresIn)
+	if err != nil {
+		return nil, err
+	}
+	scopes := request.Scopes
+	if len(scopes) == 0 {
+		scopes = identity.Scopes().List()
+	}
+	token, err := generateAccessToken()
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to generate access token: %v", err)
+	}
+	model := models.AccessToken{
+		TokenHash:      hashAccessToken(token),
+		Name:           request.Name,
+		Owner:          owner,
+		ServiceAccount: request.ServiceAccount,
+		Scopes:         strings.Join(scopes, " "),
+		ExpiresAt:      time.Now().Add(lifetime),
+	}
+	// Service accounts are principals of their own, which don't inherit the group memberships of their owner.
+	if len(request.ServiceAccount) == 0 {
+		model.Claims, err = json.Marshal(auth.GetAuthorizationClaims(identity, m.claims))
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal access token claims: %v", err)
+		}
+		model.Email = identity.UserInfo().GetEmail()
+	}
+	if err := m.db.AccessTokenRepo().Create(ctx, &model); err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Created access token [%d] named [%s] for [%s]", model.ID, model.Name, owner)
+	return &interfaces.AccessTokenCreateResponse{
+		AccessToken: toAccessToken(model),
+		Token:       token,
+	}, nil
+}
+
+func (m *AccessTokenManager) ListAccessTokens(ctx context.Context, _ interfaces.AccessTokenListRequest) (
+	*interfaces.AccessTokenList, error) {
+	owner := getAccessTokenOwner(auth.IdentityContextFromContext(ctx))
+	if len(owner) == 0 {
+		return &interfaces.AccessTokenList{AccessTokens: []interfaces.AccessToken{}}, nil
+	}
+	tokenModels, err := m.db.AccessTokenRepo().List(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]interfaces.AccessToken, len(tokenModels))
+	for idx, model := range tokenModels {
+		tokens[idx] = toAccessToken(model)
+	}
+	return &interfaces.AccessTokenList{
+		AccessTokens: tokens,
+	}, nil
+}
+
+func (m *AccessTokenManager) RevokeAccessToken(ctx context.Context, request interfaces.AccessTokenRevokeRequest) (
+	*interfaces.AccessTokenRevokeResponse, error) {
+	if err := validation.ValidateAccessTokenRevokeRequest(request); err != nil {
+		return nil, err
+	}
+	existing, err := m.db.AccessTokenRepo().Get(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	// Tokens of other owners are reported as missing rather than forbidden, so their IDs aren't disclosed.
+	if existing.Owner != getAccessTokenOwner(auth.IdentityContextFromContext(ctx)) {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound, "access token [%d] not found", request.ID)
+	}
+	if existing.RevokedAt != nil {
+		return &interfaces.AccessTokenRevokeResponse{}, nil
+	}
+	if err = m.db.AccessTokenRepo().Revoke(ctx, request.ID, time.Now()); err != nil {
+		logger.Debugf(ctx, "Failed to revoke access token [%d] with err: %v", request.ID, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "Revoked access token [%d]", request.ID)
+	return &interfaces.AccessTokenRevokeResponse{}, nil
+}
+
+func (m *AccessTokenManager) ValidateAccessToken(ctx context.Context, tokenStr string) (
+	authInterfaces.IdentityContext, error) {
+	model, err := m.db.AccessTokenRepo().GetByHash(ctx, hashAccessToken(tokenStr))
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+			return nil, errors.NewFlyteAdminErrorf(codes.Unauthenticated, "invalid access token")
+		}
+		return nil, err
+	}
+	if model.RevokedAt != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Unauthenticated, "access token [%d] was revoked", model.ID)
+	}
+	if time.Now().After(model.ExpiresAt) {
+		return nil, errors.NewFlyteAdminErrorf(codes.Unauthenticated, "access token [%d] expired", model.ID)
+	}
+	scopes := sets.NewString(strings.Fields(model.Scopes)...)
+	if len(model.ServiceAccount) > 0 {
+		return auth.NewIdentityContext("", "", model.ServiceAccount, model.CreatedAt, scopes, nil).WithAccessToken(), nil
+	}
+	claims := map[string]interface{}{}
+	if len(model.Claims) > 0 {
+		if err = json.Unmarshal(model.Claims, &claims); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to unmarshal claims of access token [%d]: %v", model.ID, err)
+		}
+	}
+	userInfo := &service.UserInfoResponse{
+		Subject: model.Owner,
+		Email:   model.Email,
+	}
+	return auth.NewIdentityContext("", model.Owner, "", model.CreatedAt, scopes, userInfo).WithClaims(claims).
+		WithAccessToken(), nil
+}
+
+func NewAccessTokenManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	authCfg *authConfig.Config) interfaces.AccessTokenInterface {
+	return &AccessTokenManager{
+		db:        db,
+		config:    config,
+		claims:    authCfg.Authorization.Claims,
+		clientIDs: getConfiguredClientIDs(authCfg),
+	}
+}
@@ -0,0 +1,227 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	authConfig "github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/ory/fosite"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func getAccessTokenTestContext(userID, appID string, scopes ...string) context.Context {
+	identity := auth.NewIdentityContext("", userID, appID, time.Now(), sets.NewString(scopes...), nil)
+	return identity.WithContext(context.Background())
+}
+
+func TestCreateAccessToken(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var tokenHash string
+	repository.AccessTokenRepo().(*repositoryMocks.MockAccessTokenRepo).CreateFunction = func(
+		ctx context.Context, input *models.AccessToken) error {
+		assert.Equal(t, "ci", input.Name)
+		assert.Equal(t, "user", input.Owner)
+		assert.Empty(t, input.ServiceAccount)
+		assert.Equal(t, "executions:write registration:write", input.Scopes)
+		assert.WithinDuration(t, time.Now().Add(48*time.Hour), input.ExpiresAt, time.Minute)
+		assert.JSONEq(t, `{"groups":["ml-platform"]}`, string(input.Claims))
+		assert.Equal(t, "user@example.com", input.Email)
+		tokenHash = input.TokenHash
+		input.ID = 1
+		return nil
+	}
+	manager := NewAccessTokenManager(repository, getMockNotificationRuleConfig(), &authConfig.Config{
+		Authorization: authConfig.AuthorizationConfig{
+			Claims: authConfig.ClaimsConfig{Groups: "groups", Email: "email"},
+		},
+	})
+	identity := auth.NewIdentityContext("", "user", "", time.Now(), sets.NewString(auth.ScopeAll),
+		&service.UserInfoResponse{Email: "user@example.com"}).WithClaims(map[string]interface{}{
+		"sub":    "user",
+		"groups": []interface{}{"ml-platform"},
+	})
+	response, err := manager.CreateAccessToken(identity.WithContext(context.Background()),
+		managerInterfaces.AccessTokenCreateRequest{
+			Name:      "ci",
+			Scopes:    []string{auth.ScopeExecutionsWrite, auth.ScopeRegistrationWrite},
+			ExpiresIn: "48h",
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), response.ID)
+	assert.True(t, strings.HasPrefix(response.Token, auth.AccessTokenPrefix))
+	assert.Equal(t, hashAccessToken(response.Token), tokenHash)
+	assert.NotContains(t, tokenHash, response.Token)
+}
+
+func TestCreateAccessToken_ServiceAccount(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.AccessTokenRepo().(*repositoryMocks.MockAccessTokenRepo).CreateFunction = func(
+		ctx context.Context, input *models.AccessToken) error {
+		assert.Equal(t, "app", input.Owner)
+		assert.Equal(t, "ci-bot", input.ServiceAccount)
+		assert.Equal(t, auth.ScopeExecutionsRead, input.Scopes)
+		assert.WithinDuration(t, time.Now().Add(defaultAccessTokenLifetime), input.ExpiresAt, time.Minute)
+		assert.Empty(t, input.Claims)
+		return nil
+	}
+	manager := NewAccessTokenManager(repository, getMockNotificationRuleConfig(), &authConfig.Config{})
+	_, err := manager.CreateAccessToken(getAccessTokenTestContext("", "app", auth.ScopeExecutionsRead),
+		managerInterfaces.AccessTokenCreateRequest{
+			Name:           "ci",
+			ServiceAccount: "ci-bot",
+		})
+	assert.NoError(t, err)
+}
+
+func TestCreateAccessToken_ServiceAccountNamedAfterClient(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.AccessTokenRepo().(*repositoryMocks.MockAccessTokenRepo).CreateFunction = func(
+		ctx context.Context, input *models.AccessToken) error {
+		assert.Fail(t, "service account named after a client shouldn't be created")
+		return nil
+	}
+	cfg := &authConfig.Config{}
+	cfg.AppAuth.SelfAuthServer.StaticClients = map[string]*fosite.DefaultClient{
+		"flytepropeller": {ID: "flytepropeller"},
+	}
+	cfg.AppAuth.ThirdParty.FlyteClientConfig.ClientID = "flytectl"
+	cfg.UserAuth.OpenID.ClientID = "flyteconsole"
+	manager := NewAccessTokenManager(repository, getMockNotificationRuleConfig(), cfg)
+	for _, serviceAccount := range []string{"flytepropeller", "flytectl", "flyteconsole"} {
+		_, err := manager.CreateAccessToken(getAccessTokenTestContext("", "app", auth.ScopeAll),
+			managerInterfaces.AccessTokenCreateRequest{
+				Name:           "ci",
+				ServiceAccount: serviceAccount,
+			})
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code(), serviceAccount)
+	}
+}
+
+func TestCreateAccessToken_InvalidRequest(t *testing.T) {
+	manager := NewAccessTokenManager(repositoryMocks.NewMockRepository(), getMockNotificationRuleConfig(),
+		&authConfig.Config{})
+	ctx := getAccessTokenTestContext("user", "", auth.ScopeExecutionsRead)
+	for _, testCase := range []struct {
+		ctx     context.Context
+		request managerInterfaces.AccessTokenCreateRequest
+		code    codes.Code
+	}{
+		{context.Background(), managerInterfaces.AccessTokenCreateRequest{Name: "ci"}, codes.Unauthenticated},
+		{getAccessTokenTestContext("", "app", auth.ScopeAll), managerInterfaces.AccessTokenCreateRequest{Name: "ci"},
+			codes.Unauthenticated},
+		{ctx, managerInterfaces.AccessTokenCreateRequest{}, codes.InvalidArgument},
+		{ctx, managerInterfaces.AccessTokenCreateRequest{Name: "ci", Scopes: []string{"admin"}}, codes.InvalidArgument},
+		{ctx, managerInterfaces.AccessTokenCreateRequest{Name: "ci", Scopes: []string{auth.ScopeExecutionsWrite}},
+			codes.PermissionDenied},
+		{ctx, managerInterfaces.AccessTokenCreateRequest{Name: "ci", ExpiresIn: "a week"}, codes.InvalidArgument},
+		{ctx, managerInterfaces.AccessTokenCreateRequest{Name: "ci", ExpiresIn: "-1h"}, codes.InvalidArgument},
+		{ctx, managerInterfaces.AccessTokenCreateRequest{Name: "ci", ExpiresIn: "9000h"}, codes.InvalidArgument},
+		{auth.NewIdentityContext("", "user", "", time.Now(), sets.NewString(auth.ScopeAll), nil).WithAccessToken().
+			WithContext(context.Background()), managerInterfaces.AccessTokenCreateRequest{Name: "ci"},
+			codes.PermissionDenied},
+	} {
+		_, err := manager.CreateAccessToken(testCase.ctx, testCase.request)
+		assert.Equal(t, testCase.code, err.(errors.FlyteAdminError).Code(), "%+v", testCase.request)
+	}
+}
+
+func TestListAccessTokens(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.AccessTokenRepo().(*repositoryMocks.MockAccessTokenRepo).ListFunction = func(
+		ctx context.Context, owner string) ([]models.AccessToken, error) {
+		assert.Equal(t, "user", owner)
+		return []models.AccessToken{
+			{ID: 1, Name: "ci", Owner: "user", TokenHash: "hash", Scopes: "executions:read executions:write"},
+		}, nil
+	}
+	manager := NewAccessTokenManager(repository, getMockNotificationRuleConfig(), &authConfig.Config{})
+	tokens, err := manager.ListAccessTokens(getAccessTokenTestContext("user", ""),
+		managerInterfaces.AccessTokenListRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []managerInterfaces.AccessToken{
+		{ID: 1, Name: "ci", Owner: "user", Scopes: []string{auth.ScopeExecutionsRead, auth.ScopeExecutionsWrite}},
+	}, tokens.AccessTokens)
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.AccessTokenRepo().(*repositoryMocks.MockAccessTokenRepo).GetFunction = func(
+		ctx context.Context, id uint) (models.AccessToken, error) {
+		return models.AccessToken{ID: id, Owner: "user"}, nil
+	}
+	var revoked bool
+	repository.AccessTokenRepo().(*repositoryMocks.MockAccessTokenRepo).RevokeFunction = func(
+		ctx context.Context, id uint, revokedAt time.Time) error {
+		assert.Equal(t, uint(1), id)
+		revoked = true
+		return nil
+	}
+	manager := NewAccessTokenManager(repository, getMockNotificationRuleConfig(), &authConfig.Config{})
+	_, err := manager.RevokeAccessToken(getAccessTokenTestContext("other", ""),
+		managerInterfaces.AccessTokenRevokeRequest{ID: 1})
+	assert.Equal(t, codes.NotFound, err.(errors.FlyteAdminError).Code())
+	assert.False(t, revoked)
+
+	_, err = manager.RevokeAccessToken(getAccessTokenTestContext("user", ""),
+		managerInterfaces.AccessTokenRevokeRequest{ID: 1})
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+
+	_, err = manager.RevokeAccessToken(getAccessTokenTestContext("user", ""),
+		managerInterfaces.AccessTokenRevokeRequest{})
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
+
+func TestValidateAccessToken(t *testing.T) {
+	revokedAt := time.Now()
+	tokens := map[string]models.AccessToken{
+		hashAccessToken("personal"): {ID: 1, Owner: "user", Scopes: "all", ExpiresAt: time.Now().Add(time.Hour),
+			Claims: []byte(`{"groups":["ml-platform"]}`), Email: "user@example.com"},
+		hashAccessToken("service"): {ID: 2, Owner: "user", ServiceAccount: "ci-bot", Scopes: "executions:read",
+			ExpiresAt: time.Now().Add(time.Hour)},
+		hashAccessToken("expired"): {ID: 3, Owner: "user", ExpiresAt: time.Now().Add(-time.Hour)},
+		hashAccessToken("revoked"): {ID: 4, Owner: "user", ExpiresAt: time.Now().Add(time.Hour),
+			RevokedAt: &revokedAt},
+	}
+	repository := repositoryMocks.NewMockRepository()
+	repository.AccessTokenRepo().(*repositoryMocks.MockAccessTokenRepo).GetByHashFunction = func(
+		ctx context.Context, tokenHash string) (models.AccessToken, error) {
+		if token, ok := tokens[tokenHash]; ok {
+			return token, nil
+		}
+		return models.AccessToken{}, errors.NewFlyteAdminErrorf(codes.NotFound, "access token not found")
+	}
+	manager := NewAccessTokenManager(repository, getMockNotificationRuleConfig(), &authConfig.Config{})
+
+	identity, err := manager.ValidateAccessToken(context.Background(), "personal")
+	assert.NoError(t, err)
+	assert.Equal(t, "user", identity.UserID())
+	assert.Empty(t, identity.AppID())
+	assert.True(t, identity.Scopes().Has(auth.ScopeAll))
+	assert.True(t, identity.(auth.IdentityContext).IsAccessToken())
+	// Roles bound to the groups and email domain of the owner apply to their personal access tokens.
+	assert.Equal(t, map[string]interface{}{"groups": []interface{}{"ml-platform"}}, identity.Claims())
+	assert.Equal(t, "user@example.com", identity.UserInfo().GetEmail())
+
+	identity, err = manager.ValidateAccessToken(context.Background(), "service")
+	assert.NoError(t, err)
+	assert.Empty(t, identity.UserID())
+	assert.Equal(t, "ci-bot", identity.AppID())
+	assert.Equal(t, []string{auth.ScopeExecutionsRead}, identity.Scopes().List())
+	assert.Empty(t, identity.Claims())
+
+	for _, token := range []string{"expired", "revoked", "unknown"} {
+		_, err = manager.ValidateAccessToken(context.Background(), token)
+		assert.Equal(t, codes.Unauthenticated, err.(errors.FlyteAdminError).Code(), token)
+	}
+}
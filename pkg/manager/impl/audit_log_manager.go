@@ -0,0 +1,100 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+// Lists the audit log entries persisted by the database audit sink.
+type AuditLogManager struct {
+	db repositories.RepositoryInterface
+}
+
+func toAuditLogEntry(ctx context.Context, model models.AuditLog) interfaces.AuditLogEntry {
+	entry := interfaces.AuditLogEntry{
+		ID:            model.ID,
+		Subject:       model.Subject,
+		ClientID:      model.ClientID,
+		TokenIssuedAt: model.TokenIssuedAt,
+		ClientIP:      model.ClientIP,
+		Method:        model.Method,
+		Mode:          "ReadOnly",
+		Project:       model.Project,
+		Domain:        model.Domain,
+		ResponseCode:  model.ResponseCode,
+		ReceivedAt:    model.ReceivedAt,
+		SentAt:        model.SentAt,
+	}
+	if audit.AccessMode(model.Mode) == audit.ReadWrite {
+		entry.Mode = "ReadWrite"
+	}
+	if len(model.Parameters) > 0 {
+		if err := json.Unmarshal(model.Parameters, &entry.Parameters); err != nil {
+			logger.Infof(ctx, "failed to read parameters of audit log entry [%d] with err: %v", model.ID, err)
+		}
+	}
+	return entry
+}
+
+func (m *AuditLogManager) ListAuditLogs(
+	ctx context.Context, request interfaces.AuditLogListRequest) (*interfaces.AuditLogList, error) {
+	if err := validation.ValidateAuditLogListRequest(request); err != nil {
+		return nil, err
+	}
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project:        request.Project,
+		Domain:         request.Domain,
+		RequestFilters: request.Filters,
+	}, common.AuditLog)
+	if err != nil {
+		return nil, err
+	}
+	if err = validation.ValidateAuditLogFilters(filters); err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListAuditLogs", request.Token)
+	}
+	auditLogModels, err := m.db.AuditLogRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list audit logs for request [%+v] with err %v", request, err)
+		return nil, err
+	}
+	auditLogs := make([]interfaces.AuditLogEntry, len(auditLogModels))
+	for idx, model := range auditLogModels {
+		auditLogs[idx] = toAuditLogEntry(ctx, model)
+	}
+	var token string
+	if len(auditLogModels) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(auditLogModels))
+	}
+	return &interfaces.AuditLogList{
+		AuditLogs: auditLogs,
+		Token:     token,
+	}, nil
+}
+
+func NewAuditLogManager(db repositories.RepositoryInterface) interfaces.AuditLogInterface {
+	return &AuditLogManager{
+		db: db,
+	}
+}
@@ -0,0 +1,91 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListAuditLogs(t *testing.T) {
+	receivedAt := time.Date(2021, time.October, 4, 12, 0, 0, 0, time.UTC)
+	repository := repositoryMocks.NewMockRepository()
+	repository.AuditLogRepo().(*repositoryMocks.MockAuditLogRepo).ListFunction = func(
+		ctx context.Context, input repositoryInterfaces.ListResourceInput) ([]models.AuditLog, error) {
+		assert.Equal(t, 2, input.Limit)
+		assert.Equal(t, 2, input.Offset)
+		assert.Len(t, input.InlineFilters, 4)
+		fields := make([]string, len(input.InlineFilters))
+		for idx, filter := range input.InlineFilters {
+			fields[idx] = filter.GetField()
+		}
+		assert.Equal(t, []string{"project", "domain", "method", "received_at"}, fields)
+		return []models.AuditLog{
+			{ID: 4, Subject: "alice", Method: "TerminateExecution", Mode: 1, Project: "project", Domain: "domain",
+				Parameters: []byte(`{"name":"execution"}`), ResponseCode: "OK", ReceivedAt: receivedAt},
+			{ID: 3, Subject: "bob", Method: "TerminateExecution", Mode: 1, Project: "project", Domain: "domain",
+				ResponseCode: "NotFound", ReceivedAt: receivedAt},
+		}, nil
+	}
+	manager := NewAuditLogManager(repository)
+	auditLogs, err := manager.ListAuditLogs(context.Background(), managerInterfaces.AuditLogListRequest{
+		Project: "project",
+		Domain:  "domain",
+		Filters: "eq(method,TerminateExecution)+gte(received_at,2021-10-01T00:00:00Z)",
+		Limit:   2,
+		Token:   "2",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, auditLogs.AuditLogs, 2)
+	assert.Equal(t, "4", auditLogs.Token)
+	assert.Equal(t, managerInterfaces.AuditLogEntry{
+		ID:           4,
+		Subject:      "alice",
+		Method:       "TerminateExecution",
+		Mode:         "ReadWrite",
+		Project:      "project",
+		Domain:       "domain",
+		Parameters:   map[string]string{"name": "execution"},
+		ResponseCode: "OK",
+		ReceivedAt:   receivedAt,
+	}, auditLogs.AuditLogs[0])
+}
+
+func TestListAuditLogs_LastPage(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.AuditLogRepo().(*repositoryMocks.MockAuditLogRepo).ListFunction = func(
+		ctx context.Context, input repositoryInterfaces.ListResourceInput) ([]models.AuditLog, error) {
+		assert.Empty(t, input.InlineFilters)
+		return []models.AuditLog{{ID: 1, Subject: "alice"}}, nil
+	}
+	manager := NewAuditLogManager(repository)
+	auditLogs, err := manager.ListAuditLogs(context.Background(), managerInterfaces.AuditLogListRequest{
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, auditLogs.AuditLogs, 1)
+	assert.Equal(t, "ReadOnly", auditLogs.AuditLogs[0].Mode)
+	assert.Empty(t, auditLogs.Token)
+}
+
+func TestListAuditLogs_InvalidRequest(t *testing.T) {
+	manager := NewAuditLogManager(repositoryMocks.NewMockRepository())
+	for _, request := range []managerInterfaces.AuditLogListRequest{
+		{Domain: "domain", Limit: 10},
+		{Project: "project"},
+		{Limit: 10, Token: "-1"},
+		{Limit: 10, Filters: "eq(parameters,secret)"},
+		{Limit: 10, Filters: "eq(project_name,project)"},
+		{Limit: 10, Filters: "gte(received_at,yesterday)"},
+	} {
+		_, err := manager.ListAuditLogs(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code(), "%+v", request)
+	}
+}
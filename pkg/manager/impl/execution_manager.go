@@ -33,6 +33,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
 	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
@@ -60,6 +61,9 @@ type executionSystemMetrics struct {
 	ExecutionEventsCreated   prometheus.Counter
 	PropellerFailures        prometheus.Counter
 	PublishNotificationError prometheus.Counter
+	TemplateRenderError      prometheus.Counter
+	DeliveryRecordError      prometheus.Counter
+	SubscriptionLookupError  prometheus.Counter
 	TransformerError         prometheus.Counter
 	UnexpectedDataError      prometheus.Counter
 	SpecSizeBytes            prometheus.Summary
@@ -1217,17 +1221,11 @@ func (m *ExecutionManager) emitOverallWorkflowExecutionTime(
 	watch.Observe(*executionModel.ExecutionCreatedAt, terminalEventTime)
 }
 
-func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admin.WorkflowExecutionEventRequest) (
-	*admin.WorkflowExecutionEventResponse, error) {
-	err := validation.ValidateCreateWorkflowEventRequest(request)
-	if err != nil {
-		logger.Debugf(ctx, "received invalid CreateWorkflowEventRequest [%s]: %v", request.RequestId, err)
-		return nil, err
-	}
-	ctx = getExecutionContext(ctx, request.Event.ExecutionId)
-	logger.Debugf(ctx, "Received workflow execution event for [%+v] transitioning to phase [%v]",
-		request.Event.ExecutionId, request.Event.Phase)
-
+// Reads the execution, validates the phase transition of the event and updates the execution with it. The update fails
+// with a concurrent update error when the execution was updated since it was read, in which case the phase transition
+// should be validated again.
+func (m *ExecutionManager) updateExecutionWithEvent(ctx context.Context, request admin.WorkflowExecutionEventRequest,
+	outboxEvent *models.OutboxEvent) (*models.Execution, error) {
 	executionModel, err := util.GetExecutionModel(ctx, m.db, *request.Event.ExecutionId)
 	if err != nil {
 		logger.Debugf(ctx, "failed to find execution [%+v] for recorded event [%s]: %v",
@@ -1255,12 +1253,42 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 			request.Event.ExecutionId, err)
 		return nil, err
 	}
-	err = m.db.ExecutionRepo().Update(ctx, *executionModel)
+	if outboxEvent != nil {
+		err = m.db.ExecutionRepo().UpdateWithOutboxEvent(ctx, *executionModel, *outboxEvent)
+	} else {
+		err = m.db.ExecutionRepo().Update(ctx, *executionModel)
+	}
 	if err != nil {
 		logger.Debugf(ctx, "Failed to update execution with CreateWorkflowEvent [%+v] with err %v",
 			request, err)
 		return nil, err
 	}
+	return executionModel, nil
+}
+
+func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admin.WorkflowExecutionEventRequest) (
+	*admin.WorkflowExecutionEventResponse, error) {
+	err := validation.ValidateCreateWorkflowEventRequest(request)
+	if err != nil {
+		logger.Debugf(ctx, "received invalid CreateWorkflowEventRequest [%s]: %v", request.RequestId, err)
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.Event.ExecutionId)
+	logger.Debugf(ctx, "Received workflow execution event for [%+v] transitioning to phase [%v]",
+		request.Event.ExecutionId, request.Event.Phase)
+
+	outboxEvent, err := util.NewOutboxEvent(m.config, request.Event.ExecutionId, &request)
+	if err != nil {
+		return nil, err
+	}
+	var executionModel *models.Execution
+	err = util.RetryOnConcurrentUpdate(ctx, func() error {
+		executionModel, err = m.updateExecutionWithEvent(ctx, request, outboxEvent)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 	m.dbEventWriter.Write(request)
 
 	if request.Event.Phase == core.WorkflowExecution_RUNNING {
@@ -1283,9 +1311,12 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 			return nil, err
 		}
 	}
-	if err := m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
-		m.systemMetrics.PublishEventError.Inc()
-		logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
+	// Events written to the outbox are published by the outbox relay.
+	if outboxEvent == nil {
+		if err := m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
+			m.systemMetrics.PublishEventError.Inc()
+			logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
+		}
 	}
 
 	m.systemMetrics.ExecutionEventsCreated.Inc()
@@ -1345,10 +1376,14 @@ func (m *ExecutionManager) GetExecutionData(
 		if err != nil {
 			return nil, err
 		}
-		// Update model so as not to offload again.
+		// Update model so as not to offload again. Should the execution have been updated concurrently, the inputs are
+		// offloaded again on a later request instead.
 		executionModel.InputsURI = newInputsURI
 		if err := m.db.ExecutionRepo().Update(ctx, *executionModel); err != nil {
-			return nil, err
+			if !repoErrors.IsConcurrentUpdateError(err) {
+				return nil, err
+			}
+			logger.Debugf(ctx, "skipped saving offloaded inputs of concurrently updated execution [%+v]", request.Id)
 		}
 	}
 	inputsURLBlob, err := m.urlData.Get(ctx, executionModel.InputsURI.String())
@@ -1454,6 +1489,26 @@ func (m *ExecutionManager) ListExecutions(
 	}, nil
 }
 
+// Returns the notifications owed to users subscribed to the execution phase, beyond those defined by the execution
+// spec. Subscriptions are best effort, when they can't be looked up only the spec notifications are sent.
+func (m *ExecutionManager) getSubscriptionNotifications(ctx context.Context, execution *admin.Execution,
+	phase core.WorkflowExecution_Phase) []*admin.Notification {
+	subscriptions, err := m.db.NotificationSubscriptionRepo().ListMatching(ctx,
+		repositoryInterfaces.NotificationSubscriptionScope{
+			Project:    execution.GetId().GetProject(),
+			Domain:     execution.GetId().GetDomain(),
+			Workflow:   execution.GetClosure().GetWorkflowId().GetName(),
+			LaunchPlan: execution.GetSpec().GetLaunchPlan().GetName(),
+		})
+	if err != nil {
+		m.systemMetrics.SubscriptionLookupError.Inc()
+		logger.Infof(ctx, "failed to look up notification subscriptions for execution [%+v] with err: %v",
+			execution.GetId(), err)
+		return nil
+	}
+	return notifications.SubscriptionNotifications(phase, execution.GetClosure().GetNotifications(), subscriptions)
+}
+
 // publishNotifications will only forward major errors because the assumption made is all of the objects
 // that are being manipulated have already been validated/manipulated by Flyte itself.
 // Note: This method should be refactored somewhere else once the interaction with pushing to SNS.
@@ -1466,9 +1521,14 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request adm
 		m.systemMetrics.TransformerError.Inc()
 		return errors.NewFlyteAdminErrorf(codes.Internal, "Failed to transform execution [%+v] with err: %v", request.Event.ExecutionId, err)
 	}
-	var notificationsList = adminExecution.Closure.Notifications
+	var notificationsList = append(adminExecution.Closure.Notifications,
+		m.getSubscriptionNotifications(ctx, adminExecution, request.Event.Phase)...)
 	logger.Debugf(ctx, "publishing notifications for execution [%+v] in state [%+v] for notifications [%+v]",
 		request.Event.ExecutionId, request.Event.Phase, notificationsList)
+	notificationsConfig := *m.config.ApplicationConfiguration().GetNotificationsConfig()
+	var template runtimeInterfaces.NotificationTemplate
+	var templateData *notifications.TemplateData
+	var templateSource string
 	for _, notification := range notificationsList {
 		// Check if the notification phase matches the current one.
 		var matchPhase = false
@@ -1486,12 +1546,16 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request adm
 		// Currently all three supported notifications use email underneath to send the notification.
 		// Convert Slack and PagerDuty into an EmailNotification type.
 		var emailNotification admin.EmailNotification
+		var channel string
 		if notification.GetEmail() != nil {
 			emailNotification.RecipientsEmail = notification.GetEmail().GetRecipientsEmail()
+			channel = models.NotificationChannelEmail
 		} else if notification.GetPagerDuty() != nil {
 			emailNotification.RecipientsEmail = notification.GetPagerDuty().GetRecipientsEmail()
+			channel = models.NotificationChannelPagerDuty
 		} else if notification.GetSlack() != nil {
 			emailNotification.RecipientsEmail = notification.GetSlack().GetRecipientsEmail()
+			channel = models.NotificationChannelSlack
 		} else {
 			logger.Debugf(ctx, "failed to publish notification, encountered unrecognized type: %v", notification.Type)
 			m.systemMetrics.UnexpectedDataError.Inc()
@@ -1500,17 +1564,41 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request adm
 				notification.Type, request.Event.ExecutionId)
 		}
 
-		// Convert the email Notification into an email message to be published.
-		// Currently there are no possible errors while creating an email message.
-		// Once customizable content is specified, errors are possible.
-		email := notifications.ToEmailMessageFromWorkflowExecutionEvent(
-			*m.config.ApplicationConfiguration().GetNotificationsConfig(), emailNotification, request, adminExecution)
-		// Errors seen while publishing a message are considered non-fatal to the method and will not result
-		// in the method returning an error.
-		if err = m.notificationClient.Publish(ctx, proto.MessageName(&emailNotification), email); err != nil {
-			m.systemMetrics.PublishNotificationError.Inc()
-			logger.Infof(ctx, "error publishing email notification [%+v] with err: [%v]", notification, err)
+		// Templates are resolved once, on the first notification which matches the current phase.
+		if len(templateSource) == 0 {
+			template, templateSource, err = resolveNotificationTemplate(
+				ctx, m.db, notificationsConfig, adminExecution, request.Event.Phase)
+			if err != nil {
+				logger.Infof(ctx, "failed to resolve notification template for execution [%+v] with err: %v",
+					request.Event.ExecutionId, err)
+				templateSource = notificationSourceLegacy
+			}
+			if templateSource != notificationSourceLegacy {
+				data := notifications.NewTemplateData(adminExecution,
+					readNotificationInputs(ctx, m.storageClient, execution.InputsURI),
+					notificationsConfig.NotificationsEmailerConfig.ConsoleURL)
+				templateData = &data
+			}
+		}
+
+		// Convert the email Notification into an email message to be published. Notification templates which fail to
+		// render fall back to the configured subject and body.
+		var email *admin.EmailMessage
+		if templateData != nil {
+			email, err = notifications.ToEmailMessageFromTemplate(notificationsConfig, emailNotification, template,
+				*templateData)
+			if err != nil {
+				m.systemMetrics.TemplateRenderError.Inc()
+				logger.Infof(ctx, "failed to render %s notification template for execution [%+v] with err: %v",
+					templateSource, request.Event.ExecutionId, err)
+			}
+		}
+		if email == nil {
+			email = notifications.ToEmailMessageFromWorkflowExecutionEvent(
+				notificationsConfig, emailNotification, request, adminExecution)
 		}
+		publishNotificationEmail(ctx, m.db, m.notificationClient, request.Event.ExecutionId, channel, email,
+			m.systemMetrics.PublishNotificationError, m.systemMetrics.DeliveryRecordError)
 	}
 	return nil
 }
@@ -1522,12 +1610,13 @@ func (m *ExecutionManager) TerminateExecution(
 		return nil, err
 	}
 	ctx = getExecutionContext(ctx, request.Id)
-	// Save the abort reason (best effort)
-	executionModel, err := m.db.ExecutionRepo().Get(ctx, repositoryInterfaces.Identifier{
+	executionID := repositoryInterfaces.Identifier{
 		Project: request.Id.Project,
 		Domain:  request.Id.Domain,
 		Name:    request.Id.Name,
-	})
+	}
+	// Save the abort reason (best effort)
+	executionModel, err := m.db.ExecutionRepo().Get(ctx, executionID)
 	if err != nil {
 		logger.Infof(ctx, "couldn't find execution [%+v] to save termination cause", request.Id)
 		return nil, err
@@ -1541,12 +1630,22 @@ func (m *ExecutionManager) TerminateExecution(
 		return nil, err
 	}
 
-	err = transformers.SetExecutionAborted(&executionModel, request.Cause, getUser(ctx))
-	if err != nil {
-		logger.Debugf(ctx, "failed to add abort metadata for execution [%+v] with err: %v", request.Id, err)
-		return nil, err
-	}
-	err = m.db.ExecutionRepo().Update(ctx, executionModel)
+	// Events may update the execution while it is terminated, in which case the abort metadata is added to a fresh read
+	// of it.
+	reread := false
+	err = util.RetryOnConcurrentUpdate(ctx, func() error {
+		if reread {
+			if executionModel, err = m.db.ExecutionRepo().Get(ctx, executionID); err != nil {
+				return err
+			}
+		}
+		reread = true
+		if err := transformers.SetExecutionAborted(&executionModel, request.Cause, getUser(ctx)); err != nil {
+			logger.Debugf(ctx, "failed to add abort metadata for execution [%+v] with err: %v", request.Id, err)
+			return err
+		}
+		return m.db.ExecutionRepo().Update(ctx, executionModel)
+	})
 	if err != nil {
 		logger.Debugf(ctx, "failed to save abort cause for terminated execution: %+v with err: %v", request.Id, err)
 		return nil, err
@@ -1573,6 +1672,12 @@ func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 			"overall count of unexpected data for previously validated objects"),
 		PublishNotificationError: scope.MustNewCounter("publish_error",
 			"overall count of publish notification errors when invoking publish()"),
+		TemplateRenderError: scope.MustNewCounter("notification_template_error",
+			"overall count of notification templates which failed to render and fell back to the default content"),
+		DeliveryRecordError: scope.MustNewCounter("notification_delivery_record_error",
+			"overall count of errors when recording notification deliveries"),
+		SubscriptionLookupError: scope.MustNewCounter("notification_subscription_lookup_error",
+			"overall count of errors when looking up notification subscriptions"),
 		SpecSizeBytes:    scope.MustNewSummary("spec_size_bytes", "size in bytes of serialized execution spec"),
 		ClosureSizeBytes: scope.MustNewSummary("closure_size_bytes", "size in bytes of serialized execution closure"),
 		AcceptanceDelay: scope.MustNewSummary("acceptance_delay",
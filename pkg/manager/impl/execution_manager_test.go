@@ -42,6 +42,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -1576,6 +1577,102 @@ func TestCreateWorkflowEvent_DatabaseUpdateError(t *testing.T) {
 	assert.EqualError(t, expectedErr, err.Error())
 }
 
+// Simulates an event for the same execution which is recorded between the read and the update of this one.
+func makeInterleavedExecutionGetFunc(interleavedPhase core.WorkflowExecution_Phase) repositoryMocks.GetExecutionFunc {
+	var reads uint32
+	return func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+		phase := core.WorkflowExecution_RUNNING
+		if reads > 0 {
+			phase = interleavedPhase
+		}
+		execution := models.Execution{
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			BaseModel: models.BaseModel{
+				ID: uint(8),
+			},
+			Spec:         specBytes,
+			Phase:        phase.String(),
+			Closure:      closureBytes,
+			LaunchPlanID: uint(1),
+			WorkflowID:   uint(2),
+			StateVersion: reads,
+		}
+		reads++
+		return execution, nil
+	}
+}
+
+func makeConcurrentExecutionUpdateFunc(updatedVersions *[]uint32) repositoryMocks.UpdateExecutionFunc {
+	return func(ctx context.Context, execution models.Execution) error {
+		*updatedVersions = append(*updatedVersions, execution.StateVersion)
+		// The interleaved event updated the execution after it was first read.
+		if execution.StateVersion == 0 {
+			return repoErrors.GetConcurrentUpdateError("execution")
+		}
+		return nil
+	}
+}
+
+func TestCreateWorkflowEvent_ConcurrentUpdate(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeInterleavedExecutionGetFunc(core.WorkflowExecution_SUCCEEDING))
+	var updatedVersions []uint32
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		makeConcurrentExecutionUpdateFunc(&updatedVersions))
+	occurredAt, _ := ptypes.TimestampProto(time.Now())
+	request := admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAt,
+			Phase:       core.WorkflowExecution_SUCCEEDED,
+			OutputResult: &event.WorkflowExecutionEvent_OutputUri{
+				OutputUri: "s3://bucket/outputs.pb",
+			},
+		},
+	}
+	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
+	mockDbEventWriter.On("Write", request)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	// The event is applied again to the execution read after the interleaved event.
+	assert.Equal(t, []uint32{0, 1}, updatedVersions)
+	mockDbEventWriter.AssertNumberOfCalls(t, "Write", 1)
+}
+
+func TestCreateWorkflowEvent_ConcurrentTerminalUpdate(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeInterleavedExecutionGetFunc(core.WorkflowExecution_ABORTED))
+	var updatedVersions []uint32
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		makeConcurrentExecutionUpdateFunc(&updatedVersions))
+	occurredAt, _ := ptypes.TimestampProto(time.Now())
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAt,
+			Phase:       core.WorkflowExecution_SUCCEEDED,
+			OutputResult: &event.WorkflowExecutionEvent_OutputUri{
+				OutputUri: "s3://bucket/outputs.pb",
+			},
+		},
+	})
+	assert.Nil(t, resp)
+	// The phase transition is validated again against the execution aborted by the interleaved event.
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Equal(t, []uint32{0}, updatedVersions)
+}
+
 func TestGetExecution(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	startedAt := time.Date(2018, 8, 30, 0, 0, 0, 0, time.UTC)
@@ -2072,6 +2169,230 @@ func TestExecutionManager_PublishNotificationsNoPhaseMatch(t *testing.T) {
 	assert.Nil(t, myExecManager.publishNotifications(context.Background(), workflowRequest, executionModel))
 }
 
+func getFailedExecutionModelWithEmailNotification() models.Execution {
+	execClosure := admin.ExecutionClosure{
+		Phase: core.WorkflowExecution_FAILED,
+		Notifications: []*admin.Notification{
+			{
+				Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{
+						RecipientsEmail: []string{"email@example.com"},
+					},
+				},
+			},
+		},
+		OutputResult: &admin.ExecutionClosure_Error{
+			Error: &core.ExecutionError{Message: "<oopsie>"},
+		},
+		WorkflowId: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "wf_name",
+			Version:      "wf_version",
+		},
+	}
+	execClosureBytes, _ := proto.Marshal(&execClosure)
+	return models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:   core.WorkflowExecution_FAILED.String(),
+		Closure: execClosureBytes,
+		Spec:    specBytes,
+	}
+}
+
+func TestExecutionManager_PublishNotificationsWithTemplates(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, "project", ID.Project)
+		assert.Equal(t, "domain", ID.Domain)
+		assert.Equal(t, "wf_name", ID.Workflow)
+		assert.Equal(t, models.NotificationTemplateResourceType, ID.ResourceType)
+		return models.Resource{
+			Attributes: []byte(`{"failed":{"subject":"{{ .Name }} {{ .Phase }}","body":"<p>{{ .Error }}</p> {{ .ConsoleURL }}","html":true}}`),
+		}, nil
+	}
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Sender:     "flyte@example.com",
+			Subject:    "legacy subject",
+			ConsoleURL: "https://example.com/console",
+			Templates: map[string]runtimeInterfaces.NotificationTemplate{
+				"default": {Subject: "config subject"},
+			},
+		},
+	})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(&mockApplicationConfig, nil, nil, nil, nil, nil)
+
+	var publishCalled bool
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		assert.True(t, proto.Equal(&admin.EmailMessage{
+			SubjectLine:     "name failed",
+			SenderEmail:     "flyte@example.com",
+			RecipientsEmail: []string{"email@example.com"},
+			Body:            "<p>&lt;oopsie&gt;</p> https://example.com/console/projects/project/domains/domain/executions/name",
+		}, msg))
+		publishCalled = true
+		return nil
+	})
+	execManager := &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+	}
+	workflowRequest := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_FAILED,
+			ExecutionId: &executionIdentifier,
+		},
+	}
+	assert.Nil(t, execManager.publishNotifications(
+		context.Background(), workflowRequest, getFailedExecutionModelWithEmailNotification()))
+	assert.True(t, publishCalled)
+}
+
+func TestExecutionManager_PublishNotificationsTemplateFallback(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Subject: "{{ name }} is {{ phase }}",
+			Templates: map[string]runtimeInterfaces.NotificationTemplate{
+				"failed": {Subject: "{{ .Missing }}"},
+			},
+		},
+	})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(&mockApplicationConfig, nil, nil, nil, nil, nil)
+
+	var publishCalled bool
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		assert.Equal(t, "name is failed", msg.(*admin.EmailMessage).SubjectLine)
+		publishCalled = true
+		return nil
+	})
+	execManager := &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+	}
+	workflowRequest := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_FAILED,
+			ExecutionId: &executionIdentifier,
+		},
+	}
+	assert.Nil(t, execManager.publishNotifications(
+		context.Background(), workflowRequest, getFailedExecutionModelWithEmailNotification()))
+	assert.True(t, publishCalled)
+}
+
+func TestExecutionManager_PublishNotificationsRecordsDeliveries(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var created []*models.NotificationDelivery
+	var updated []models.NotificationDelivery
+	deliveryRepo := repository.NotificationDeliveryRepo().(*repositoryMocks.MockNotificationDeliveryRepo)
+	deliveryRepo.CreateFunction = func(ctx context.Context, input *models.NotificationDelivery) error {
+		input.ID = uint(len(created) + 1)
+		created = append(created, input)
+		return nil
+	}
+	deliveryRepo.UpdateFunction = func(ctx context.Context, input models.NotificationDelivery) error {
+		updated = append(updated, input)
+		return nil
+	}
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(&mockApplicationConfig, nil, nil, nil, nil, nil)
+
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		// Deliveries are recorded before the notification is published.
+		assert.Len(t, created, 1)
+		message, _ := proto.Marshal(msg)
+		assert.Equal(t, created[0].Message, message)
+		return flyteAdminErrors.NewFlyteAdminError(codes.Unavailable, "topic unavailable")
+	})
+	execManager := &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+	}
+	workflowRequest := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_FAILED,
+			ExecutionId: &executionIdentifier,
+		},
+	}
+	assert.Nil(t, execManager.publishNotifications(
+		context.Background(), workflowRequest, getFailedExecutionModelWithEmailNotification()))
+	assert.Len(t, created, 1)
+	assert.Equal(t, executionIdentifier.Name, created[0].ExecutionName)
+	assert.Equal(t, models.NotificationChannelEmail, created[0].Channel)
+	assert.Equal(t, "email@example.com", created[0].Recipients)
+	assert.Len(t, updated, 1)
+	assert.Equal(t, uint(1), updated[0].ID)
+	assert.Equal(t, models.NotificationDeliveryFailed, updated[0].Status)
+	assert.Contains(t, updated[0].Error, "topic unavailable")
+}
+
+func TestExecutionManager_PublishNotificationsToSubscribers(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationSubscriptionRepo().(*repositoryMocks.MockNotificationSubscriptionRepo).ListMatchingFunction =
+		func(ctx context.Context, scope interfaces.NotificationSubscriptionScope) (
+			[]models.NotificationSubscription, error) {
+			assert.Equal(t, "project", scope.Project)
+			assert.Equal(t, "domain", scope.Domain)
+			assert.Equal(t, "wf_name", scope.Workflow)
+			return []models.NotificationSubscription{
+				{Channel: models.NotificationChannelEmail, Recipient: "email@example.com", Phases: "FAILED"},
+				{Channel: models.NotificationChannelEmail, Recipient: "oncall@example.com", Phases: "FAILED"},
+				{Channel: models.NotificationChannelSlack, Recipient: "@oncall", Phases: "FAILED,TIMED_OUT"},
+				{Channel: models.NotificationChannelEmail, Recipient: "happy@example.com", Phases: "SUCCEEDED"},
+			}, nil
+		}
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(&mockApplicationConfig, nil, nil, nil, nil, nil)
+
+	var recipients [][]string
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		recipients = append(recipients, msg.(*admin.EmailMessage).RecipientsEmail)
+		return nil
+	})
+	execManager := &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+	}
+	workflowRequest := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_FAILED,
+			ExecutionId: &executionIdentifier,
+		},
+	}
+	assert.Nil(t, execManager.publishNotifications(
+		context.Background(), workflowRequest, getFailedExecutionModelWithEmailNotification()))
+	assert.Equal(t, [][]string{
+		{"email@example.com"},
+		{"oncall@example.com"},
+		{"@oncall"},
+	}, recipients)
+}
+
 func TestTerminateExecution(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	startTime := time.Now()
@@ -2133,6 +2454,40 @@ func TestTerminateExecution(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestTerminateExecution_ConcurrentUpdate(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeInterleavedExecutionGetFunc(core.WorkflowExecution_SUCCEEDING))
+	var updatedPhases []string
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			updatedPhases = append(updatedPhases, execution.Phase)
+			assert.Equal(t, "abort cause", execution.AbortCause)
+			if execution.StateVersion == 0 {
+				return repoErrors.GetConcurrentUpdateError("execution")
+			}
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	var terminateCalls int
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
+		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
+			terminateCalls++
+			return nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+
+	resp, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
+		Id:    &executionIdentifier,
+		Cause: "abort cause",
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	// The abort metadata is added again to the execution read after the interleaved event, which isn't overwritten.
+	assert.Equal(t, []string{core.WorkflowExecution_RUNNING.String(), core.WorkflowExecution_SUCCEEDING.String()},
+		updatedPhases)
+	assert.Equal(t, 1, terminateCalls)
+}
 func TestTerminateExecution_PropellerError(t *testing.T) {
 	var expectedError = errors.New("expected error")
 
@@ -0,0 +1,142 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type ExecutionTimelineManager struct {
+	db repositories.RepositoryInterface
+}
+
+type taskExecutionTimelineKey struct {
+	models.TaskKey
+	RetryAttempt uint32
+}
+
+// Groups the events of the node and task executions of a workflow execution by node execution. Events are expected
+// in the order in which they occurred, so node and task executions are ordered by their first event.
+type nodeExecutionTimelines struct {
+	timelines []interfaces.NodeExecutionTimeline
+	nodes     map[string]int
+	tasks     map[string]map[taskExecutionTimelineKey]int
+}
+
+func (t *nodeExecutionTimelines) node(nodeID string) *interfaces.NodeExecutionTimeline {
+	index, ok := t.nodes[nodeID]
+	if !ok {
+		index = len(t.timelines)
+		t.nodes[nodeID] = index
+		t.tasks[nodeID] = make(map[taskExecutionTimelineKey]int)
+		t.timelines = append(t.timelines, interfaces.NodeExecutionTimeline{
+			NodeID:         nodeID,
+			Transitions:    []interfaces.PhaseTransition{},
+			TaskExecutions: []interfaces.TaskExecutionTimeline{},
+		})
+	}
+	return &t.timelines[index]
+}
+
+func (t *nodeExecutionTimelines) addNodeExecutionEvent(event models.NodeExecutionEvent) {
+	node := t.node(event.NodeID)
+	node.Transitions = append(node.Transitions, interfaces.PhaseTransition{
+		Phase:      event.Phase,
+		OccurredAt: event.OccurredAt,
+		RecordedAt: event.CreatedAt,
+	})
+}
+
+func (t *nodeExecutionTimelines) addTaskExecutionEvent(event models.TaskExecutionEvent) {
+	node := t.node(event.NodeID)
+	key := taskExecutionTimelineKey{TaskKey: event.TaskKey}
+	if event.RetryAttempt != nil {
+		key.RetryAttempt = *event.RetryAttempt
+	}
+	index, ok := t.tasks[event.NodeID][key]
+	if !ok {
+		index = len(node.TaskExecutions)
+		t.tasks[event.NodeID][key] = index
+		node.TaskExecutions = append(node.TaskExecutions, interfaces.TaskExecutionTimeline{
+			TaskProject:  key.Project,
+			TaskDomain:   key.Domain,
+			TaskName:     key.Name,
+			TaskVersion:  key.Version,
+			RetryAttempt: key.RetryAttempt,
+			Transitions:  []interfaces.PhaseTransition{},
+		})
+	}
+	task := &node.TaskExecutions[index]
+	task.Transitions = append(task.Transitions, interfaces.PhaseTransition{
+		Phase:        event.Phase,
+		PhaseVersion: event.PhaseVersion,
+		OccurredAt:   event.OccurredAt,
+		RecordedAt:   event.CreatedAt,
+	})
+}
+
+func (m *ExecutionTimelineManager) GetExecutionTimeline(
+	ctx context.Context, request interfaces.ExecutionTimelineRequest) (*interfaces.ExecutionTimeline, error) {
+	if err := validation.ValidateExecutionTimelineRequest(request); err != nil {
+		return nil, err
+	}
+	executionID := repoInterfaces.Identifier{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Name:    request.Name,
+	}
+	if _, err := m.db.ExecutionRepo().Get(ctx, executionID); err != nil {
+		return nil, err
+	}
+	executionEvents, err := m.db.ExecutionEventRepo().ListByExecution(ctx, executionID)
+	if err != nil {
+		return nil, err
+	}
+	nodeExecutionEvents, err := m.db.NodeExecutionEventRepo().ListByExecution(ctx, executionID, request.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	taskExecutionEvents, err := m.db.TaskExecutionEventRepo().ListByExecution(ctx, executionID, request.NodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &interfaces.ExecutionTimeline{
+		Project:     request.Project,
+		Domain:      request.Domain,
+		Name:        request.Name,
+		Transitions: make([]interfaces.PhaseTransition, len(executionEvents)),
+	}
+	for idx, event := range executionEvents {
+		timeline.Transitions[idx] = interfaces.PhaseTransition{
+			Phase:      event.Phase,
+			OccurredAt: event.OccurredAt,
+			RecordedAt: event.CreatedAt,
+		}
+	}
+	nodeTimelines := nodeExecutionTimelines{
+		timelines: []interfaces.NodeExecutionTimeline{},
+		nodes:     make(map[string]int),
+		tasks:     make(map[string]map[taskExecutionTimelineKey]int),
+	}
+	for _, event := range nodeExecutionEvents {
+		nodeTimelines.addNodeExecutionEvent(event)
+	}
+	// Node executions for which only task execution events were recorded, for instance because their own events were
+	// dropped, are ordered after the others.
+	for _, event := range taskExecutionEvents {
+		nodeTimelines.addTaskExecutionEvent(event)
+	}
+	timeline.NodeExecutions = nodeTimelines.timelines
+	return timeline, nil
+}
+
+func NewExecutionTimelineManager(db repositories.RepositoryInterface) interfaces.ExecutionTimelineInterface {
+	return &ExecutionTimelineManager{
+		db: db,
+	}
+}
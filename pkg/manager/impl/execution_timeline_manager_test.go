@@ -0,0 +1,162 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var timelineExecutionID = interfaces.Identifier{Project: "project", Domain: "domain", Name: "name"}
+
+var timelineExecutionKey = models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"}
+
+func getTimelineTaskExecutionEvent(nodeID string, retryAttempt uint32, phase string, phaseVersion uint32,
+	occurredAt time.Time) models.TaskExecutionEvent {
+	return models.TaskExecutionEvent{
+		TaskExecutionKey: models.TaskExecutionKey{
+			TaskKey: models.TaskKey{Project: "project", Domain: "domain", Name: "task", Version: "v1"},
+			NodeExecutionKey: models.NodeExecutionKey{
+				ExecutionKey: timelineExecutionKey,
+				NodeID:       nodeID,
+			},
+			RetryAttempt: &retryAttempt,
+		},
+		Phase:        phase,
+		PhaseVersion: phaseVersion,
+		OccurredAt:   occurredAt,
+	}
+}
+
+func TestGetExecutionTimeline(t *testing.T) {
+	start := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time {
+		return start.Add(time.Duration(seconds) * time.Second)
+	}
+	repository := repositoryMocks.NewMockRepository()
+	executionEventRepo := repositoryMocks.ExecutionEventRepoInterface{}
+	executionEventRepo.OnListByExecutionMatch(context.Background(), timelineExecutionID).Return(
+		[]models.ExecutionEvent{
+			{ExecutionKey: timelineExecutionKey, Phase: "QUEUED", OccurredAt: at(0)},
+			{ExecutionKey: timelineExecutionKey, Phase: "RUNNING", OccurredAt: at(1)},
+		}, nil)
+	repository.(*repositoryMocks.MockRepository).ExecutionEventRepoIface = &executionEventRepo
+	nodeExecutionEventRepo := repositoryMocks.NodeExecutionEventRepoInterface{}
+	nodeExecutionEventRepo.OnListByExecutionMatch(context.Background(), timelineExecutionID, "").Return(
+		[]models.NodeExecutionEvent{
+			{NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: timelineExecutionKey, NodeID: "start-node"},
+				Phase: "SUCCEEDED", OccurredAt: at(2)},
+			{NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: timelineExecutionKey, NodeID: "n0"},
+				Phase: "QUEUED", OccurredAt: at(3)},
+			{NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: timelineExecutionKey, NodeID: "n0"},
+				Phase: "RUNNING", OccurredAt: at(4)},
+		}, nil)
+	repository.(*repositoryMocks.MockRepository).NodeExecutionEventRepoIface = &nodeExecutionEventRepo
+	taskExecutionEventRepo := repositoryMocks.TaskExecutionEventRepoInterface{}
+	taskExecutionEventRepo.OnListByExecutionMatch(context.Background(), timelineExecutionID, "").Return(
+		[]models.TaskExecutionEvent{
+			getTimelineTaskExecutionEvent("n0", 0, "RUNNING", 0, at(5)),
+			getTimelineTaskExecutionEvent("n0", 0, "RUNNING", 1, at(6)),
+			getTimelineTaskExecutionEvent("n0", 0, "FAILED", 0, at(7)),
+			getTimelineTaskExecutionEvent("n0", 1, "RUNNING", 0, at(8)),
+			getTimelineTaskExecutionEvent("n1", 0, "QUEUED", 0, at(9)),
+		}, nil)
+	repository.(*repositoryMocks.MockRepository).TaskExecutionEventRepoIface = &taskExecutionEventRepo
+
+	manager := NewExecutionTimelineManager(repository)
+	timeline, err := manager.GetExecutionTimeline(context.Background(), managerInterfaces.ExecutionTimelineRequest{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &managerInterfaces.ExecutionTimeline{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+		Transitions: []managerInterfaces.PhaseTransition{
+			{Phase: "QUEUED", OccurredAt: at(0)},
+			{Phase: "RUNNING", OccurredAt: at(1)},
+		},
+		NodeExecutions: []managerInterfaces.NodeExecutionTimeline{
+			{
+				NodeID:         "start-node",
+				Transitions:    []managerInterfaces.PhaseTransition{{Phase: "SUCCEEDED", OccurredAt: at(2)}},
+				TaskExecutions: []managerInterfaces.TaskExecutionTimeline{},
+			},
+			{
+				NodeID: "n0",
+				Transitions: []managerInterfaces.PhaseTransition{
+					{Phase: "QUEUED", OccurredAt: at(3)},
+					{Phase: "RUNNING", OccurredAt: at(4)},
+				},
+				TaskExecutions: []managerInterfaces.TaskExecutionTimeline{
+					{
+						TaskProject:  "project",
+						TaskDomain:   "domain",
+						TaskName:     "task",
+						TaskVersion:  "v1",
+						RetryAttempt: 0,
+						Transitions: []managerInterfaces.PhaseTransition{
+							{Phase: "RUNNING", OccurredAt: at(5)},
+							{Phase: "RUNNING", PhaseVersion: 1, OccurredAt: at(6)},
+							{Phase: "FAILED", OccurredAt: at(7)},
+						},
+					},
+					{
+						TaskProject:  "project",
+						TaskDomain:   "domain",
+						TaskName:     "task",
+						TaskVersion:  "v1",
+						RetryAttempt: 1,
+						Transitions:  []managerInterfaces.PhaseTransition{{Phase: "RUNNING", OccurredAt: at(8)}},
+					},
+				},
+			},
+			{
+				NodeID:      "n1",
+				Transitions: []managerInterfaces.PhaseTransition{},
+				TaskExecutions: []managerInterfaces.TaskExecutionTimeline{
+					{
+						TaskProject: "project",
+						TaskDomain:  "domain",
+						TaskName:    "task",
+						TaskVersion: "v1",
+						Transitions: []managerInterfaces.PhaseTransition{{Phase: "QUEUED", OccurredAt: at(9)}},
+					},
+				},
+			},
+		},
+	}, timeline)
+}
+
+func TestGetExecutionTimeline_MissingExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{}, errors.NewFlyteAdminError(codes.NotFound, "not found")
+		})
+	manager := NewExecutionTimelineManager(repository)
+	_, err := manager.GetExecutionTimeline(context.Background(), managerInterfaces.ExecutionTimelineRequest{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	})
+	assert.Equal(t, codes.NotFound, err.(errors.FlyteAdminError).Code())
+}
+
+func TestGetExecutionTimeline_InvalidRequest(t *testing.T) {
+	manager := NewExecutionTimelineManager(repositoryMocks.NewMockRepository())
+	_, err := manager.GetExecutionTimeline(context.Background(), managerInterfaces.ExecutionTimelineRequest{
+		Project: "project",
+		Domain:  "domain",
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	eventWriter "github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
 
 	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/golang/protobuf/proto"
@@ -49,6 +50,9 @@ type nodeExecutionMetrics struct {
 	NodeExecutionInputBytes    prometheus.Summary
 	NodeExecutionOutputBytes   prometheus.Summary
 	PublishEventError          prometheus.Counter
+	PublishNotificationError   prometheus.Counter
+	NotificationRuleError      prometheus.Counter
+	DeliveryRecordError        prometheus.Counter
 }
 
 type NodeExecutionManager struct {
@@ -60,6 +64,8 @@ type NodeExecutionManager struct {
 	urlData        dataInterfaces.RemoteURLInterface
 	eventPublisher notificationInterfaces.Publisher
 	dbEventWriter  eventWriter.NodeExecutionEventWriter
+	// Optional, publishes the notifications of rules matching node execution phase changes.
+	notificationClient notificationInterfaces.Publisher
 }
 
 type updateNodeExecutionStatus int
@@ -134,7 +140,16 @@ func (m *NodeExecutionManager) createNodeExecutionWithEvent(
 			request.RequestId, err)
 		return err
 	}
-	if err := m.db.NodeExecutionRepo().Create(ctx, nodeExecutionModel); err != nil {
+	outboxEvent, err := util.NewOutboxEvent(m.config, request.Event.Id.ExecutionId, request)
+	if err != nil {
+		return err
+	}
+	if outboxEvent != nil {
+		err = m.db.NodeExecutionRepo().CreateWithOutboxEvent(ctx, nodeExecutionModel, *outboxEvent)
+	} else {
+		err = m.db.NodeExecutionRepo().Create(ctx, nodeExecutionModel)
+	}
+	if err != nil {
 		logger.Debugf(ctx, "Failed to create node execution with id [%+v] and model [%+v] "+
 			"with err %v", request.Event.Id, nodeExecutionModel, err)
 		return err
@@ -180,7 +195,15 @@ func (m *NodeExecutionManager) updateNodeExecutionWithEvent(
 		logger.Debugf(ctx, "failed to update node execution model: %+v with err: %v", request.Event.Id, err)
 		return updateFailed, err
 	}
-	err = m.db.NodeExecutionRepo().Update(ctx, nodeExecutionModel)
+	outboxEvent, err := util.NewOutboxEvent(m.config, request.Event.Id.ExecutionId, request)
+	if err != nil {
+		return updateFailed, err
+	}
+	if outboxEvent != nil {
+		err = m.db.NodeExecutionRepo().UpdateWithOutboxEvent(ctx, nodeExecutionModel, *outboxEvent)
+	} else {
+		err = m.db.NodeExecutionRepo().Update(ctx, nodeExecutionModel)
+	}
 	if err != nil {
 		logger.Debugf(ctx, "Failed to update node execution with id [%+v] with err %v",
 			request.Event.Id, err)
@@ -240,9 +263,11 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 		dynamicWorkflowRemoteClosureReference = dynamicWorkflowRemoteClosureDataReference.String()
 	}
 
-	nodeExecutionModel, err := m.db.NodeExecutionRepo().Get(ctx, repoInterfaces.NodeExecutionResource{
+	phaseChanged := true
+	nodeExecutionID := repoInterfaces.NodeExecutionResource{
 		NodeExecutionIdentifier: *request.Event.Id,
-	})
+	}
+	nodeExecutionModel, err := m.db.NodeExecutionRepo().Get(ctx, nodeExecutionID)
 	if err != nil {
 		if err.(errors.FlyteAdminError).Code() != codes.NotFound {
 			logger.Debugf(ctx, "Failed to retrieve existing node execution with id [%+v] with err: %v",
@@ -255,11 +280,27 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 		}
 		m.metrics.NodeExecutionsCreated.Inc()
 	} else {
-		phase := core.NodeExecution_Phase(core.NodeExecution_Phase_value[nodeExecutionModel.Phase])
-		updateStatus, err := m.updateNodeExecutionWithEvent(ctx, &request, &nodeExecutionModel, dynamicWorkflowRemoteClosureReference)
+		var phase core.NodeExecution_Phase
+		var updateStatus updateNodeExecutionStatus
+		// Concurrent events may update the node execution after it was read, in which case the phase transition is
+		// validated again against a fresh read of it.
+		reread := false
+		err = util.RetryOnConcurrentUpdate(ctx, func() error {
+			if reread {
+				if nodeExecutionModel, err = m.db.NodeExecutionRepo().Get(ctx, nodeExecutionID); err != nil {
+					return err
+				}
+			}
+			reread = true
+			phase = core.NodeExecution_Phase(core.NodeExecution_Phase_value[nodeExecutionModel.Phase])
+			updateStatus, err = m.updateNodeExecutionWithEvent(
+				ctx, &request, &nodeExecutionModel, dynamicWorkflowRemoteClosureReference)
+			return err
+		})
 		if err != nil {
 			return nil, err
 		}
+		phaseChanged = phase != request.Event.Phase
 
 		if updateStatus == alreadyInTerminalStatus {
 			curPhase := request.Event.Phase.String()
@@ -277,14 +318,45 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 	}
 	m.metrics.NodeExecutionEventsCreated.Inc()
 
-	if err := m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
-		m.metrics.PublishEventError.Inc()
-		logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
+	// Events written to the outbox are published by the outbox relay.
+	if !util.IsOutboxEnabled(m.config) {
+		if err := m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
+			m.metrics.PublishEventError.Inc()
+			logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
+		}
+	}
+	if phaseChanged {
+		m.publishNotifications(ctx, request)
 	}
 
 	return &admin.NodeExecutionEventResponse{}, nil
 }
 
+// Publishes the notifications of rules matching a node execution which transitioned to a new phase. Notifications are
+// best effort and failures are only logged.
+func (m *NodeExecutionManager) publishNotifications(ctx context.Context, request admin.NodeExecutionEventRequest) {
+	if m.notificationClient == nil {
+		return
+	}
+	executionID := request.Event.Id.ExecutionId
+	rules, err := getExecutionNotificationRules(ctx, m.db, executionID)
+	if err != nil {
+		m.metrics.NotificationRuleError.Inc()
+		logger.Infof(ctx, "failed to get notification rules for node execution [%+v] with err: %v",
+			request.Event.Id, err)
+		return
+	}
+	if rules == nil {
+		return
+	}
+	notificationsConfig := *m.config.ApplicationConfiguration().GetNotificationsConfig()
+	for _, rule := range notifications.MatchNodeRules(*rules, request.Event.Id.NodeId, request.Event.Phase) {
+		email := notifications.ToEmailMessageFromNodeExecutionEvent(notificationsConfig, rule, request)
+		publishNotificationEmail(ctx, m.db, m.notificationClient, executionID, notifications.RuleChannel(rule), email,
+			m.metrics.PublishNotificationError, m.metrics.DeliveryRecordError)
+	}
+}
+
 func (m *NodeExecutionManager) GetNodeExecution(
 	ctx context.Context, request admin.NodeExecutionGetRequest) (*admin.NodeExecution, error) {
 	if err := validation.ValidateNodeExecutionIdentifier(request.Id); err != nil {
@@ -492,7 +564,8 @@ func (m *NodeExecutionManager) GetNodeExecutionData(
 
 func NewNodeExecutionManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
 	storagePrefix []string, storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface,
-	eventPublisher notificationInterfaces.Publisher, eventWriter eventWriter.NodeExecutionEventWriter) interfaces.NodeExecutionInterface {
+	eventPublisher notificationInterfaces.Publisher, eventWriter eventWriter.NodeExecutionEventWriter,
+	notificationClient notificationInterfaces.Publisher) interfaces.NodeExecutionInterface {
 	metrics := nodeExecutionMetrics{
 		Scope: scope,
 		ActiveNodeExecutions: scope.MustNewGauge("active_node_executions",
@@ -513,16 +586,23 @@ func NewNodeExecutionManager(db repositories.RepositoryInterface, config runtime
 			"size in bytes of serialized node execution outputs"),
 		PublishEventError: scope.MustNewCounter("publish_event_error",
 			"overall count of publish event errors when invoking publish()"),
+		PublishNotificationError: scope.MustNewCounter("publish_notification_error",
+			"overall count of notification publish errors for matched notification rules"),
+		NotificationRuleError: scope.MustNewCounter("notification_rule_error",
+			"overall count of failures to look up notification rules for node execution events"),
+		DeliveryRecordError: scope.MustNewCounter("notification_delivery_record_error",
+			"overall count of failures to record notification deliveries"),
 	}
 	return &NodeExecutionManager{
 		db:     db,
 		config: config,
 
-		storagePrefix:  storagePrefix,
-		storageClient:  storageClient,
-		metrics:        metrics,
-		urlData:        urlData,
-		eventPublisher: eventPublisher,
-		dbEventWriter:  eventWriter,
+		storagePrefix:      storagePrefix,
+		storageClient:      storageClient,
+		metrics:            metrics,
+		urlData:            urlData,
+		eventPublisher:     eventPublisher,
+		dbEventWriter:      eventWriter,
+		notificationClient: notificationClient,
 	}
 }
@@ -0,0 +1,163 @@
+package impl
+
+import (
+	"context"
+
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+// Publishes a notification email. The delivery is recorded before publishing so that the processor can find it once
+// the message is dequeued. Errors seen while recording or publishing are counted and logged, but considered non-fatal.
+func publishNotificationEmail(ctx context.Context, db repositories.RepositoryInterface,
+	publisher notificationInterfaces.Publisher, executionID *core.WorkflowExecutionIdentifier, channel string,
+	email *admin.EmailMessage, publishErrors, recordErrors prometheus.Counter) {
+	delivery, err := transformers.CreateNotificationDeliveryModel(executionID, channel, email)
+	if err == nil {
+		err = db.NotificationDeliveryRepo().Create(ctx, &delivery)
+	}
+	recorded := err == nil
+	if !recorded {
+		recordErrors.Inc()
+		logger.Infof(ctx, "failed to record notification delivery for execution [%+v] with err: %v",
+			executionID, err)
+	}
+
+	// Keeps the notifications for an execution in order when the publisher supports partitioning.
+	publishCtx := notificationInterfaces.WithPartitionKey(ctx, notificationInterfaces.ExecutionPartitionKey(executionID))
+	if err = publisher.Publish(publishCtx, proto.MessageName(&admin.EmailNotification{}), email); err == nil {
+		return
+	}
+	publishErrors.Inc()
+	logger.Infof(ctx, "error publishing %s notification to [%v] with err: [%v]", channel, email.RecipientsEmail, err)
+	if !recorded {
+		return
+	}
+	delivery.Status = models.NotificationDeliveryFailed
+	delivery.Error = err.Error()
+	if err = db.NotificationDeliveryRepo().Update(ctx, delivery); err != nil {
+		recordErrors.Inc()
+		logger.Infof(ctx, "failed to record notification delivery [%d] as failed with err: %v", delivery.ID, err)
+	}
+}
+
+type NotificationDeliveryManager struct {
+	db        repositories.RepositoryInterface
+	publisher notificationInterfaces.Publisher
+}
+
+func toNotificationDelivery(ctx context.Context, model models.NotificationDelivery) interfaces.NotificationDelivery {
+	delivery := interfaces.NotificationDelivery{
+		ID:            model.ID,
+		Project:       model.ExecutionProject,
+		Domain:        model.ExecutionDomain,
+		Name:          model.ExecutionName,
+		Channel:       model.Channel,
+		Recipients:    transformers.FromNotificationDeliveryModelRecipients(model),
+		Status:        model.Status,
+		Attempts:      model.Attempts,
+		Error:         model.Error,
+		CreatedAt:     model.CreatedAt,
+		LastAttemptAt: model.LastAttemptAt,
+	}
+	email, err := transformers.FromNotificationDeliveryModelToEmailMessage(model)
+	if err != nil {
+		logger.Infof(ctx, "failed to read subject of notification delivery [%d] with err: %v", model.ID, err)
+	} else {
+		delivery.Subject = email.SubjectLine
+	}
+	return delivery
+}
+
+func (m *NotificationDeliveryManager) ListNotificationDeliveries(
+	ctx context.Context, request interfaces.NotificationDeliveryListRequest) (
+	*interfaces.NotificationDeliveryList, error) {
+	if err := validation.ValidateNotificationDeliveryListRequest(request); err != nil {
+		return nil, err
+	}
+	executionID := repoInterfaces.Identifier{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Name:    request.Name,
+	}
+	if _, err := m.db.ExecutionRepo().Get(ctx, executionID); err != nil {
+		return nil, err
+	}
+	deliveryModels, err := m.db.NotificationDeliveryRepo().ListByExecution(ctx, executionID)
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]interfaces.NotificationDelivery, len(deliveryModels))
+	for idx, model := range deliveryModels {
+		deliveries[idx] = toNotificationDelivery(ctx, model)
+	}
+	return &interfaces.NotificationDeliveryList{
+		Deliveries: deliveries,
+	}, nil
+}
+
+// Re-publishes the message of a failed notification delivery. The processor picks the delivery up again once the
+// message is dequeued, so the same delivery records the outcome of the new attempts.
+func (m *NotificationDeliveryManager) ResendNotificationDelivery(
+	ctx context.Context, request interfaces.NotificationDeliveryResendRequest) (
+	*interfaces.NotificationDelivery, error) {
+	if err := validation.ValidateNotificationDeliveryResendRequest(request); err != nil {
+		return nil, err
+	}
+	model, err := m.db.NotificationDeliveryRepo().Get(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	// The request is authorized for its project and domain, so deliveries of other scopes can't be re-sent with it.
+	if model.ExecutionProject != request.Project || model.ExecutionDomain != request.Domain {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"notification delivery [%d] not found in project [%s] domain [%s]", request.ID, request.Project, request.Domain)
+	}
+	if model.Status != models.NotificationDeliveryFailed {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"notification delivery [%d] is %s, only failed deliveries can be re-sent", model.ID, model.Status)
+	}
+	email, err := transformers.FromNotificationDeliveryModelToEmailMessage(model)
+	if err != nil {
+		return nil, err
+	}
+
+	model.Status = models.NotificationDeliveryQueued
+	model.Error = ""
+	if err = m.db.NotificationDeliveryRepo().Update(ctx, model); err != nil {
+		return nil, err
+	}
+	if err = m.publisher.Publish(ctx, proto.MessageName(&admin.EmailNotification{}), email); err != nil {
+		logger.Infof(ctx, "failed to re-send notification delivery [%d] with err: %v", model.ID, err)
+		model.Status = models.NotificationDeliveryFailed
+		model.Error = err.Error()
+		if updateErr := m.db.NotificationDeliveryRepo().Update(ctx, model); updateErr != nil {
+			logger.Warningf(ctx, "failed to record notification delivery [%d] as failed with err: %v",
+				model.ID, updateErr)
+		}
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to re-send notification delivery [%d]: %v", model.ID, err)
+	}
+	delivery := toNotificationDelivery(ctx, model)
+	return &delivery, nil
+}
+
+func NewNotificationDeliveryManager(db repositories.RepositoryInterface,
+	publisher notificationInterfaces.Publisher) interfaces.NotificationDeliveryInterface {
+	return &NotificationDeliveryManager{
+		db:        db,
+		publisher: publisher,
+	}
+}
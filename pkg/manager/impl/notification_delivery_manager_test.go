@@ -0,0 +1,176 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var deliveryEmail = &admin.EmailMessage{
+	RecipientsEmail: []string{"a@example.com", "b@example.com"},
+	SubjectLine:     "name failed",
+	Body:            "body",
+}
+
+func getFailedNotificationDelivery() models.NotificationDelivery {
+	delivery, _ := transformers.CreateNotificationDeliveryModel(&core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}, models.NotificationChannelSlack, deliveryEmail)
+	delivery.ID = 1
+	delivery.Status = models.NotificationDeliveryFailed
+	delivery.Attempts = 3
+	delivery.Error = "oops"
+	return delivery
+}
+
+func TestListNotificationDeliveries(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationDeliveryRepo().(*repositoryMocks.MockNotificationDeliveryRepo).ListByExecutionFunction =
+		func(ctx context.Context, executionID interfaces.Identifier) ([]models.NotificationDelivery, error) {
+			assert.Equal(t, interfaces.Identifier{Project: "project", Domain: "domain", Name: "name"}, executionID)
+			return []models.NotificationDelivery{getFailedNotificationDelivery()}, nil
+		}
+	manager := NewNotificationDeliveryManager(repository, &notificationMocks.MockPublisher{})
+	response, err := manager.ListNotificationDeliveries(context.Background(),
+		managerInterfaces.NotificationDeliveryListRequest{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, []managerInterfaces.NotificationDelivery{
+		{
+			ID:         1,
+			Project:    "project",
+			Domain:     "domain",
+			Name:       "name",
+			Channel:    models.NotificationChannelSlack,
+			Recipients: []string{"a@example.com", "b@example.com"},
+			Subject:    "name failed",
+			Status:     models.NotificationDeliveryFailed,
+			Attempts:   3,
+			Error:      "oops",
+		},
+	}, response.Deliveries)
+}
+
+func TestListNotificationDeliveries_Errors(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{}, errors.NewFlyteAdminError(codes.NotFound, "missing")
+		})
+	manager := NewNotificationDeliveryManager(repository, &notificationMocks.MockPublisher{})
+
+	_, err := manager.ListNotificationDeliveries(context.Background(),
+		managerInterfaces.NotificationDeliveryListRequest{Project: "project", Domain: "domain"})
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+
+	_, err = manager.ListNotificationDeliveries(context.Background(),
+		managerInterfaces.NotificationDeliveryListRequest{Project: "project", Domain: "domain", Name: "name"})
+	assert.Equal(t, codes.NotFound, err.(errors.FlyteAdminError).Code())
+}
+
+func TestResendNotificationDelivery(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	deliveryRepo := repository.NotificationDeliveryRepo().(*repositoryMocks.MockNotificationDeliveryRepo)
+	deliveryRepo.GetFunction = func(ctx context.Context, id uint) (models.NotificationDelivery, error) {
+		assert.Equal(t, uint(1), id)
+		return getFailedNotificationDelivery(), nil
+	}
+	var updated models.NotificationDelivery
+	deliveryRepo.UpdateFunction = func(ctx context.Context, input models.NotificationDelivery) error {
+		updated = input
+		return nil
+	}
+	var publisher notificationMocks.MockPublisher
+	var published bool
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		// The delivery is queued again before the message is published.
+		assert.Equal(t, models.NotificationDeliveryQueued, updated.Status)
+		assert.Equal(t, "flyteidl.admin.EmailNotification", notificationType)
+		assert.True(t, proto.Equal(deliveryEmail, msg))
+		published = true
+		return nil
+	})
+
+	manager := NewNotificationDeliveryManager(repository, &publisher)
+	response, err := manager.ResendNotificationDelivery(context.Background(),
+		managerInterfaces.NotificationDeliveryResendRequest{ID: 1, Project: "project", Domain: "domain"})
+	assert.NoError(t, err)
+	assert.True(t, published)
+	assert.Equal(t, models.NotificationDeliveryQueued, response.Status)
+	assert.Empty(t, response.Error)
+	assert.Empty(t, updated.Error)
+}
+
+func TestResendNotificationDelivery_NotFailed(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationDeliveryRepo().(*repositoryMocks.MockNotificationDeliveryRepo).GetFunction =
+		func(ctx context.Context, id uint) (models.NotificationDelivery, error) {
+			delivery := getFailedNotificationDelivery()
+			delivery.Status = models.NotificationDeliverySucceeded
+			return delivery, nil
+		}
+	manager := NewNotificationDeliveryManager(repository, &notificationMocks.MockPublisher{})
+	_, err := manager.ResendNotificationDelivery(context.Background(),
+		managerInterfaces.NotificationDeliveryResendRequest{ID: 1, Project: "project", Domain: "domain"})
+	assert.Equal(t, codes.FailedPrecondition, err.(errors.FlyteAdminError).Code())
+
+	_, err = manager.ResendNotificationDelivery(context.Background(), managerInterfaces.NotificationDeliveryResendRequest{})
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
+
+func TestResendNotificationDelivery_OtherProject(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	deliveryRepo := repository.NotificationDeliveryRepo().(*repositoryMocks.MockNotificationDeliveryRepo)
+	deliveryRepo.GetFunction = func(ctx context.Context, id uint) (models.NotificationDelivery, error) {
+		return getFailedNotificationDelivery(), nil
+	}
+	deliveryRepo.UpdateFunction = func(ctx context.Context, input models.NotificationDelivery) error {
+		assert.Fail(t, "a delivery of another project must not be re-sent")
+		return nil
+	}
+	manager := NewNotificationDeliveryManager(repository, &notificationMocks.MockPublisher{})
+	_, err := manager.ResendNotificationDelivery(context.Background(),
+		managerInterfaces.NotificationDeliveryResendRequest{ID: 1, Project: "other", Domain: "domain"})
+	assert.Equal(t, codes.NotFound, err.(errors.FlyteAdminError).Code())
+}
+
+func TestResendNotificationDelivery_PublishError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	deliveryRepo := repository.NotificationDeliveryRepo().(*repositoryMocks.MockNotificationDeliveryRepo)
+	deliveryRepo.GetFunction = func(ctx context.Context, id uint) (models.NotificationDelivery, error) {
+		return getFailedNotificationDelivery(), nil
+	}
+	var updated models.NotificationDelivery
+	deliveryRepo.UpdateFunction = func(ctx context.Context, input models.NotificationDelivery) error {
+		updated = input
+		return nil
+	}
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		return errors.NewFlyteAdminError(codes.Unavailable, "topic unavailable")
+	})
+
+	manager := NewNotificationDeliveryManager(repository, &publisher)
+	_, err := manager.ResendNotificationDelivery(context.Background(),
+		managerInterfaces.NotificationDeliveryResendRequest{ID: 1, Project: "project", Domain: "domain"})
+	assert.Equal(t, codes.Internal, err.(errors.FlyteAdminError).Code())
+	assert.Equal(t, models.NotificationDeliveryFailed, updated.Status)
+	assert.Contains(t, updated.Error, "topic unavailable")
+}
@@ -0,0 +1,121 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+type NotificationRuleManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+// Returns the notification rules which apply to a workflow execution. Rules registered for the execution's launch plan
+// take precedence over those for its workflow, which in turn take precedence over those for its project and domain.
+// Returns nil when no rules apply.
+func getExecutionNotificationRules(ctx context.Context, db repositories.RepositoryInterface,
+	executionID *core.WorkflowExecutionIdentifier) (*runtimeInterfaces.NotificationRules, error) {
+	executionModel, err := util.GetExecutionModel(ctx, db, *executionID)
+	if err != nil {
+		return nil, err
+	}
+	execution, err := transformers.FromExecutionModel(*executionModel)
+	if err != nil {
+		return nil, err
+	}
+	return util.GetNotificationRules(ctx, db, executionID.Project, executionID.Domain,
+		execution.GetClosure().GetWorkflowId().GetName(), execution.GetSpec().GetLaunchPlan().GetName())
+}
+
+func (m *NotificationRuleManager) UpdateNotificationRules(
+	ctx context.Context, request interfaces.NotificationRuleAttributes) (
+	*interfaces.NotificationRuleUpdateResponse, error) {
+	if err := validation.ValidateNotificationRuleAttributes(
+		ctx, m.db, m.config.ApplicationConfiguration(), request); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	model, err := transformers.NotificationRulesToResourceModel(repoInterfaces.ResourceID{
+		Project:    request.Project,
+		Domain:     request.Domain,
+		Workflow:   request.Workflow,
+		LaunchPlan: request.LaunchPlan,
+	}, request.Rules)
+	if err != nil {
+		return nil, err
+	}
+	if err = m.db.ResourceRepo().CreateOrUpdate(ctx, model); err != nil {
+		return nil, err
+	}
+	return &interfaces.NotificationRuleUpdateResponse{}, nil
+}
+
+func (m *NotificationRuleManager) GetNotificationRules(
+	ctx context.Context, request interfaces.NotificationRuleGetRequest) (
+	*interfaces.NotificationRuleAttributes, error) {
+	if err := validation.ValidateNotificationRuleGetRequest(
+		ctx, m.db, m.config.ApplicationConfiguration(), request); err != nil {
+		return nil, err
+	}
+	model, err := m.db.ResourceRepo().GetRaw(ctx, repoInterfaces.ResourceID{
+		Project:      request.Project,
+		Domain:       request.Domain,
+		Workflow:     request.Workflow,
+		LaunchPlan:   request.LaunchPlan,
+		ResourceType: models.NotificationRuleResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rules, err := transformers.FromResourceModelToNotificationRules(model)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.NotificationRuleAttributes{
+		Project:    request.Project,
+		Domain:     request.Domain,
+		Workflow:   request.Workflow,
+		LaunchPlan: request.LaunchPlan,
+		Rules:      rules,
+	}, nil
+}
+
+func (m *NotificationRuleManager) DeleteNotificationRules(
+	ctx context.Context, request interfaces.NotificationRuleGetRequest) (
+	*interfaces.NotificationRuleDeleteResponse, error) {
+	if err := validation.ValidateNotificationRuleGetRequest(
+		ctx, m.db, m.config.ApplicationConfiguration(), request); err != nil {
+		return nil, err
+	}
+	if err := m.db.ResourceRepo().Delete(ctx, repoInterfaces.ResourceID{
+		Project:      request.Project,
+		Domain:       request.Domain,
+		Workflow:     request.Workflow,
+		LaunchPlan:   request.LaunchPlan,
+		ResourceType: models.NotificationRuleResourceType,
+	}); err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Deleted notification rules for: %s-%s-%s-%s", request.Project, request.Domain,
+		request.Workflow, request.LaunchPlan)
+	return &interfaces.NotificationRuleDeleteResponse{}, nil
+}
+
+func NewNotificationRuleManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.NotificationRuleInterface {
+	return &NotificationRuleManager{
+		db:     db,
+		config: config,
+	}
+}
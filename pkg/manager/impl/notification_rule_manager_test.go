@@ -0,0 +1,180 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var failedNotificationRules = runtimeInterfaces.NotificationRules{
+	Nodes: []runtimeInterfaces.NotificationRule{
+		{
+			NodeID:     "node id",
+			Phases:     []string{"FAILED"},
+			Recipients: []string{"node@example.com"},
+		},
+	},
+	Tasks: []runtimeInterfaces.NotificationRule{
+		{
+			TaskName:        "task-id",
+			Phases:          []string{"FAILED"},
+			MinRetryAttempt: 1,
+			Channel:         models.NotificationChannelSlack,
+			Recipients:      []string{"task@example.com"},
+		},
+	},
+}
+
+func getMockNotificationRuleConfig() runtimeInterfaces.Configuration {
+	applicationConfig := testutils.GetApplicationConfigWithDefaultDomains()
+	applicationConfig.(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Sender:     "flyte@example.com",
+			ConsoleURL: "https://example.com/console",
+		},
+	})
+	return runtimeMocks.NewMockConfigurationProvider(applicationConfig, nil, nil, nil, nil, nil)
+}
+
+// Registers an execution of workflow "workflow" launched by launch plan "launch_plan", with the given notification rules.
+func addGetNotificationRulesCallbacks(
+	t *testing.T, repository repositories.RepositoryInterface, rules runtimeInterfaces.NotificationRules) {
+	spec, _ := proto.Marshal(&admin.ExecutionSpec{
+		LaunchPlan: &core.Identifier{Name: "launch_plan"},
+	})
+	closure, _ := proto.Marshal(&admin.ExecutionClosure{
+		WorkflowId: &core.Identifier{Name: "workflow"},
+	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+				},
+				Spec:    spec,
+				Closure: closure,
+			}, nil
+		})
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, interfaces.ResourceID{
+			Project:      "project",
+			Domain:       "domain",
+			Workflow:     "workflow",
+			LaunchPlan:   "launch_plan",
+			ResourceType: models.NotificationRuleResourceType,
+		}, ID)
+		attributes, _ := json.Marshal(rules)
+		return models.Resource{Attributes: attributes}, nil
+	}
+}
+
+func TestUpdateNotificationRules(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var createOrUpdateCalled bool
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).CreateOrUpdateFunction = func(
+		ctx context.Context, input models.Resource) error {
+		assert.Equal(t, "project", input.Project)
+		assert.Equal(t, "domain", input.Domain)
+		assert.Equal(t, "workflow", input.Workflow)
+		assert.Equal(t, "launch_plan", input.LaunchPlan)
+		assert.Equal(t, models.NotificationRuleResourceType, input.ResourceType)
+		assert.Equal(t, models.ResourcePriorityLaunchPlanLevel, input.Priority)
+		var rules runtimeInterfaces.NotificationRules
+		assert.NoError(t, json.Unmarshal(input.Attributes, &rules))
+		assert.Equal(t, failedNotificationRules, rules)
+		createOrUpdateCalled = true
+		return nil
+	}
+	manager := NewNotificationRuleManager(repository, getMockNotificationRuleConfig())
+	_, err := manager.UpdateNotificationRules(context.Background(), managerInterfaces.NotificationRuleAttributes{
+		Project:    "project",
+		Domain:     "domain",
+		Workflow:   "workflow",
+		LaunchPlan: "launch_plan",
+		Rules:      failedNotificationRules,
+	})
+	assert.NoError(t, err)
+	assert.True(t, createOrUpdateCalled)
+}
+
+func TestUpdateNotificationRules_InvalidRequest(t *testing.T) {
+	manager := NewNotificationRuleManager(repositoryMocks.NewMockRepository(), getMockNotificationRuleConfig())
+	for _, request := range []managerInterfaces.NotificationRuleAttributes{
+		{Project: "project", Domain: "domain"},
+		{Project: "project", Domain: "domain", LaunchPlan: "launch_plan", Rules: failedNotificationRules},
+		{Project: "project", Domain: "domain", Rules: runtimeInterfaces.NotificationRules{
+			Nodes: []runtimeInterfaces.NotificationRule{{Phases: []string{"failed"}, Recipients: []string{"a"}}},
+		}},
+		{Project: "project", Domain: "domain", Rules: runtimeInterfaces.NotificationRules{
+			Nodes: []runtimeInterfaces.NotificationRule{
+				{TaskName: "task", Phases: []string{"FAILED"}, Recipients: []string{"a"}},
+			},
+		}},
+	} {
+		_, err := manager.UpdateNotificationRules(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	}
+}
+
+func TestGetNotificationRules(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, interfaces.ResourceID{
+			Project:      "project",
+			Domain:       "domain",
+			Workflow:     "workflow",
+			ResourceType: models.NotificationRuleResourceType,
+		}, ID)
+		attributes, _ := json.Marshal(failedNotificationRules)
+		return models.Resource{Attributes: attributes}, nil
+	}
+	manager := NewNotificationRuleManager(repository, getMockNotificationRuleConfig())
+	response, err := manager.GetNotificationRules(context.Background(), managerInterfaces.NotificationRuleGetRequest{
+		Project:  "project",
+		Domain:   "domain",
+		Workflow: "workflow",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, failedNotificationRules, response.Rules)
+	assert.Equal(t, "workflow", response.Workflow)
+}
+
+func TestDeleteNotificationRules(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var deleteCalled bool
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).DeleteFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) error {
+		assert.Equal(t, models.NotificationRuleResourceType, ID.ResourceType)
+		assert.Equal(t, "launch_plan", ID.LaunchPlan)
+		deleteCalled = true
+		return nil
+	}
+	manager := NewNotificationRuleManager(repository, getMockNotificationRuleConfig())
+	_, err := manager.DeleteNotificationRules(context.Background(), managerInterfaces.NotificationRuleGetRequest{
+		Project:    "project",
+		Domain:     "domain",
+		Workflow:   "workflow",
+		LaunchPlan: "launch_plan",
+	})
+	assert.NoError(t, err)
+	assert.True(t, deleteCalled)
+}
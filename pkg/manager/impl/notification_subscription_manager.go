@@ -0,0 +1,160 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+type NotificationSubscriptionManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func toNotificationSubscription(model models.NotificationSubscription) interfaces.NotificationSubscription {
+	return interfaces.NotificationSubscription{
+		ID:         model.ID,
+		Project:    model.Project,
+		Domain:     model.Domain,
+		Workflow:   model.Workflow,
+		LaunchPlan: model.LaunchPlan,
+		Channel:    model.Channel,
+		Recipient:  model.Recipient,
+		Phases:     transformers.FromNotificationSubscriptionModelPhases(model),
+		CreatedBy:  model.CreatedBy,
+		CreatedAt:  model.CreatedAt,
+	}
+}
+
+// Defaults the channel of the subscription and validates it.
+func (m *NotificationSubscriptionManager) validate(
+	ctx context.Context, request *interfaces.NotificationSubscription) error {
+	if len(request.Channel) == 0 {
+		request.Channel = models.NotificationChannelEmail
+	}
+	return validation.ValidateNotificationSubscription(ctx, m.db, m.config.ApplicationConfiguration(), *request)
+}
+
+func (m *NotificationSubscriptionManager) CreateNotificationSubscription(
+	ctx context.Context, request interfaces.NotificationSubscription) (*interfaces.NotificationSubscription, error) {
+	if err := m.validate(ctx, &request); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	model := transformers.CreateNotificationSubscriptionModel(repoInterfaces.NotificationSubscriptionScope{
+		Project:    request.Project,
+		Domain:     request.Domain,
+		Workflow:   request.Workflow,
+		LaunchPlan: request.LaunchPlan,
+	}, request.Channel, request.Recipient, request.Phases, getUser(ctx))
+	if err := m.db.NotificationSubscriptionRepo().Create(ctx, &model); err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Created notification subscription [%d] for %s recipient [%s]", model.ID, model.Channel,
+		model.Recipient)
+	subscription := toNotificationSubscription(model)
+	return &subscription, nil
+}
+
+func (m *NotificationSubscriptionManager) UpdateNotificationSubscription(
+	ctx context.Context, request interfaces.NotificationSubscription) (*interfaces.NotificationSubscription, error) {
+	if request.ID == 0 {
+		return nil, shared.GetMissingArgumentError(shared.ID)
+	}
+	if err := m.validate(ctx, &request); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	existing, err := m.db.NotificationSubscriptionRepo().Get(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	// The request is authorized for its project and domain, so subscriptions of other scopes can't be updated with it.
+	if existing.Project != request.Project || existing.Domain != request.Domain {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"notification subscription [%d] not found in project [%s] domain [%s]", request.ID, request.Project,
+			request.Domain)
+	}
+	model := transformers.CreateNotificationSubscriptionModel(repoInterfaces.NotificationSubscriptionScope{
+		Project:    request.Project,
+		Domain:     request.Domain,
+		Workflow:   request.Workflow,
+		LaunchPlan: request.LaunchPlan,
+	}, request.Channel, request.Recipient, request.Phases, existing.CreatedBy)
+	model.ID = existing.ID
+	model.CreatedAt = existing.CreatedAt
+	if err = m.db.NotificationSubscriptionRepo().Update(ctx, model); err != nil {
+		return nil, err
+	}
+	subscription := toNotificationSubscription(model)
+	return &subscription, nil
+}
+
+func (m *NotificationSubscriptionManager) ListNotificationSubscriptions(
+	ctx context.Context, request interfaces.NotificationSubscriptionListRequest) (
+	*interfaces.NotificationSubscriptionList, error) {
+	if err := validation.ValidateNotificationSubscriptionListRequest(
+		ctx, m.db, m.config.ApplicationConfiguration(), request); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	subscriptionModels, err := m.db.NotificationSubscriptionRepo().List(ctx, repoInterfaces.NotificationSubscriptionScope{
+		Project:    request.Project,
+		Domain:     request.Domain,
+		Workflow:   request.Workflow,
+		LaunchPlan: request.LaunchPlan,
+	})
+	if err != nil {
+		return nil, err
+	}
+	subscriptions := make([]interfaces.NotificationSubscription, len(subscriptionModels))
+	for idx, model := range subscriptionModels {
+		subscriptions[idx] = toNotificationSubscription(model)
+	}
+	return &interfaces.NotificationSubscriptionList{
+		Subscriptions: subscriptions,
+	}, nil
+}
+
+func (m *NotificationSubscriptionManager) DeleteNotificationSubscription(
+	ctx context.Context, request interfaces.NotificationSubscriptionDeleteRequest) (
+	*interfaces.NotificationSubscriptionDeleteResponse, error) {
+	if err := validation.ValidateNotificationSubscriptionDeleteRequest(request); err != nil {
+		return nil, err
+	}
+	existing, err := m.db.NotificationSubscriptionRepo().Get(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	// The request is authorized for its project and domain, so subscriptions of other scopes can't be deleted with it.
+	if existing.Project != request.Project || existing.Domain != request.Domain {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"notification subscription [%d] not found in project [%s] domain [%s]",
+			request.ID, request.Project, request.Domain)
+	}
+	if err = m.db.NotificationSubscriptionRepo().Delete(ctx, request.ID); err != nil {
+		logger.Debugf(ctx, "Failed to delete notification subscription [%d] with err: %v", request.ID, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "Deleted notification subscription [%d]", request.ID)
+	return &interfaces.NotificationSubscriptionDeleteResponse{}, nil
+}
+
+func NewNotificationSubscriptionManager(db repositories.RepositoryInterface,
+	config runtimeInterfaces.Configuration) interfaces.NotificationSubscriptionInterface {
+	return &NotificationSubscriptionManager{
+		db:     db,
+		config: config,
+	}
+}
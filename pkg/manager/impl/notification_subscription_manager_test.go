@@ -0,0 +1,204 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateNotificationSubscription(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationSubscriptionRepo().(*repositoryMocks.MockNotificationSubscriptionRepo).CreateFunction = func(
+		ctx context.Context, input *models.NotificationSubscription) error {
+		assert.Equal(t, models.NotificationSubscription{
+			Project:    "project",
+			Domain:     "domain",
+			LaunchPlan: "launch_plan",
+			Channel:    models.NotificationChannelEmail,
+			Recipient:  "a@example.com",
+			Phases:     "FAILED,TIMED_OUT",
+		}, *input)
+		input.ID = 1
+		return nil
+	}
+	manager := NewNotificationSubscriptionManager(repository, getMockNotificationRuleConfig())
+	subscription, err := manager.CreateNotificationSubscription(context.Background(),
+		managerInterfaces.NotificationSubscription{
+			Project:    "project",
+			Domain:     "domain",
+			LaunchPlan: "launch_plan",
+			Recipient:  "a@example.com",
+			Phases:     []string{"FAILED", "TIMED_OUT"},
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), subscription.ID)
+	assert.Equal(t, models.NotificationChannelEmail, subscription.Channel)
+	assert.Equal(t, []string{"FAILED", "TIMED_OUT"}, subscription.Phases)
+}
+
+func TestCreateNotificationSubscription_InvalidRequest(t *testing.T) {
+	manager := NewNotificationSubscriptionManager(repositoryMocks.NewMockRepository(), getMockNotificationRuleConfig())
+	for _, request := range []managerInterfaces.NotificationSubscription{
+		{Project: "project", Domain: "domain", Phases: []string{"FAILED"}},
+		{Project: "project", Domain: "domain", Recipient: "a@example.com"},
+		{Project: "project", Domain: "domain", Recipient: "a@example.com", Phases: []string{"failed"}},
+		{Project: "project", Domain: "domain", Recipient: "a@example.com", Phases: []string{"RUNNING"}},
+		{Project: "project", Domain: "domain", Recipient: "a@example.com", Phases: []string{"FAILED"}, Channel: "sms"},
+	} {
+		_, err := manager.CreateNotificationSubscription(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	}
+}
+
+func TestUpdateNotificationSubscription(t *testing.T) {
+	createdAt := time.Now()
+	repository := repositoryMocks.NewMockRepository()
+	subscriptionRepo := repository.NotificationSubscriptionRepo().(*repositoryMocks.MockNotificationSubscriptionRepo)
+	subscriptionRepo.GetFunction = func(ctx context.Context, id uint) (models.NotificationSubscription, error) {
+		assert.Equal(t, uint(3), id)
+		return models.NotificationSubscription{
+			ID:        3,
+			CreatedAt: createdAt,
+			Project:   "project",
+			Domain:    "domain",
+			Channel:   models.NotificationChannelEmail,
+			Recipient: "a@example.com",
+			Phases:    "FAILED",
+			CreatedBy: "creator",
+		}, nil
+	}
+	var updated models.NotificationSubscription
+	subscriptionRepo.UpdateFunction = func(ctx context.Context, input models.NotificationSubscription) error {
+		updated = input
+		return nil
+	}
+	manager := NewNotificationSubscriptionManager(repository, getMockNotificationRuleConfig())
+	_, err := manager.UpdateNotificationSubscription(context.Background(), managerInterfaces.NotificationSubscription{
+		ID:        3,
+		Project:   "project",
+		Domain:    "domain",
+		Channel:   models.NotificationChannelSlack,
+		Recipient: "@oncall",
+		Phases:    []string{"FAILED", "ABORTED"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.NotificationSubscription{
+		ID:        3,
+		CreatedAt: createdAt,
+		Project:   "project",
+		Domain:    "domain",
+		Channel:   models.NotificationChannelSlack,
+		Recipient: "@oncall",
+		Phases:    "FAILED,ABORTED",
+		CreatedBy: "creator",
+	}, updated)
+
+	_, err = manager.UpdateNotificationSubscription(context.Background(), managerInterfaces.NotificationSubscription{
+		Project:   "project",
+		Domain:    "domain",
+		Recipient: "@oncall",
+		Phases:    []string{"FAILED"},
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
+
+func TestUpdateNotificationSubscription_OtherProject(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	subscriptionRepo := repository.NotificationSubscriptionRepo().(*repositoryMocks.MockNotificationSubscriptionRepo)
+	subscriptionRepo.GetFunction = func(ctx context.Context, id uint) (models.NotificationSubscription, error) {
+		return models.NotificationSubscription{
+			ID:        3,
+			Project:   "other-project",
+			Domain:    "domain",
+			Channel:   models.NotificationChannelEmail,
+			Recipient: "a@example.com",
+			Phases:    "FAILED",
+		}, nil
+	}
+	subscriptionRepo.UpdateFunction = func(ctx context.Context, input models.NotificationSubscription) error {
+		assert.Fail(t, "subscriptions of other projects shouldn't be updated")
+		return nil
+	}
+	manager := NewNotificationSubscriptionManager(repository, getMockNotificationRuleConfig())
+	_, err := manager.UpdateNotificationSubscription(context.Background(), managerInterfaces.NotificationSubscription{
+		ID:        3,
+		Project:   "project",
+		Domain:    "domain",
+		Recipient: "attacker@example.com",
+		Phases:    []string{"FAILED"},
+	})
+	assert.Equal(t, codes.NotFound, err.(errors.FlyteAdminError).Code())
+}
+
+func TestListNotificationSubscriptions(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationSubscriptionRepo().(*repositoryMocks.MockNotificationSubscriptionRepo).ListFunction = func(
+		ctx context.Context, scope interfaces.NotificationSubscriptionScope) ([]models.NotificationSubscription, error) {
+		assert.Equal(t, interfaces.NotificationSubscriptionScope{
+			Project:  "project",
+			Domain:   "domain",
+			Workflow: "workflow",
+		}, scope)
+		return []models.NotificationSubscription{
+			{ID: 1, Project: "project", Domain: "domain", Workflow: "workflow", Recipient: "a", Phases: "FAILED"},
+			{ID: 2, Project: "project", Domain: "domain", Workflow: "workflow", Recipient: "b", Phases: "SUCCEEDED"},
+		}, nil
+	}
+	manager := NewNotificationSubscriptionManager(repository, getMockNotificationRuleConfig())
+	list, err := manager.ListNotificationSubscriptions(context.Background(),
+		managerInterfaces.NotificationSubscriptionListRequest{
+			Project:  "project",
+			Domain:   "domain",
+			Workflow: "workflow",
+		})
+	assert.NoError(t, err)
+	assert.Len(t, list.Subscriptions, 2)
+	assert.Equal(t, "b", list.Subscriptions[1].Recipient)
+	assert.Equal(t, []string{"SUCCEEDED"}, list.Subscriptions[1].Phases)
+}
+
+func TestDeleteNotificationSubscription(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	subscriptionRepo := repository.NotificationSubscriptionRepo().(*repositoryMocks.MockNotificationSubscriptionRepo)
+	subscriptionRepo.GetFunction = func(ctx context.Context, id uint) (models.NotificationSubscription, error) {
+		return models.NotificationSubscription{ID: id, Project: "project", Domain: "domain"}, nil
+	}
+	var deleted uint
+	subscriptionRepo.DeleteFunction = func(ctx context.Context, id uint) error {
+		deleted = id
+		return nil
+	}
+	manager := NewNotificationSubscriptionManager(repository, getMockNotificationRuleConfig())
+	_, err := manager.DeleteNotificationSubscription(context.Background(),
+		managerInterfaces.NotificationSubscriptionDeleteRequest{ID: 4, Project: "project", Domain: "domain"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(4), deleted)
+
+	_, err = manager.DeleteNotificationSubscription(context.Background(),
+		managerInterfaces.NotificationSubscriptionDeleteRequest{})
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
+
+func TestDeleteNotificationSubscription_OtherProject(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	subscriptionRepo := repository.NotificationSubscriptionRepo().(*repositoryMocks.MockNotificationSubscriptionRepo)
+	subscriptionRepo.GetFunction = func(ctx context.Context, id uint) (models.NotificationSubscription, error) {
+		return models.NotificationSubscription{ID: id, Project: "project", Domain: "domain"}, nil
+	}
+	subscriptionRepo.DeleteFunction = func(ctx context.Context, id uint) error {
+		assert.Fail(t, "a subscription of another project must not be deleted")
+		return nil
+	}
+	manager := NewNotificationSubscriptionManager(repository, getMockNotificationRuleConfig())
+	_, err := manager.DeleteNotificationSubscription(context.Background(),
+		managerInterfaces.NotificationSubscriptionDeleteRequest{ID: 4, Project: "other", Domain: "domain"})
+	assert.Equal(t, codes.NotFound, err.(errors.FlyteAdminError).Code())
+}
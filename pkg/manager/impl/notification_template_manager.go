@@ -0,0 +1,220 @@
+package impl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/storage"
+	"google.golang.org/grpc/codes"
+)
+
+// Where the template used to render a notification came from.
+const (
+	notificationSourceRequest    = "request"
+	notificationSourceRegistered = "registered"
+	notificationSourceConfig     = "config"
+	notificationSourceLegacy     = "legacy"
+)
+
+type NotificationTemplateManager struct {
+	db            repositories.RepositoryInterface
+	config        runtimeInterfaces.Configuration
+	storageClient *storage.DataStore
+}
+
+// Selects the notification template for a workflow execution phase. Templates registered for the execution's project,
+// domain and workflow take precedence over those in the application config. When neither matches, the legacy
+// configured subject and body should be used.
+func resolveNotificationTemplate(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.NotificationsConfig, execution *admin.Execution, phase core.WorkflowExecution_Phase) (
+	runtimeInterfaces.NotificationTemplate, string, error) {
+	registered, err := util.GetNotificationTemplates(ctx, db, execution.GetId().GetProject(),
+		execution.GetId().GetDomain(), execution.GetClosure().GetWorkflowId().GetName())
+	if err != nil {
+		return runtimeInterfaces.NotificationTemplate{}, "", err
+	}
+	if template, ok := notifications.SelectTemplate(phase, registered); ok {
+		return template, notificationSourceRegistered, nil
+	}
+	if template, ok := notifications.SelectTemplate(phase, config.NotificationsEmailerConfig.Templates); ok {
+		return template, notificationSourceConfig, nil
+	}
+	return runtimeInterfaces.NotificationTemplate{}, notificationSourceLegacy, nil
+}
+
+// Reads execution inputs for use in notification templates. Notifications are best effort and failures are only logged.
+func readNotificationInputs(
+	ctx context.Context, storageClient *storage.DataStore, inputsURI storage.DataReference) *core.LiteralMap {
+	if storageClient == nil || len(inputsURI) == 0 {
+		return nil
+	}
+	inputs := &core.LiteralMap{}
+	if err := storageClient.ReadProtobuf(ctx, inputsURI, inputs); err != nil {
+		logger.Infof(ctx, "failed to read inputs [%s] for notification with err: %v", inputsURI, err)
+		return nil
+	}
+	return inputs
+}
+
+func (m *NotificationTemplateManager) UpdateNotificationTemplates(
+	ctx context.Context, request interfaces.NotificationTemplateAttributes) (
+	*interfaces.NotificationTemplateUpdateResponse, error) {
+	if err := validation.ValidateNotificationTemplateAttributes(
+		ctx, m.db, m.config.ApplicationConfiguration(), request); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	model, err := transformers.NotificationTemplatesToResourceModel(repoInterfaces.ResourceID{
+		Project:  request.Project,
+		Domain:   request.Domain,
+		Workflow: request.Workflow,
+	}, request.Templates)
+	if err != nil {
+		return nil, err
+	}
+	if err = m.db.ResourceRepo().CreateOrUpdate(ctx, model); err != nil {
+		return nil, err
+	}
+	return &interfaces.NotificationTemplateUpdateResponse{}, nil
+}
+
+func (m *NotificationTemplateManager) GetNotificationTemplates(
+	ctx context.Context, request interfaces.NotificationTemplateGetRequest) (
+	*interfaces.NotificationTemplateAttributes, error) {
+	if err := validation.ValidateNotificationTemplateGetRequest(
+		ctx, m.db, m.config.ApplicationConfiguration(), request); err != nil {
+		return nil, err
+	}
+	model, err := m.db.ResourceRepo().GetRaw(ctx, repoInterfaces.ResourceID{
+		Project:      request.Project,
+		Domain:       request.Domain,
+		Workflow:     request.Workflow,
+		ResourceType: models.NotificationTemplateResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	templates, err := transformers.FromResourceModelToNotificationTemplates(model)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.NotificationTemplateAttributes{
+		Project:   request.Project,
+		Domain:    request.Domain,
+		Workflow:  request.Workflow,
+		Templates: templates,
+	}, nil
+}
+
+func (m *NotificationTemplateManager) DeleteNotificationTemplates(
+	ctx context.Context, request interfaces.NotificationTemplateGetRequest) (
+	*interfaces.NotificationTemplateDeleteResponse, error) {
+	if err := validation.ValidateNotificationTemplateGetRequest(
+		ctx, m.db, m.config.ApplicationConfiguration(), request); err != nil {
+		return nil, err
+	}
+	if err := m.db.ResourceRepo().Delete(ctx, repoInterfaces.ResourceID{
+		Project:      request.Project,
+		Domain:       request.Domain,
+		Workflow:     request.Workflow,
+		ResourceType: models.NotificationTemplateResourceType,
+	}); err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Deleted notification templates for: %s-%s-%s", request.Project, request.Domain,
+		request.Workflow)
+	return &interfaces.NotificationTemplateDeleteResponse{}, nil
+}
+
+func (m *NotificationTemplateManager) PreviewNotification(
+	ctx context.Context, request interfaces.NotificationPreviewRequest) (*interfaces.NotificationPreviewResponse, error) {
+	if err := validation.ValidateNotificationPreviewRequest(request); err != nil {
+		return nil, err
+	}
+	executionID := &core.WorkflowExecutionIdentifier{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Name:    request.Name,
+	}
+	ctx = getExecutionContext(ctx, executionID)
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *executionID)
+	if err != nil {
+		return nil, err
+	}
+	execution, err := transformers.FromExecutionModel(*executionModel)
+	if err != nil {
+		return nil, err
+	}
+	phase := execution.GetClosure().GetPhase()
+	if len(request.Phase) > 0 {
+		phase = core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[strings.ToUpper(request.Phase)])
+	}
+
+	notificationsConfig := *m.config.ApplicationConfiguration().GetNotificationsConfig()
+	template, source := runtimeInterfaces.NotificationTemplate{}, notificationSourceRequest
+	if request.Template != nil {
+		template = *request.Template
+	} else {
+		template, source, err = resolveNotificationTemplate(ctx, m.db, notificationsConfig, execution, phase)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if source == notificationSourceLegacy {
+		email := notifications.ToEmailMessageFromWorkflowExecutionEvent(notificationsConfig, admin.EmailNotification{},
+			admin.WorkflowExecutionEventRequest{
+				Event: &event.WorkflowExecutionEvent{
+					ExecutionId: executionID,
+					Phase:       phase,
+					OutputResult: &event.WorkflowExecutionEvent_Error{
+						Error: execution.GetClosure().GetError(),
+					},
+				},
+			}, execution)
+		return &interfaces.NotificationPreviewResponse{
+			Subject: email.SubjectLine,
+			Body:    email.Body,
+			Source:  source,
+		}, nil
+	}
+
+	data := notifications.NewTemplateData(execution, readNotificationInputs(ctx, m.storageClient,
+		executionModel.InputsURI), notificationsConfig.NotificationsEmailerConfig.ConsoleURL)
+	data.Phase = strings.ToLower(phase.String())
+	subject, body, err := notifications.RenderTemplate(template, data)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"failed to render %s notification template: %v", source, err)
+	}
+	return &interfaces.NotificationPreviewResponse{
+		Subject: subject,
+		Body:    body,
+		HTML:    template.HTML,
+		Source:  source,
+	}, nil
+}
+
+func NewNotificationTemplateManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	storageClient *storage.DataStore) interfaces.NotificationTemplateInterface {
+	return &NotificationTemplateManager{
+		db:            db,
+		config:        config,
+		storageClient: storageClient,
+	}
+}
@@ -0,0 +1,197 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var failedNotificationTemplates = map[string]runtimeInterfaces.NotificationTemplate{
+	"failed": {
+		Subject: "{{ .Name }} {{ .Phase }}",
+		Body:    "<p>{{ .Error }}</p>",
+		HTML:    true,
+	},
+}
+
+func getMockNotificationTemplateConfig() runtimeInterfaces.Configuration {
+	applicationConfig := testutils.GetApplicationConfigWithDefaultDomains()
+	applicationConfig.(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Subject: "{{ name }} is {{ phase }}",
+			Templates: map[string]runtimeInterfaces.NotificationTemplate{
+				"succeeded": {Subject: "config {{ .Name }}"},
+			},
+		},
+	})
+	return runtimeMocks.NewMockConfigurationProvider(applicationConfig, nil, nil, nil, nil, nil)
+}
+
+func TestUpdateNotificationTemplates(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var createOrUpdateCalled bool
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).CreateOrUpdateFunction = func(
+		ctx context.Context, input models.Resource) error {
+		assert.Equal(t, "project", input.Project)
+		assert.Equal(t, "domain", input.Domain)
+		assert.Equal(t, "workflow", input.Workflow)
+		assert.Equal(t, models.NotificationTemplateResourceType, input.ResourceType)
+		assert.Equal(t, models.ResourcePriorityWorkflowLevel, input.Priority)
+		var templates map[string]runtimeInterfaces.NotificationTemplate
+		assert.NoError(t, json.Unmarshal(input.Attributes, &templates))
+		assert.Equal(t, failedNotificationTemplates, templates)
+		createOrUpdateCalled = true
+		return nil
+	}
+	manager := NewNotificationTemplateManager(repository, getMockNotificationTemplateConfig(), nil)
+	_, err := manager.UpdateNotificationTemplates(context.Background(), managerInterfaces.NotificationTemplateAttributes{
+		Project:   "project",
+		Domain:    "domain",
+		Workflow:  "workflow",
+		Templates: failedNotificationTemplates,
+	})
+	assert.NoError(t, err)
+	assert.True(t, createOrUpdateCalled)
+}
+
+func TestUpdateNotificationTemplates_InvalidRequest(t *testing.T) {
+	manager := NewNotificationTemplateManager(
+		repositoryMocks.NewMockRepository(), getMockNotificationTemplateConfig(), nil)
+	for _, templates := range []map[string]runtimeInterfaces.NotificationTemplate{
+		nil,
+		{"FAILED": {Subject: "subject"}},
+		{"bogus": {Subject: "subject"}},
+		{"default": {Subject: "{{ .Name "}},
+	} {
+		_, err := manager.UpdateNotificationTemplates(context.Background(),
+			managerInterfaces.NotificationTemplateAttributes{
+				Project:   "project",
+				Domain:    "domain",
+				Templates: templates,
+			})
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	}
+}
+
+func TestGetNotificationTemplates(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, interfaces.ResourceID{
+			Project:      "project",
+			Domain:       "domain",
+			ResourceType: models.NotificationTemplateResourceType,
+		}, ID)
+		attributes, _ := json.Marshal(failedNotificationTemplates)
+		return models.Resource{Attributes: attributes}, nil
+	}
+	manager := NewNotificationTemplateManager(repository, getMockNotificationTemplateConfig(), nil)
+	response, err := manager.GetNotificationTemplates(context.Background(), managerInterfaces.NotificationTemplateGetRequest{
+		Project: "project",
+		Domain:  "domain",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, failedNotificationTemplates, response.Templates)
+}
+
+func TestDeleteNotificationTemplates(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var deleteCalled bool
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).DeleteFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) error {
+		assert.Equal(t, models.NotificationTemplateResourceType, ID.ResourceType)
+		deleteCalled = true
+		return nil
+	}
+	manager := NewNotificationTemplateManager(repository, getMockNotificationTemplateConfig(), nil)
+	_, err := manager.DeleteNotificationTemplates(context.Background(), managerInterfaces.NotificationTemplateGetRequest{
+		Project: "project",
+		Domain:  "domain",
+	})
+	assert.NoError(t, err)
+	assert.True(t, deleteCalled)
+}
+
+func getNotificationPreviewRepository(t *testing.T) repositories.RepositoryInterface {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return getFailedExecutionModelWithEmailNotification(), nil
+		})
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, "wf_name", ID.Workflow)
+		attributes, _ := json.Marshal(failedNotificationTemplates)
+		return models.Resource{Attributes: attributes}, nil
+	}
+	return repository
+}
+
+func TestPreviewNotification(t *testing.T) {
+	manager := NewNotificationTemplateManager(
+		getNotificationPreviewRepository(t), getMockNotificationTemplateConfig(), nil)
+	request := managerInterfaces.NotificationPreviewRequest{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+
+	t.Run("registered", func(t *testing.T) {
+		response, err := manager.PreviewNotification(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Equal(t, managerInterfaces.NotificationPreviewResponse{
+			Subject: "name failed",
+			Body:    "<p>&lt;oopsie&gt;</p>",
+			HTML:    true,
+			Source:  "registered",
+		}, *response)
+	})
+	t.Run("config", func(t *testing.T) {
+		request := request
+		request.Phase = "succeeded"
+		response, err := manager.PreviewNotification(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Equal(t, "config name", response.Subject)
+		assert.Equal(t, "config", response.Source)
+	})
+	t.Run("legacy", func(t *testing.T) {
+		request := request
+		request.Phase = "aborted"
+		response, err := manager.PreviewNotification(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Equal(t, "name is aborted", response.Subject)
+		assert.Equal(t, "legacy", response.Source)
+	})
+	t.Run("request", func(t *testing.T) {
+		request := request
+		request.Template = &runtimeInterfaces.NotificationTemplate{Subject: "{{ .Error | upper }}"}
+		response, err := manager.PreviewNotification(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Equal(t, "<OOPSIE>", response.Subject)
+		assert.Equal(t, "request", response.Source)
+	})
+	t.Run("render error", func(t *testing.T) {
+		request := request
+		request.Template = &runtimeInterfaces.NotificationTemplate{Subject: "{{ .Missing }}"}
+		_, err := manager.PreviewNotification(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+	t.Run("invalid phase", func(t *testing.T) {
+		request := request
+		request.Phase = "bogus"
+		_, err := manager.PreviewNotification(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+}
@@ -0,0 +1,102 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+type RoleBindingManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func toRoleBinding(model models.RoleBinding) interfaces.RoleBinding {
+	return interfaces.RoleBinding{
+		ID:          model.ID,
+		SubjectType: model.SubjectType,
+		Subject:     model.Subject,
+		Role:        model.Role,
+		Project:     model.Project,
+		Domain:      model.Domain,
+		CreatedBy:   model.CreatedBy,
+		CreatedAt:   model.CreatedAt,
+	}
+}
+
+func (m *RoleBindingManager) CreateRoleBinding(ctx context.Context, request interfaces.RoleBinding) (
+	*interfaces.RoleBinding, error) {
+	if err := validation.ValidateRoleBinding(ctx, m.db, m.config.ApplicationConfiguration(), request); err != nil {
+		return nil, err
+	}
+	model := models.RoleBinding{
+		SubjectType: request.SubjectType,
+		Subject:     request.Subject,
+		Project:     request.Project,
+		Domain:      request.Domain,
+		Role:        request.Role,
+		CreatedBy:   getUser(ctx),
+	}
+	if err := m.db.RoleBindingRepo().Create(ctx, &model); err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Bound role [%s] to %s [%s] in project [%s] domain [%s]", model.Role, model.SubjectType,
+		model.Subject, model.Project, model.Domain)
+	binding := toRoleBinding(model)
+	return &binding, nil
+}
+
+func (m *RoleBindingManager) ListRoleBindings(ctx context.Context, request interfaces.RoleBindingListRequest) (
+	*interfaces.RoleBindingList, error) {
+	if err := validation.ValidateRoleBindingListRequest(request); err != nil {
+		return nil, err
+	}
+	bindingModels, err := m.db.RoleBindingRepo().List(ctx, request.Project, request.Domain)
+	if err != nil {
+		return nil, err
+	}
+	bindings := make([]interfaces.RoleBinding, len(bindingModels))
+	for idx, model := range bindingModels {
+		bindings[idx] = toRoleBinding(model)
+	}
+	return &interfaces.RoleBindingList{
+		RoleBindings: bindings,
+	}, nil
+}
+
+func (m *RoleBindingManager) DeleteRoleBinding(ctx context.Context, request interfaces.RoleBindingDeleteRequest) (
+	*interfaces.RoleBindingDeleteResponse, error) {
+	if err := validation.ValidateRoleBindingDeleteRequest(request); err != nil {
+		return nil, err
+	}
+	existing, err := m.db.RoleBindingRepo().Get(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	// The request is authorized for its project and domain, so bindings of other scopes can't be deleted with it.
+	if existing.Project != request.Project || existing.Domain != request.Domain {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"role binding [%d] not found in project [%s] domain [%s]", request.ID, request.Project, request.Domain)
+	}
+	if err = m.db.RoleBindingRepo().Delete(ctx, request.ID); err != nil {
+		logger.Debugf(ctx, "Failed to delete role binding [%d] with err: %v", request.ID, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "Deleted role binding [%d]", request.ID)
+	return &interfaces.RoleBindingDeleteResponse{}, nil
+}
+
+func NewRoleBindingManager(db repositories.RepositoryInterface,
+	config runtimeInterfaces.Configuration) interfaces.RoleBindingInterface {
+	return &RoleBindingManager{
+		db:     db,
+		config: config,
+	}
+}
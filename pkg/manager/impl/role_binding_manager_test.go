@@ -0,0 +1,109 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateRoleBinding(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.RoleBindingRepo().(*repositoryMocks.MockRoleBindingRepo).CreateFunction = func(
+		ctx context.Context, input *models.RoleBinding) error {
+		assert.Equal(t, models.RoleBinding{
+			SubjectType: models.RoleBindingSubjectGroup,
+			Subject:     "ml-platform",
+			Project:     "project",
+			Domain:      "domain",
+			Role:        models.RoleContributor,
+		}, *input)
+		input.ID = 1
+		return nil
+	}
+	manager := NewRoleBindingManager(repository, getMockNotificationRuleConfig())
+	binding, err := manager.CreateRoleBinding(context.Background(), managerInterfaces.RoleBinding{
+		SubjectType: models.RoleBindingSubjectGroup,
+		Subject:     "ml-platform",
+		Project:     "project",
+		Domain:      "domain",
+		Role:        models.RoleContributor,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), binding.ID)
+	assert.Equal(t, "ml-platform", binding.Subject)
+}
+
+func TestCreateRoleBinding_InvalidRequest(t *testing.T) {
+	manager := NewRoleBindingManager(repositoryMocks.NewMockRepository(), getMockNotificationRuleConfig())
+	for _, request := range []managerInterfaces.RoleBinding{
+		{SubjectType: "robot", Subject: "user", Role: models.RoleViewer},
+		{SubjectType: models.RoleBindingSubjectUser, Role: models.RoleViewer},
+		{SubjectType: models.RoleBindingSubjectUser, Subject: "user", Role: "owner"},
+		{SubjectType: models.RoleBindingSubjectUser, Subject: "user", Role: models.RoleViewer, Domain: "domain"},
+		{SubjectType: models.RoleBindingSubjectUser, Subject: "user", Role: models.RoleViewer, Project: "project",
+			Domain: "unknown"},
+	} {
+		_, err := manager.CreateRoleBinding(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	}
+}
+
+func TestListRoleBindings(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.RoleBindingRepo().(*repositoryMocks.MockRoleBindingRepo).ListFunction = func(
+		ctx context.Context, project, domain string) ([]models.RoleBinding, error) {
+		assert.Equal(t, "project", project)
+		assert.Empty(t, domain)
+		return []models.RoleBinding{
+			{ID: 1, SubjectType: models.RoleBindingSubjectUser, Subject: "user", Project: "project",
+				Role: models.RoleAdmin},
+		}, nil
+	}
+	manager := NewRoleBindingManager(repository, getMockNotificationRuleConfig())
+	bindings, err := manager.ListRoleBindings(context.Background(), managerInterfaces.RoleBindingListRequest{
+		Project: "project",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, bindings.RoleBindings, 1)
+	assert.Equal(t, models.RoleAdmin, bindings.RoleBindings[0].Role)
+
+	_, err = manager.ListRoleBindings(context.Background(), managerInterfaces.RoleBindingListRequest{
+		Domain: "domain",
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
+
+func TestDeleteRoleBinding(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	roleBindingRepo := repository.RoleBindingRepo().(*repositoryMocks.MockRoleBindingRepo)
+	roleBindingRepo.GetFunction = func(ctx context.Context, id uint) (models.RoleBinding, error) {
+		return models.RoleBinding{ID: id, Project: "project", Domain: "domain"}, nil
+	}
+	var deleted uint
+	roleBindingRepo.DeleteFunction = func(ctx context.Context, id uint) error {
+		deleted = id
+		return nil
+	}
+	manager := NewRoleBindingManager(repository, getMockNotificationRuleConfig())
+
+	_, err := manager.DeleteRoleBinding(context.Background(), managerInterfaces.RoleBindingDeleteRequest{
+		ID:      2,
+		Project: "project",
+	})
+	assert.Equal(t, codes.NotFound, err.(errors.FlyteAdminError).Code())
+	assert.Zero(t, deleted)
+
+	_, err = manager.DeleteRoleBinding(context.Background(), managerInterfaces.RoleBindingDeleteRequest{
+		ID:      2,
+		Project: "project",
+		Domain:  "domain",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(2), deleted)
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strconv"
 
+	eventWriter "github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
 	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/golang/protobuf/proto"
 
@@ -44,14 +46,21 @@ type taskExecutionMetrics struct {
 	TaskExecutionInputBytes    prometheus.Summary
 	TaskExecutionOutputBytes   prometheus.Summary
 	PublishEventError          prometheus.Counter
+	PublishNotificationError   prometheus.Counter
+	NotificationRuleError      prometheus.Counter
+	DeliveryRecordError        prometheus.Counter
 }
 
 type TaskExecutionManager struct {
-	db                 repositories.RepositoryInterface
-	config             runtimeInterfaces.Configuration
-	storageClient      *storage.DataStore
-	metrics            taskExecutionMetrics
-	urlData            dataInterfaces.RemoteURLInterface
+	db             repositories.RepositoryInterface
+	config         runtimeInterfaces.Configuration
+	storageClient  *storage.DataStore
+	metrics        taskExecutionMetrics
+	urlData        dataInterfaces.RemoteURLInterface
+	eventPublisher notificationInterfaces.Publisher
+	// Optional, records the task execution events of the execution timeline.
+	dbEventWriter eventWriter.TaskExecutionEventWriter
+	// Optional, publishes the notifications of rules matching task execution phase changes.
 	notificationClient notificationInterfaces.Publisher
 }
 
@@ -88,7 +97,16 @@ func (m *TaskExecutionManager) createTaskExecution(
 		logger.Debugf(ctx, "failed to transform task execution %+v into database model: %v", request.Event.TaskId, err)
 		return models.TaskExecution{}, err
 	}
-	if err := m.db.TaskExecutionRepo().Create(ctx, *taskExecutionModel); err != nil {
+	outboxEvent, err := util.NewOutboxEvent(m.config, nodeExecutionID.ExecutionId, request)
+	if err != nil {
+		return models.TaskExecution{}, err
+	}
+	if outboxEvent != nil {
+		err = m.db.TaskExecutionRepo().CreateWithOutboxEvent(ctx, *taskExecutionModel, *outboxEvent)
+	} else {
+		err = m.db.TaskExecutionRepo().Create(ctx, *taskExecutionModel)
+	}
+	if err != nil {
 		logger.Debugf(ctx, "Failed to create task execution with task id [%+v] with err %v",
 			request.Event.TaskId, err)
 		return models.TaskExecution{}, err
@@ -110,7 +128,15 @@ func (m *TaskExecutionManager) updateTaskExecutionModelState(
 		return models.TaskExecution{}, err
 	}
 
-	err = m.db.TaskExecutionRepo().Update(ctx, *existingTaskExecution)
+	outboxEvent, err := util.NewOutboxEvent(m.config, request.Event.ParentNodeExecutionId.ExecutionId, request)
+	if err != nil {
+		return models.TaskExecution{}, err
+	}
+	if outboxEvent != nil {
+		err = m.db.TaskExecutionRepo().UpdateWithOutboxEvent(ctx, *existingTaskExecution, *outboxEvent)
+	} else {
+		err = m.db.TaskExecutionRepo().Update(ctx, *existingTaskExecution)
+	}
 	if err != nil {
 		logger.Debugf(ctx, "Failed to update task execution with task id [%+v] and task execution model [%+v] with err %v",
 			request.Event.TaskId, existingTaskExecution, err)
@@ -136,9 +162,10 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 	// See if the task execution exists
 	// - if it does check if the new phase is applicable and then update
 	// - if it doesn't, create a task execution
-	taskExecutionModel, err := m.db.TaskExecutionRepo().Get(ctx, repoInterfaces.GetTaskExecutionInput{
+	getTaskExecutionInput := repoInterfaces.GetTaskExecutionInput{
 		TaskExecutionID: taskExecutionID,
-	})
+	}
+	taskExecutionModel, err := m.db.TaskExecutionRepo().Get(ctx, getTaskExecutionInput)
 
 	if err != nil {
 		if err.(errors.FlyteAdminError).Code() != codes.NotFound {
@@ -149,33 +176,51 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 		if err != nil {
 			return nil, err
 		}
+		m.writeDbEvent(request)
+		m.publishNotifications(ctx, request)
 
 		return &admin.TaskExecutionEventResponse{}, nil
 	}
-	if taskExecutionModel.Phase == request.Event.Phase.String() &&
-		taskExecutionModel.PhaseVersion >= request.Event.PhaseVersion {
-		logger.Debugf(ctx, "have already recorded task execution phase %s (version: %d) for %v",
-			request.Event.Phase.String(), request.Event.PhaseVersion, taskExecutionID)
-		return nil, errors.NewFlyteAdminErrorf(codes.AlreadyExists,
-			"have already recorded task execution phase %s (version: %d) for %v",
-			request.Event.Phase.String(), request.Event.PhaseVersion, taskExecutionID)
-	}
+	var currentPhase core.TaskExecution_Phase
+	// Concurrent events may update the task execution after it was read, in which case the phase transition is
+	// validated again against a fresh read of it.
+	reread := false
+	err = util.RetryOnConcurrentUpdate(ctx, func() error {
+		if reread {
+			if taskExecutionModel, err = m.db.TaskExecutionRepo().Get(ctx, getTaskExecutionInput); err != nil {
+				return err
+			}
+		}
+		reread = true
+		if taskExecutionModel.Phase == request.Event.Phase.String() &&
+			taskExecutionModel.PhaseVersion >= request.Event.PhaseVersion {
+			logger.Debugf(ctx, "have already recorded task execution phase %s (version: %d) for %v",
+				request.Event.Phase.String(), request.Event.PhaseVersion, taskExecutionID)
+			return errors.NewFlyteAdminErrorf(codes.AlreadyExists,
+				"have already recorded task execution phase %s (version: %d) for %v",
+				request.Event.Phase.String(), request.Event.PhaseVersion, taskExecutionID)
+		}
 
-	currentPhase := core.TaskExecution_Phase(core.TaskExecution_Phase_value[taskExecutionModel.Phase])
-	if common.IsTaskExecutionTerminal(currentPhase) {
-		// Cannot update a terminal execution.
-		curPhase := request.Event.Phase.String()
-		errorMsg := fmt.Sprintf("invalid phase change from %v to %v for task execution %v", taskExecutionModel.Phase, request.Event.Phase, taskExecutionID)
-		logger.Warnf(ctx, errorMsg)
-		return nil, errors.NewAlreadyInTerminalStateError(ctx, errorMsg, curPhase)
-	}
+		currentPhase = core.TaskExecution_Phase(core.TaskExecution_Phase_value[taskExecutionModel.Phase])
+		if common.IsTaskExecutionTerminal(currentPhase) {
+			// Cannot update a terminal execution.
+			curPhase := request.Event.Phase.String()
+			errorMsg := fmt.Sprintf("invalid phase change from %v to %v for task execution %v", taskExecutionModel.Phase, request.Event.Phase, taskExecutionID)
+			logger.Warnf(ctx, errorMsg)
+			return errors.NewAlreadyInTerminalStateError(ctx, errorMsg, curPhase)
+		}
 
-	taskExecutionModel, err = m.updateTaskExecutionModelState(ctx, &request, &taskExecutionModel)
+		taskExecutionModel, err = m.updateTaskExecutionModelState(ctx, &request, &taskExecutionModel)
+		if err != nil {
+			logger.Debugf(ctx, "Failed to update task execution with id [%+v] with err %v",
+				taskExecutionID, err)
+		}
+		return err
+	})
 	if err != nil {
-		logger.Debugf(ctx, "Failed to update task execution with id [%+v] with err %v",
-			taskExecutionID, err)
 		return nil, err
 	}
+	m.writeDbEvent(request)
 
 	if request.Event.Phase == core.TaskExecution_RUNNING && request.Event.PhaseVersion == 0 {
 		m.metrics.ActiveTaskExecutions.Inc()
@@ -184,9 +229,15 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 		m.metrics.TaskExecutionsTerminated.Inc()
 	}
 
-	if err = m.notificationClient.Publish(ctx, proto.MessageName(&request), &request); err != nil {
-		m.metrics.PublishEventError.Inc()
-		logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
+	// Events written to the outbox are published by the outbox relay.
+	if !util.IsOutboxEnabled(m.config) {
+		if err = m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
+			m.metrics.PublishEventError.Inc()
+			logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
+		}
+	}
+	if currentPhase != request.Event.Phase {
+		m.publishNotifications(ctx, request)
 	}
 
 	m.metrics.TaskExecutionEventsCreated.Inc()
@@ -195,6 +246,40 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 	return &admin.TaskExecutionEventResponse{}, nil
 }
 
+// Queues the event to be recorded in the execution timeline, when a writer is configured.
+func (m *TaskExecutionManager) writeDbEvent(request admin.TaskExecutionEventRequest) {
+	if m.dbEventWriter == nil {
+		return
+	}
+	m.dbEventWriter.Write(request)
+}
+
+// Publishes the notifications of rules matching a task execution which transitioned to a new phase. Notifications are
+// best effort and failures are only logged.
+func (m *TaskExecutionManager) publishNotifications(ctx context.Context, request admin.TaskExecutionEventRequest) {
+	if m.notificationClient == nil {
+		return
+	}
+	nodeExecutionID := request.Event.ParentNodeExecutionId
+	rules, err := getExecutionNotificationRules(ctx, m.db, nodeExecutionID.ExecutionId)
+	if err != nil {
+		m.metrics.NotificationRuleError.Inc()
+		logger.Infof(ctx, "failed to get notification rules for task execution of [%+v] with err: %v",
+			nodeExecutionID, err)
+		return
+	}
+	if rules == nil {
+		return
+	}
+	notificationsConfig := *m.config.ApplicationConfiguration().GetNotificationsConfig()
+	for _, rule := range notifications.MatchTaskRules(*rules, nodeExecutionID.NodeId, request.Event.TaskId.Name,
+		request.Event.Phase, request.Event.RetryAttempt) {
+		email := notifications.ToEmailMessageFromTaskExecutionEvent(notificationsConfig, rule, request)
+		publishNotificationEmail(ctx, m.db, m.notificationClient, nodeExecutionID.ExecutionId,
+			notifications.RuleChannel(rule), email, m.metrics.PublishNotificationError, m.metrics.DeliveryRecordError)
+	}
+}
+
 func (m *TaskExecutionManager) GetTaskExecution(
 	ctx context.Context, request admin.TaskExecutionGetRequest) (*admin.TaskExecution, error) {
 	err := validation.ValidateTaskExecutionIdentifier(request.Id)
@@ -326,7 +411,8 @@ func (m *TaskExecutionManager) GetTaskExecutionData(
 	return response, nil
 }
 
-func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration, storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface, publisher notificationInterfaces.Publisher) interfaces.TaskExecutionInterface {
+func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration, storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface, publisher notificationInterfaces.Publisher,
+	eventWriter eventWriter.TaskExecutionEventWriter, notificationClient notificationInterfaces.Publisher) interfaces.TaskExecutionInterface {
 	metrics := taskExecutionMetrics{
 		Scope: scope,
 		ActiveTaskExecutions: scope.MustNewGauge("active_executions",
@@ -349,6 +435,12 @@ func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtime
 			"size in bytes of serialized node execution outputs"),
 		PublishEventError: scope.MustNewCounter("publish_event_error",
 			"overall count of publish event errors when invoking publish()"),
+		PublishNotificationError: scope.MustNewCounter("publish_notification_error",
+			"overall count of notification publish errors for matched notification rules"),
+		NotificationRuleError: scope.MustNewCounter("notification_rule_error",
+			"overall count of failures to look up notification rules for task execution events"),
+		DeliveryRecordError: scope.MustNewCounter("notification_delivery_record_error",
+			"overall count of failures to record notification deliveries"),
 	}
 	return &TaskExecutionManager{
 		db:                 db,
@@ -356,6 +448,8 @@ func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtime
 		storageClient:      storageClient,
 		metrics:            metrics,
 		urlData:            urlData,
-		notificationClient: publisher,
+		eventPublisher:     publisher,
+		dbEventWriter:      eventWriter,
+		notificationClient: notificationClient,
 	}
 }
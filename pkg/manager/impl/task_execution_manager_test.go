@@ -7,6 +7,8 @@ import (
 	"testing"
 	"time"
 
+	eventWriterMocks "github.com/flyteorg/flyteadmin/pkg/async/events/mocks"
+	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
 	"github.com/flyteorg/flytestdlib/storage"
 
@@ -16,6 +18,7 @@ import (
 	dataMocks "github.com/flyteorg/flyteadmin/pkg/data/mocks"
 	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -175,12 +178,15 @@ func TestCreateTaskEvent(t *testing.T) {
 			}, input)
 			return nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	mockDbEventWriter := &eventWriterMocks.TaskExecutionEventWriter{}
+	mockDbEventWriter.On("Write", taskEventRequest)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, mockDbEventWriter, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, createTaskCalled)
 	assert.Nil(t, err)
 	assert.NotNil(t, resp)
+	mockDbEventWriter.AssertCalled(t, "Write", taskEventRequest)
 }
 
 func TestCreateTaskEvent_Update(t *testing.T) {
@@ -288,7 +294,7 @@ func TestCreateTaskEvent_Update(t *testing.T) {
 		OutputUri: expectedOutputResult.OutputUri,
 	}
 
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, updateTaskCalled)
@@ -307,7 +313,7 @@ func TestCreateTaskEvent_MissingExecution(t *testing.T) {
 		ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
 		return false, expectedErr
 	}
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, "Failed to get existing node execution id: [node_id:\"node-id\""+
 		" execution_id:<project:\"project\" domain:\"domain\" name:\"name\" > ] "+
@@ -318,7 +324,7 @@ func TestCreateTaskEvent_MissingExecution(t *testing.T) {
 		ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
 		return false, nil
 	}
-	taskExecManager = NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager = NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err = taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, "failed to get existing node execution id: [node_id:\"node-id\""+
 		" execution_id:<project:\"project\" domain:\"domain\" name:\"name\" > ]")
@@ -337,7 +343,7 @@ func TestCreateTaskEvent_CreateDatabaseError(t *testing.T) {
 		func(ctx context.Context, input models.TaskExecution) error {
 			return expectedErr
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -377,7 +383,7 @@ func TestCreateTaskEvent_UpdateDatabaseError(t *testing.T) {
 		func(ctx context.Context, execution models.TaskExecution) error {
 			return expectedErr
 		})
-	nodeExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	nodeExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err := nodeExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -410,7 +416,7 @@ func TestCreateTaskEvent_UpdateTerminalEventError(t *testing.T) {
 			}, nil
 		})
 	taskEventRequest.Event.Phase = core.TaskExecution_RUNNING
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 
 	assert.Nil(t, resp)
@@ -477,7 +483,7 @@ func TestCreateTaskEvent_PhaseVersionChange(t *testing.T) {
 	taskEventRequest.Event.PhaseVersion = uint32(1)
 	taskEventRequest.Event.OccurredAt = taskEventUpdatedAtProto
 
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, updateTaskCalled)
@@ -485,6 +491,103 @@ func TestCreateTaskEvent_PhaseVersionChange(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+// Simulates an event for the same task execution which is recorded between the read and the update of this one.
+func addInterleavedTaskExecutionCallbacks(repository repositories.RepositoryInterface,
+	interleavedPhaseVersion uint32, updatedVersions *[]uint32) {
+	var reads uint32
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			var phaseVersion uint32
+			if reads > 0 {
+				phaseVersion = interleavedPhaseVersion
+			}
+			taskExecution := models.TaskExecution{
+				TaskExecutionKey: models.TaskExecutionKey{
+					TaskKey: models.TaskKey{
+						Project: sampleTaskID.Project,
+						Domain:  sampleTaskID.Domain,
+						Name:    sampleTaskID.Name,
+						Version: sampleTaskID.Version,
+					},
+					NodeExecutionKey: models.NodeExecutionKey{
+						NodeID: sampleNodeExecID.NodeId,
+						ExecutionKey: models.ExecutionKey{
+							Project: sampleNodeExecID.ExecutionId.Project,
+							Domain:  sampleNodeExecID.ExecutionId.Domain,
+							Name:    sampleNodeExecID.ExecutionId.Name,
+						},
+					},
+					RetryAttempt: &retryAttemptValue,
+				},
+				InputURI:               "input uri",
+				StartedAt:              &taskStartedAt,
+				TaskExecutionCreatedAt: &taskStartedAt,
+				TaskExecutionUpdatedAt: &taskStartedAt,
+				Phase:                  core.TaskExecution_RUNNING.String(),
+				PhaseVersion:           phaseVersion,
+				StateVersion:           reads,
+			}
+			reads++
+			return taskExecution, nil
+		})
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetUpdateCallback(
+		func(ctx context.Context, execution models.TaskExecution) error {
+			*updatedVersions = append(*updatedVersions, execution.StateVersion)
+			if execution.StateVersion == 0 {
+				return repoErrors.GetConcurrentUpdateError("task execution")
+			}
+			return nil
+		})
+}
+
+func getPhaseVersionTaskEventRequest(phaseVersion uint32) admin.TaskExecutionEventRequest {
+	occurredAt, _ := ptypes.TimestampProto(taskStartedAt.Add(time.Minute))
+	return admin.TaskExecutionEventRequest{
+		RequestId: "request id",
+		Event: &event.TaskExecutionEvent{
+			ProducerId:            "propeller",
+			TaskId:                sampleTaskID,
+			ParentNodeExecutionId: sampleNodeExecID,
+			RetryAttempt:          retryAttemptValue,
+			Phase:                 core.TaskExecution_RUNNING,
+			PhaseVersion:          phaseVersion,
+			OccurredAt:            occurredAt,
+		},
+	}
+}
+
+func TestCreateTaskEvent_ConcurrentUpdate(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	addGetNodeExecutionCallback(repository)
+	addGetTaskCallback(repository)
+	var updatedVersions []uint32
+	addInterleavedTaskExecutionCallbacks(repository, 1, &updatedVersions)
+
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
+	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), getPhaseVersionTaskEventRequest(2))
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	// The event is applied again to the task execution read after the interleaved event.
+	assert.Equal(t, []uint32{0, 1}, updatedVersions)
+}
+
+func TestCreateTaskEvent_ConcurrentDuplicateEvent(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	addGetNodeExecutionCallback(repository)
+	addGetTaskCallback(repository)
+	var updatedVersions []uint32
+	addInterleavedTaskExecutionCallbacks(repository, 2, &updatedVersions)
+
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
+	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), getPhaseVersionTaskEventRequest(2))
+	assert.Nil(t, resp)
+	// The interleaved event already recorded the phase version of this one.
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Equal(t, []uint32{0}, updatedVersions)
+}
+
 func TestGetTaskExecution(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	addGetWorkflowExecutionCallback(repository)
@@ -543,7 +646,7 @@ func TestGetTaskExecution(t *testing.T) {
 				},
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	taskExecution, err := taskExecManager.GetTaskExecution(context.Background(), admin.TaskExecutionGetRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -593,7 +696,7 @@ func TestGetTaskExecution_TransformerError(t *testing.T) {
 				Closure:   []byte("i'm an invalid task closure"),
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	taskExecution, err := taskExecManager.GetTaskExecution(context.Background(), admin.TaskExecutionGetRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -703,7 +806,7 @@ func TestListTaskExecutions(t *testing.T) {
 				},
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	taskExecutions, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		NodeExecutionId: &core.NodeExecutionIdentifier{
 			NodeId: "nodey b",
@@ -774,7 +877,7 @@ func TestListTaskExecutions_NoFilters(t *testing.T) {
 			listTaskCalled = true
 			return interfaces.TaskExecutionCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		Token: "1",
 		Limit: 99,
@@ -792,7 +895,7 @@ func TestListTaskExecutions_NoLimit(t *testing.T) {
 			getTaskCalled = true
 			return interfaces.TaskExecutionCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		Limit: 0,
 	})
@@ -823,7 +926,7 @@ func TestListTaskExecutions_NothingToReturn(t *testing.T) {
 			listTasksCalled = true
 			return interfaces.TaskCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		NodeExecutionId: &core.NodeExecutionIdentifier{
 			ExecutionId: &core.WorkflowExecutionIdentifier{
@@ -925,7 +1028,7 @@ func TestGetTaskExecutionData(t *testing.T) {
 		}
 		return fmt.Errorf("unexpected call to find value in storage [%v]", reference.String())
 	}
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	dataResponse, err := taskExecManager.GetTaskExecutionData(context.Background(), admin.TaskExecutionGetDataRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -948,3 +1051,43 @@ func TestGetTaskExecutionData(t *testing.T) {
 		FullOutputs: fullOutputs,
 	}, dataResponse))
 }
+
+func TestCreateTaskEvent_PublishesNotifications(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetNotificationRulesCallbacks(t, repository, failedNotificationRules)
+	var created []*models.NotificationDelivery
+	repository.NotificationDeliveryRepo().(*repositoryMocks.MockNotificationDeliveryRepo).CreateFunction = func(
+		ctx context.Context, input *models.NotificationDelivery) error {
+		created = append(created, input)
+		return nil
+	}
+	var emails []*admin.EmailMessage
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		emails = append(emails, msg.(*admin.EmailMessage))
+		return nil
+	})
+	taskExecManager := NewTaskExecutionManager(repository, getMockNotificationRuleConfig(), nil,
+		mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, &publisher).(*TaskExecutionManager)
+
+	failedRequest := admin.TaskExecutionEventRequest{
+		Event: &event.TaskExecutionEvent{
+			TaskId:                sampleTaskID,
+			ParentNodeExecutionId: sampleNodeExecID,
+			Phase:                 core.TaskExecution_FAILED,
+			RetryAttempt:          1,
+		},
+	}
+	taskExecManager.publishNotifications(context.Background(), failedRequest)
+	assert.Len(t, emails, 1)
+	assert.Equal(t, []string{"task@example.com"}, emails[0].RecipientsEmail)
+	assert.Equal(t, `Notice: Task "task-id" (attempt 1) of node "node-id" in execution "name" in "domain" is FAILED.`,
+		emails[0].SubjectLine)
+	assert.Len(t, created, 1)
+	assert.Equal(t, models.NotificationChannelSlack, created[0].Channel)
+
+	// The first attempt is below the rule's minimum retry attempt.
+	failedRequest.Event.RetryAttempt = 0
+	taskExecManager.publishNotifications(context.Background(), failedRequest)
+	assert.Len(t, emails, 1)
+}
@@ -0,0 +1,23 @@
+package util
+
+import (
+	"context"
+
+	repoErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// Bounds the attempts to apply an update which keeps conflicting with concurrent updates of the same entity.
+const maxConcurrentUpdateAttempts = 5
+
+// Runs the given read, validation and update of an entity, and runs it again from a fresh read when the update failed
+// because the entity was updated concurrently since it was read.
+func RetryOnConcurrentUpdate(ctx context.Context, update func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := update()
+		if !repoErrors.IsConcurrentUpdateError(err) || attempt == maxConcurrentUpdateAttempts {
+			return err
+		}
+		logger.Debugf(ctx, "Retrying update after a concurrent update (attempt %d): %v", attempt, err)
+	}
+}
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repoErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRetryOnConcurrentUpdate(t *testing.T) {
+	var attempts int
+	err := RetryOnConcurrentUpdate(context.Background(), func() error {
+		attempts++
+		if attempts < 3 {
+			return repoErrors.GetConcurrentUpdateError("execution")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryOnConcurrentUpdate_OtherError(t *testing.T) {
+	var attempts int
+	err := RetryOnConcurrentUpdate(context.Background(), func() error {
+		attempts++
+		return errors.NewFlyteAdminError(codes.AlreadyExists, "already recorded")
+	})
+	assert.Equal(t, codes.AlreadyExists, err.(errors.FlyteAdminError).Code())
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryOnConcurrentUpdate_OtherAbortedError(t *testing.T) {
+	var attempts int
+	err := RetryOnConcurrentUpdate(context.Background(), func() error {
+		attempts++
+		return errors.NewFlyteAdminError(codes.Aborted, "transaction aborted")
+	})
+	assert.False(t, repoErrors.IsConcurrentUpdateError(err))
+	assert.Equal(t, codes.Aborted, err.(errors.FlyteAdminError).Code())
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryOnConcurrentUpdate_AttemptsExhausted(t *testing.T) {
+	var attempts int
+	err := RetryOnConcurrentUpdate(context.Background(), func() error {
+		attempts++
+		return repoErrors.GetConcurrentUpdateError("execution")
+	})
+	assert.True(t, repoErrors.IsConcurrentUpdateError(err))
+	assert.Equal(t, maxConcurrentUpdateAttempts, attempts)
+}
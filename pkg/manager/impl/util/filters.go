@@ -42,6 +42,9 @@ var timestampFields = map[string]bool{
 	"UpdatedAt": true,
 	"DeletedAt": true,
 	"StartedAt": true,
+	// Audit log entries.
+	"received_at": true,
+	"sent_at":     true,
 }
 
 var durationFields = map[string]bool{
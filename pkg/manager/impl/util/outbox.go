@@ -0,0 +1,30 @@
+package util
+
+import (
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+)
+
+// Returns whether external events are written to the transactional outbox, rather than published directly.
+func IsOutboxEnabled(config runtimeInterfaces.Configuration) bool {
+	eventsConfig := config.ApplicationConfiguration().GetExternalEventsConfig()
+	return eventsConfig.Enable && eventsConfig.OutboxConfig.Enable
+}
+
+// Returns the outbox event to write alongside the execution state change described by the event request, or nil when
+// the outbox is disabled.
+func NewOutboxEvent(config runtimeInterfaces.Configuration, executionID *core.WorkflowExecutionIdentifier,
+	request proto.Message) (*models.OutboxEvent, error) {
+	if !IsOutboxEnabled(config) {
+		return nil, nil
+	}
+	event, err := transformers.CreateOutboxEventModel(notificationInterfaces.ExecutionPartitionKey(executionID), request)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
@@ -0,0 +1,42 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func getOutboxConfig(enable bool) runtimeInterfaces.Configuration {
+	applicationConfig := testutils.GetApplicationConfigWithDefaultDomains()
+	applicationConfig.(*runtimeMocks.MockApplicationProvider).SetExternalEventsConfig(
+		runtimeInterfaces.ExternalEventsConfig{
+			Enable: true,
+			OutboxConfig: runtimeInterfaces.OutboxConfig{
+				Enable: enable,
+			},
+		})
+	return runtimeMocks.NewMockConfigurationProvider(applicationConfig, nil, nil, nil, nil, nil)
+}
+
+func TestNewOutboxEvent(t *testing.T) {
+	executionID := &core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	request := &admin.WorkflowExecutionEventRequest{RequestId: "request id"}
+
+	event, err := NewOutboxEvent(getOutboxConfig(true), executionID, request)
+	assert.NoError(t, err)
+	assert.Equal(t, "project/domain/name", event.PartitionKey)
+	assert.Equal(t, "flyteidl.admin.WorkflowExecutionEventRequest", event.EventType)
+
+	event, err = NewOutboxEvent(getOutboxConfig(false), executionID, request)
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+}
@@ -13,6 +13,7 @@ import (
 	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytestdlib/logger"
@@ -234,3 +235,56 @@ func GetTaskExecutionModel(
 	}
 	return &taskExecutionModel, nil
 }
+
+// GetNotificationTemplates returns the notification templates registered for the most specific matching project, domain
+// and (optionally) workflow. A nil map is returned when no templates have been registered.
+func GetNotificationTemplates(ctx context.Context, repo repositories.RepositoryInterface, project, domain,
+	workflow string) (map[string]runtimeInterfaces.NotificationTemplate, error) {
+	resource, err := repo.ResourceRepo().Get(ctx, repoInterfaces.ResourceID{
+		Project:      project,
+		Domain:       domain,
+		Workflow:     workflow,
+		ResourceType: models.NotificationTemplateResourceType,
+	})
+	if err != nil {
+		if ec, ok := err.(errors.FlyteAdminError); ok && ec.Code() == codes.NotFound {
+			return nil, nil
+		}
+		logger.Debugf(ctx, "Failed to get notification templates for [%s/%s/%s] with err: %v",
+			project, domain, workflow, err)
+		return nil, err
+	}
+	if len(resource.Attributes) == 0 {
+		return nil, nil
+	}
+	return transformers.FromResourceModelToNotificationTemplates(resource)
+}
+
+// GetNotificationRules returns the notification rules registered for the most specific matching project, domain,
+// workflow and launch plan. Nil rules are returned when none have been registered.
+func GetNotificationRules(ctx context.Context, repo repositories.RepositoryInterface, project, domain, workflow,
+	launchPlan string) (*runtimeInterfaces.NotificationRules, error) {
+	resource, err := repo.ResourceRepo().Get(ctx, repoInterfaces.ResourceID{
+		Project:      project,
+		Domain:       domain,
+		Workflow:     workflow,
+		LaunchPlan:   launchPlan,
+		ResourceType: models.NotificationRuleResourceType,
+	})
+	if err != nil {
+		if ec, ok := err.(errors.FlyteAdminError); ok && ec.Code() == codes.NotFound {
+			return nil, nil
+		}
+		logger.Debugf(ctx, "Failed to get notification rules for [%s/%s/%s/%s] with err: %v",
+			project, domain, workflow, launchPlan, err)
+		return nil, err
+	}
+	if len(resource.Attributes) == 0 {
+		return nil, nil
+	}
+	rules, err := transformers.FromResourceModelToNotificationRules(resource)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
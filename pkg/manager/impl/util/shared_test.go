@@ -15,6 +15,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flytestdlib/storage"
 	"github.com/golang/protobuf/proto"
@@ -474,3 +475,82 @@ func TestListActiveLaunchPlanVersionsFilters(t *testing.T) {
 	assert.Equal(t, activeExpr.Args, int32(admin.LaunchPlanState_ACTIVE))
 	assert.Equal(t, activeExpr.Query, testutils.StateQueryPattern)
 }
+
+func TestGetNotificationTemplates(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, project, ID.Project)
+		assert.Equal(t, domain, ID.Domain)
+		assert.Equal(t, "workflow", ID.Workflow)
+		assert.Equal(t, models.NotificationTemplateResourceType, ID.ResourceType)
+		return models.Resource{
+			Project:      project,
+			Domain:       domain,
+			ResourceType: models.NotificationTemplateResourceType,
+			Attributes:   []byte(`{"failed":{"subject":"{{ .Name }} failed","body":"<b>oh no</b>","html":true}}`),
+		}, nil
+	}
+	templates, err := GetNotificationTemplates(context.Background(), repository, project, domain, "workflow")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]runtimeInterfaces.NotificationTemplate{
+		"failed": {
+			Subject: "{{ .Name }} failed",
+			Body:    "<b>oh no</b>",
+			HTML:    true,
+		},
+	}, templates)
+}
+
+func TestGetNotificationTemplates_NotFound(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+		return models.Resource{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+	}
+	templates, err := GetNotificationTemplates(context.Background(), repository, project, domain, "")
+	assert.NoError(t, err)
+	assert.Nil(t, templates)
+}
+
+func TestGetNotificationTemplates_Error(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+		return models.Resource{}, flyteAdminErrors.NewFlyteAdminError(codes.Internal, "uh oh")
+	}
+	_, err := GetNotificationTemplates(context.Background(), repository, project, domain, "")
+	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetNotificationRules(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, interfaces.ResourceID{
+			Project:      project,
+			Domain:       domain,
+			Workflow:     "workflow",
+			LaunchPlan:   "launch_plan",
+			ResourceType: models.NotificationRuleResourceType,
+		}, ID)
+		return models.Resource{
+			Attributes: []byte(`{"tasks":[{"phases":["RUNNING"],"minRetryAttempt":3,"recipients":["a@example.com"]}]}`),
+		}, nil
+	}
+	rules, err := GetNotificationRules(context.Background(), repository, project, domain, "workflow", "launch_plan")
+	assert.NoError(t, err)
+	assert.Equal(t, &runtimeInterfaces.NotificationRules{
+		Tasks: []runtimeInterfaces.NotificationRule{
+			{Phases: []string{"RUNNING"}, MinRetryAttempt: 3, Recipients: []string{"a@example.com"}},
+		},
+	}, rules)
+
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+		return models.Resource{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+	}
+	rules, err = GetNotificationRules(context.Background(), repository, project, domain, "workflow", "launch_plan")
+	assert.NoError(t, err)
+	assert.Nil(t, rules)
+}
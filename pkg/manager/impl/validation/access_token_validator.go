@@ -0,0 +1,37 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var accessTokenScopes = sets.NewString(auth.FineGrainedScopes...).Insert(auth.ScopeAll)
+
+// Validates a request to create an access token on behalf of a caller granted the given scopes. Tokens can't be
+// granted scopes their creator doesn't hold.
+func ValidateAccessTokenCreateRequest(request interfaces.AccessTokenCreateRequest, granted sets.String) error {
+	if err := ValidateEmptyStringField(request.Name, shared.Name); err != nil {
+		return err
+	}
+	for _, scope := range request.Scopes {
+		if !accessTokenScopes.Has(scope) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unrecognized scope [%s]", scope)
+		}
+		if !granted.Has(auth.ScopeAll) && !granted.Has(scope) {
+			return errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+				"scope [%s] can't be granted by a caller who doesn't hold it", scope)
+		}
+	}
+	return nil
+}
+
+func ValidateAccessTokenRevokeRequest(request interfaces.AccessTokenRevokeRequest) error {
+	if request.ID == 0 {
+		return shared.GetMissingArgumentError(shared.ID)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+)
+
+// The audit log columns which can be filtered on. Filter fields are interpolated into the query, hence the allowlist.
+var auditLogFilterFields = map[string]bool{
+	"subject":       true,
+	"client_id":     true,
+	"client_ip":     true,
+	"method":        true,
+	"project":       true,
+	"domain":        true,
+	"response_code": true,
+	"received_at":   true,
+	"sent_at":       true,
+}
+
+func ValidateAuditLogListRequest(request interfaces.AuditLogListRequest) error {
+	if len(request.Project) == 0 && len(request.Domain) > 0 {
+		return shared.GetMissingArgumentError(shared.Project)
+	}
+	return ValidateLimit(request.Limit)
+}
+
+func ValidateAuditLogFilters(filters []common.InlineFilter) error {
+	for _, filter := range filters {
+		if filter.GetEntity() != common.AuditLog || !auditLogFilterFields[filter.GetField()] {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"audit logs can't be filtered by [%s]", filter.GetField())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+func ValidateExecutionTimelineRequest(request interfaces.ExecutionTimelineRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	return ValidateEmptyStringField(request.Name, shared.Name)
+}
@@ -0,0 +1,26 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+func ValidateNotificationDeliveryListRequest(request interfaces.NotificationDeliveryListRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	return ValidateEmptyStringField(request.Name, shared.Name)
+}
+
+func ValidateNotificationDeliveryResendRequest(request interfaces.NotificationDeliveryResendRequest) error {
+	if request.ID == 0 {
+		return shared.GetMissingArgumentError(shared.ID)
+	}
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	return ValidateEmptyStringField(request.Domain, shared.Domain)
+}
@@ -0,0 +1,48 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"google.golang.org/grpc/codes"
+)
+
+const rules = "rules"
+const workflow = "workflow"
+
+func validateNotificationRuleScope(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, project, domain, workflowName, launchPlan string) error {
+	if err := ValidateProjectAndDomain(ctx, db, config, project, domain); err != nil {
+		return err
+	}
+	if len(launchPlan) > 0 && len(workflowName) == 0 {
+		return shared.GetMissingArgumentError(workflow)
+	}
+	return nil
+}
+
+func ValidateNotificationRuleAttributes(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, request interfaces.NotificationRuleAttributes) error {
+	if err := validateNotificationRuleScope(
+		ctx, db, config, request.Project, request.Domain, request.Workflow, request.LaunchPlan); err != nil {
+		return err
+	}
+	if len(request.Rules.Nodes) == 0 && len(request.Rules.Tasks) == 0 {
+		return shared.GetMissingArgumentError(rules)
+	}
+	if err := notifications.ValidateRules(request.Rules); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid notification rules: %v", err)
+	}
+	return nil
+}
+
+func ValidateNotificationRuleGetRequest(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, request interfaces.NotificationRuleGetRequest) error {
+	return validateNotificationRuleScope(
+		ctx, db, config, request.Project, request.Domain, request.Workflow, request.LaunchPlan)
+}
@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+)
+
+const recipient = "recipient"
+const phases = "phases"
+
+// Validates a subscription to be created or updated. The channel is expected to have been defaulted.
+func ValidateNotificationSubscription(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, request interfaces.NotificationSubscription) error {
+	if err := ValidateProjectAndDomain(ctx, db, config, request.Project, request.Domain); err != nil {
+		return err
+	}
+	switch request.Channel {
+	case models.NotificationChannelEmail, models.NotificationChannelSlack, models.NotificationChannelPagerDuty:
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unrecognized channel [%s]", request.Channel)
+	}
+	if err := ValidateEmptyStringField(request.Recipient, recipient); err != nil {
+		return err
+	}
+	if len(request.Phases) == 0 {
+		return shared.GetMissingArgumentError(phases)
+	}
+	for _, phase := range request.Phases {
+		value, ok := core.WorkflowExecution_Phase_value[phase]
+		if !ok {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unrecognized phase [%s]", phase)
+		}
+		// Notifications are only published once executions terminate.
+		if !common.IsExecutionTerminal(core.WorkflowExecution_Phase(value)) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"notifications can only be subscribed to for terminal phases, got [%s]", phase)
+		}
+	}
+	return nil
+}
+
+func ValidateNotificationSubscriptionListRequest(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, request interfaces.NotificationSubscriptionListRequest) error {
+	return ValidateProjectAndDomain(ctx, db, config, request.Project, request.Domain)
+}
+
+func ValidateNotificationSubscriptionDeleteRequest(request interfaces.NotificationSubscriptionDeleteRequest) error {
+	if request.ID == 0 {
+		return shared.GetMissingArgumentError(shared.ID)
+	}
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	return ValidateEmptyStringField(request.Domain, shared.Domain)
+}
@@ -0,0 +1,76 @@
+package validation
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+)
+
+const templates = "templates"
+
+// Returns whether the template key is either the default key or a lower-cased workflow execution phase.
+func isValidNotificationTemplateKey(key string) bool {
+	if key == notifications.DefaultTemplateKey {
+		return true
+	}
+	_, ok := core.WorkflowExecution_Phase_value[strings.ToUpper(key)]
+	return ok && key == strings.ToLower(key)
+}
+
+func ValidateNotificationTemplateAttributes(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, request interfaces.NotificationTemplateAttributes) error {
+	if err := ValidateProjectAndDomain(ctx, db, config, request.Project, request.Domain); err != nil {
+		return err
+	}
+	if len(request.Templates) == 0 {
+		return shared.GetMissingArgumentError(templates)
+	}
+	for key, template := range request.Templates {
+		if !isValidNotificationTemplateKey(key) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"unrecognized notification template key [%s], expected a lower-cased execution phase or [%s]",
+				key, notifications.DefaultTemplateKey)
+		}
+		if err := notifications.ValidateTemplate(template); err != nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid notification template for [%s]: %v", key, err)
+		}
+	}
+	return nil
+}
+
+func ValidateNotificationTemplateGetRequest(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, request interfaces.NotificationTemplateGetRequest) error {
+	return ValidateProjectAndDomain(ctx, db, config, request.Project, request.Domain)
+}
+
+func ValidateNotificationPreviewRequest(request interfaces.NotificationPreviewRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Name, shared.Name); err != nil {
+		return err
+	}
+	if len(request.Phase) > 0 {
+		if _, ok := core.WorkflowExecution_Phase_value[strings.ToUpper(request.Phase)]; !ok {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unrecognized phase [%s]", request.Phase)
+		}
+	}
+	if request.Template != nil {
+		if err := notifications.ValidateTemplate(*request.Template); err != nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid notification template: %v", err)
+		}
+	}
+	return nil
+}
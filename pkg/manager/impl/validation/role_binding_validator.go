@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"google.golang.org/grpc/codes"
+)
+
+const subject = "subject"
+
+// Validates the optional project and domain of a role binding. A domain can only be set along with a project.
+func validateRoleBindingScope(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, project, domain string) error {
+	if len(project) == 0 {
+		if len(domain) > 0 {
+			return shared.GetMissingArgumentError(shared.Project)
+		}
+		return nil
+	}
+	if len(domain) == 0 {
+		if _, err := db.ProjectRepo().Get(ctx, project); err != nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"failed to validate that project [%s] is registered, err: [%+v]", project, err)
+		}
+		return nil
+	}
+	return ValidateProjectAndDomain(ctx, db, config, project, domain)
+}
+
+func ValidateRoleBinding(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, request interfaces.RoleBinding) error {
+	switch request.SubjectType {
+	case models.RoleBindingSubjectUser, models.RoleBindingSubjectApp, models.RoleBindingSubjectGroup,
+		models.RoleBindingSubjectEmailDomain:
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unrecognized subject type [%s]", request.SubjectType)
+	}
+	if err := ValidateEmptyStringField(request.Subject, subject); err != nil {
+		return err
+	}
+	switch request.Role {
+	case models.RoleViewer, models.RoleContributor, models.RoleAdmin:
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unrecognized role [%s]", request.Role)
+	}
+	return validateRoleBindingScope(ctx, db, config, request.Project, request.Domain)
+}
+
+func ValidateRoleBindingListRequest(request interfaces.RoleBindingListRequest) error {
+	if len(request.Project) == 0 && len(request.Domain) > 0 {
+		return shared.GetMissingArgumentError(shared.Project)
+	}
+	return nil
+}
+
+func ValidateRoleBindingDeleteRequest(request interfaces.RoleBindingDeleteRequest) error {
+	if request.ID == 0 {
+		return shared.GetMissingArgumentError(shared.ID)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+)
+
+// Interface for managing the personal access tokens of users and the tokens of service accounts.
+type AccessTokenInterface interface {
+	CreateAccessToken(ctx context.Context, request AccessTokenCreateRequest) (*AccessTokenCreateResponse, error)
+	ListAccessTokens(ctx context.Context, request AccessTokenListRequest) (*AccessTokenList, error)
+	RevokeAccessToken(ctx context.Context, request AccessTokenRevokeRequest) (*AccessTokenRevokeResponse, error)
+	// Validates a token minted by CreateAccessToken and returns the identity it authenticates.
+	ValidateAccessToken(ctx context.Context, tokenStr string) (authInterfaces.IdentityContext, error)
+}
+
+type AccessTokenCreateRequest struct {
+	// A name describing what the token is used for, e.g. the CI pipeline it was created for.
+	Name string `json:"name"`
+	// Optional, defaults to the scopes of the caller. Each scope must be held by the caller.
+	Scopes []string `json:"scopes,omitempty"`
+	// Optional lifetime of the token as a duration, e.g. "720h". Defaults to the configured default lifetime.
+	ExpiresIn string `json:"expiresIn,omitempty"`
+	// Optional, when set the token authenticates as this service account rather than as the caller.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+}
+
+// An access token, without its secret value.
+type AccessToken struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	// The authenticated user or app who created the token.
+	Owner          string     `json:"owner"`
+	ServiceAccount string     `json:"serviceAccount,omitempty"`
+	Scopes         []string   `json:"scopes"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	RevokedAt      *time.Time `json:"revokedAt,omitempty"`
+}
+
+type AccessTokenCreateResponse struct {
+	AccessToken
+	// The secret value of the token. It is only returned on creation, flyteadmin stores a hash of it.
+	Token string `json:"token"`
+}
+
+// Lists the access tokens owned by the caller.
+type AccessTokenListRequest struct{}
+
+type AccessTokenList struct {
+	AccessTokens []AccessToken `json:"accessTokens"`
+}
+
+// Revokes an access token owned by the caller.
+type AccessTokenRevokeRequest struct {
+	ID uint `json:"id"`
+}
+
+type AccessTokenRevokeResponse struct{}
@@ -0,0 +1,45 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Interface for querying the audit log of requests issued to the admin service.
+type AuditLogInterface interface {
+	ListAuditLogs(ctx context.Context, request AuditLogListRequest) (*AuditLogList, error)
+}
+
+// Lists audit log entries, most recent first. The project and domain are optional, a domain can only be set along with
+// a project. Filters use the same grammar as other list requests, e.g. "eq(subject,alice)+gte(received_at,<RFC3339>)".
+type AuditLogListRequest struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Filters string `json:"filters"`
+	Limit   uint32 `json:"limit"`
+	Token   string `json:"token"`
+}
+
+type AuditLogEntry struct {
+	ID uint `json:"id"`
+	// The authenticated end-user, and the client which initiated the auth flow, that issued the request.
+	Subject       string     `json:"subject"`
+	ClientID      string     `json:"clientId"`
+	TokenIssuedAt *time.Time `json:"tokenIssuedAt,omitempty"`
+	ClientIP      string     `json:"clientIp"`
+	Method        string     `json:"method"`
+	// One of "ReadOnly" or "ReadWrite".
+	Mode         string            `json:"mode"`
+	Project      string            `json:"project,omitempty"`
+	Domain       string            `json:"domain,omitempty"`
+	Parameters   map[string]string `json:"parameters"`
+	ResponseCode string            `json:"responseCode"`
+	ReceivedAt   time.Time         `json:"receivedAt"`
+	SentAt       time.Time         `json:"sentAt"`
+}
+
+type AuditLogList struct {
+	AuditLogs []AuditLogEntry `json:"auditLogs"`
+	// Passed as the token of the next request to list the following page, empty on the last page.
+	Token string `json:"token"`
+}
@@ -0,0 +1,58 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Interface for inspecting the phase transitions recorded over the course of a workflow execution.
+type ExecutionTimelineInterface interface {
+	GetExecutionTimeline(ctx context.Context, request ExecutionTimelineRequest) (*ExecutionTimeline, error)
+}
+
+// Identifies the workflow execution to get the timeline of. When NodeID is set only the node and task executions of
+// that node are included.
+type ExecutionTimelineRequest struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Name    string `json:"name"`
+	NodeID  string `json:"nodeId,omitempty"`
+}
+
+// A phase a workflow, node or task execution transitioned to.
+type PhaseTransition struct {
+	Phase string `json:"phase"`
+	// Task executions report a new phase version when their state changes without changing phase.
+	PhaseVersion uint32 `json:"phaseVersion,omitempty"`
+	// When the transition occurred, as reported by the event.
+	OccurredAt time.Time `json:"occurredAt"`
+	// When the event was recorded by admin.
+	RecordedAt time.Time `json:"recordedAt"`
+}
+
+type TaskExecutionTimeline struct {
+	TaskProject  string            `json:"taskProject"`
+	TaskDomain   string            `json:"taskDomain"`
+	TaskName     string            `json:"taskName"`
+	TaskVersion  string            `json:"taskVersion"`
+	RetryAttempt uint32            `json:"retryAttempt"`
+	Transitions  []PhaseTransition `json:"transitions"`
+}
+
+type NodeExecutionTimeline struct {
+	NodeID      string            `json:"nodeId"`
+	Transitions []PhaseTransition `json:"transitions"`
+	// Ordered by the first transition of each task execution.
+	TaskExecutions []TaskExecutionTimeline `json:"taskExecutions"`
+}
+
+// The phase transitions of a workflow execution and its node and task executions, each in the order in which they
+// occurred.
+type ExecutionTimeline struct {
+	Project     string            `json:"project"`
+	Domain      string            `json:"domain"`
+	Name        string            `json:"name"`
+	Transitions []PhaseTransition `json:"transitions"`
+	// Ordered by the first transition of each node execution.
+	NodeExecutions []NodeExecutionTimeline `json:"nodeExecutions"`
+}
@@ -0,0 +1,50 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Interface for inspecting and re-sending workflow execution notification deliveries.
+type NotificationDeliveryInterface interface {
+	ListNotificationDeliveries(ctx context.Context, request NotificationDeliveryListRequest) (
+		*NotificationDeliveryList, error)
+	ResendNotificationDelivery(ctx context.Context, request NotificationDeliveryResendRequest) (
+		*NotificationDelivery, error)
+}
+
+// Identifies the workflow execution to list notification deliveries for.
+type NotificationDeliveryListRequest struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Name    string `json:"name"`
+}
+
+type NotificationDelivery struct {
+	ID      uint   `json:"id"`
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Name    string `json:"name"`
+	// One of "email", "pagerduty" or "slack".
+	Channel    string   `json:"channel"`
+	Recipients []string `json:"recipients"`
+	Subject    string   `json:"subject"`
+	// One of "QUEUED", "RETRYING", "SUCCEEDED" or "FAILED".
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+	// The error encountered on the most recent failed attempt.
+	Error         string     `json:"error,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	LastAttemptAt *time.Time `json:"lastAttemptAt,omitempty"`
+}
+
+type NotificationDeliveryList struct {
+	Deliveries []NotificationDelivery `json:"deliveries"`
+}
+
+// Re-sends a failed notification delivery of an execution in the given project and domain.
+type NotificationDeliveryResendRequest struct {
+	ID      uint   `json:"id"`
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+}
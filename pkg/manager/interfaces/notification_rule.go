@@ -0,0 +1,38 @@
+package interfaces
+
+import (
+	"context"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Interface for managing the node and task notification rules of a project and domain, workflow or launch plan.
+type NotificationRuleInterface interface {
+	UpdateNotificationRules(ctx context.Context, request NotificationRuleAttributes) (
+		*NotificationRuleUpdateResponse, error)
+	GetNotificationRules(ctx context.Context, request NotificationRuleGetRequest) (*NotificationRuleAttributes, error)
+	DeleteNotificationRules(ctx context.Context, request NotificationRuleGetRequest) (
+		*NotificationRuleDeleteResponse, error)
+}
+
+// TODO we can move these to flyteidl once notification rules are a flyteidl.admin.MatchableResource
+type NotificationRuleAttributes struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// Optional, when empty the rules apply to all workflows in the project and domain.
+	Workflow string `json:"workflow,omitempty"`
+	// Optional, when set the rules only apply to executions of this launch plan. Requires a workflow.
+	LaunchPlan string                              `json:"launchPlan,omitempty"`
+	Rules      runtimeInterfaces.NotificationRules `json:"rules"`
+}
+
+type NotificationRuleGetRequest struct {
+	Project    string `json:"project"`
+	Domain     string `json:"domain"`
+	Workflow   string `json:"workflow,omitempty"`
+	LaunchPlan string `json:"launchPlan,omitempty"`
+}
+
+type NotificationRuleUpdateResponse struct{}
+
+type NotificationRuleDeleteResponse struct{}
@@ -0,0 +1,59 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Interface for managing user subscriptions to workflow execution notifications.
+type NotificationSubscriptionInterface interface {
+	CreateNotificationSubscription(ctx context.Context, request NotificationSubscription) (
+		*NotificationSubscription, error)
+	UpdateNotificationSubscription(ctx context.Context, request NotificationSubscription) (
+		*NotificationSubscription, error)
+	ListNotificationSubscriptions(ctx context.Context, request NotificationSubscriptionListRequest) (
+		*NotificationSubscriptionList, error)
+	DeleteNotificationSubscription(ctx context.Context, request NotificationSubscriptionDeleteRequest) (
+		*NotificationSubscriptionDeleteResponse, error)
+}
+
+type NotificationSubscription struct {
+	// Assigned on creation and required for updates.
+	ID      uint   `json:"id,omitempty"`
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// Optional, when empty the subscription applies to all workflows in the project and domain.
+	Workflow string `json:"workflow,omitempty"`
+	// Optional, when empty the subscription applies to all launch plans in the project and domain.
+	LaunchPlan string `json:"launchPlan,omitempty"`
+	// One of "email", "pagerduty" or "slack". Defaults to "email".
+	Channel string `json:"channel,omitempty"`
+	// The email address or Slack handle to notify.
+	Recipient string `json:"recipient"`
+	// Terminal workflow execution phase names, e.g. "FAILED".
+	Phases []string `json:"phases"`
+	// The authenticated user who created the subscription. Set by the server.
+	CreatedBy string    `json:"createdBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+}
+
+// Lists the subscriptions of a project and domain, optionally restricted to those for a workflow and/or launch plan.
+type NotificationSubscriptionListRequest struct {
+	Project    string `json:"project"`
+	Domain     string `json:"domain"`
+	Workflow   string `json:"workflow,omitempty"`
+	LaunchPlan string `json:"launchPlan,omitempty"`
+}
+
+type NotificationSubscriptionList struct {
+	Subscriptions []NotificationSubscription `json:"subscriptions"`
+}
+
+// Deletes a subscription of the given project and domain.
+type NotificationSubscriptionDeleteRequest struct {
+	ID      uint   `json:"id"`
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+}
+
+type NotificationSubscriptionDeleteResponse struct{}
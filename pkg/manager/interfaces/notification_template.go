@@ -0,0 +1,57 @@
+package interfaces
+
+import (
+	"context"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Interface for managing project, domain and workflow -specific notification templates.
+type NotificationTemplateInterface interface {
+	UpdateNotificationTemplates(ctx context.Context, request NotificationTemplateAttributes) (
+		*NotificationTemplateUpdateResponse, error)
+	GetNotificationTemplates(ctx context.Context, request NotificationTemplateGetRequest) (
+		*NotificationTemplateAttributes, error)
+	DeleteNotificationTemplates(ctx context.Context, request NotificationTemplateGetRequest) (
+		*NotificationTemplateDeleteResponse, error)
+	PreviewNotification(ctx context.Context, request NotificationPreviewRequest) (*NotificationPreviewResponse, error)
+}
+
+// TODO we can move these to flyteidl once notification templates are a flyteidl.admin.MatchableResource
+type NotificationTemplateAttributes struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// Optional, when empty the templates apply to all workflows in the project and domain.
+	Workflow string `json:"workflow,omitempty"`
+	// Templates keyed by lower-cased workflow execution phase, or "default".
+	Templates map[string]runtimeInterfaces.NotificationTemplate `json:"templates"`
+}
+
+type NotificationTemplateGetRequest struct {
+	Project  string `json:"project"`
+	Domain   string `json:"domain"`
+	Workflow string `json:"workflow,omitempty"`
+}
+
+type NotificationTemplateUpdateResponse struct{}
+
+type NotificationTemplateDeleteResponse struct{}
+
+// Renders the notification which would be sent for an existing execution.
+type NotificationPreviewRequest struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Name    string `json:"name"`
+	// Optional, the lower-cased phase to render the notification for. Defaults to the current execution phase.
+	Phase string `json:"phase,omitempty"`
+	// Optional, an unsaved template to render in place of the registered and configured ones.
+	Template *runtimeInterfaces.NotificationTemplate `json:"template,omitempty"`
+}
+
+type NotificationPreviewResponse struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	HTML    bool   `json:"html"`
+	// Where the rendered template came from: one of "request", "registered", "config" or "legacy".
+	Source string `json:"source"`
+}
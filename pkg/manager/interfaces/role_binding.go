@@ -0,0 +1,51 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Interface for managing the roles bound to users, apps and groups in projects and domains.
+type RoleBindingInterface interface {
+	CreateRoleBinding(ctx context.Context, request RoleBinding) (*RoleBinding, error)
+	ListRoleBindings(ctx context.Context, request RoleBindingListRequest) (*RoleBindingList, error)
+	DeleteRoleBinding(ctx context.Context, request RoleBindingDeleteRequest) (*RoleBindingDeleteResponse, error)
+}
+
+type RoleBinding struct {
+	// Assigned on creation.
+	ID uint `json:"id,omitempty"`
+	// One of "user", "app", "group" or "emailDomain".
+	SubjectType string `json:"subjectType"`
+	// The user or app ID, the group name or the email domain.
+	Subject string `json:"subject"`
+	// One of "viewer", "contributor" or "admin".
+	Role string `json:"role"`
+	// Optional, when empty the binding applies to all projects.
+	Project string `json:"project,omitempty"`
+	// Optional, when empty the binding applies to all domains of the project.
+	Domain string `json:"domain,omitempty"`
+	// The authenticated user who created the binding. Set by the server.
+	CreatedBy string    `json:"createdBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+}
+
+// Lists the role bindings of a project and domain, of all domains of the project when the domain is empty and of all
+// projects when the project is empty as well.
+type RoleBindingListRequest struct {
+	Project string `json:"project,omitempty"`
+	Domain  string `json:"domain,omitempty"`
+}
+
+type RoleBindingList struct {
+	RoleBindings []RoleBinding `json:"roleBindings"`
+}
+
+// Deletes a role binding. The project and domain must be those of the binding, which authorization is checked against.
+type RoleBindingDeleteRequest struct {
+	ID      uint   `json:"id"`
+	Project string `json:"project,omitempty"`
+	Domain  string `json:"domain,omitempty"`
+}
+
+type RoleBindingDeleteResponse struct{}
@@ -0,0 +1,55 @@
+package mocks
+
+import (
+	"context"
+
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type CreateAccessTokenFunc func(ctx context.Context, request interfaces.AccessTokenCreateRequest) (
+	*interfaces.AccessTokenCreateResponse, error)
+type ListAccessTokensFunc func(ctx context.Context, request interfaces.AccessTokenListRequest) (
+	*interfaces.AccessTokenList, error)
+type RevokeAccessTokenFunc func(ctx context.Context, request interfaces.AccessTokenRevokeRequest) (
+	*interfaces.AccessTokenRevokeResponse, error)
+type ValidateAccessTokenFunc func(ctx context.Context, tokenStr string) (authInterfaces.IdentityContext, error)
+
+type MockAccessTokenManager struct {
+	CreateFunc   CreateAccessTokenFunc
+	ListFunc     ListAccessTokensFunc
+	RevokeFunc   RevokeAccessTokenFunc
+	ValidateFunc ValidateAccessTokenFunc
+}
+
+func (m *MockAccessTokenManager) CreateAccessToken(
+	ctx context.Context, request interfaces.AccessTokenCreateRequest) (*interfaces.AccessTokenCreateResponse, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockAccessTokenManager) ListAccessTokens(
+	ctx context.Context, request interfaces.AccessTokenListRequest) (*interfaces.AccessTokenList, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockAccessTokenManager) RevokeAccessToken(
+	ctx context.Context, request interfaces.AccessTokenRevokeRequest) (*interfaces.AccessTokenRevokeResponse, error) {
+	if m.RevokeFunc != nil {
+		return m.RevokeFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockAccessTokenManager) ValidateAccessToken(
+	ctx context.Context, tokenStr string) (authInterfaces.IdentityContext, error) {
+	if m.ValidateFunc != nil {
+		return m.ValidateFunc(ctx, tokenStr)
+	}
+	return nil, nil
+}
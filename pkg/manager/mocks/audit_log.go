@@ -0,0 +1,22 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type ListAuditLogsFunc func(ctx context.Context, request interfaces.AuditLogListRequest) (
+	*interfaces.AuditLogList, error)
+
+type MockAuditLogManager struct {
+	ListFunc ListAuditLogsFunc
+}
+
+func (m *MockAuditLogManager) ListAuditLogs(
+	ctx context.Context, request interfaces.AuditLogListRequest) (*interfaces.AuditLogList, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, request)
+	}
+	return &interfaces.AuditLogList{}, nil
+}
@@ -0,0 +1,22 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type GetExecutionTimelineFunc func(ctx context.Context, request interfaces.ExecutionTimelineRequest) (
+	*interfaces.ExecutionTimeline, error)
+
+type MockExecutionTimelineManager struct {
+	GetFunc GetExecutionTimelineFunc
+}
+
+func (m *MockExecutionTimelineManager) GetExecutionTimeline(
+	ctx context.Context, request interfaces.ExecutionTimelineRequest) (*interfaces.ExecutionTimeline, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, request)
+	}
+	return &interfaces.ExecutionTimeline{}, nil
+}
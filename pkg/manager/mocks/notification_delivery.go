@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type ListNotificationDeliveriesFunc func(ctx context.Context, request interfaces.NotificationDeliveryListRequest) (
+	*interfaces.NotificationDeliveryList, error)
+type ResendNotificationDeliveryFunc func(ctx context.Context, request interfaces.NotificationDeliveryResendRequest) (
+	*interfaces.NotificationDelivery, error)
+
+type MockNotificationDeliveryManager struct {
+	ListFunc   ListNotificationDeliveriesFunc
+	ResendFunc ResendNotificationDeliveryFunc
+}
+
+func (m *MockNotificationDeliveryManager) ListNotificationDeliveries(
+	ctx context.Context, request interfaces.NotificationDeliveryListRequest) (
+	*interfaces.NotificationDeliveryList, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, request)
+	}
+	return &interfaces.NotificationDeliveryList{}, nil
+}
+
+func (m *MockNotificationDeliveryManager) ResendNotificationDelivery(
+	ctx context.Context, request interfaces.NotificationDeliveryResendRequest) (
+	*interfaces.NotificationDelivery, error) {
+	if m.ResendFunc != nil {
+		return m.ResendFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type UpdateNotificationRulesFunc func(ctx context.Context, request interfaces.NotificationRuleAttributes) (
+	*interfaces.NotificationRuleUpdateResponse, error)
+type GetNotificationRulesFunc func(ctx context.Context, request interfaces.NotificationRuleGetRequest) (
+	*interfaces.NotificationRuleAttributes, error)
+type DeleteNotificationRulesFunc func(ctx context.Context, request interfaces.NotificationRuleGetRequest) (
+	*interfaces.NotificationRuleDeleteResponse, error)
+
+type MockNotificationRuleManager struct {
+	UpdateFunc UpdateNotificationRulesFunc
+	GetFunc    GetNotificationRulesFunc
+	DeleteFunc DeleteNotificationRulesFunc
+}
+
+func (m *MockNotificationRuleManager) UpdateNotificationRules(
+	ctx context.Context, request interfaces.NotificationRuleAttributes) (
+	*interfaces.NotificationRuleUpdateResponse, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, request)
+	}
+	return &interfaces.NotificationRuleUpdateResponse{}, nil
+}
+
+func (m *MockNotificationRuleManager) GetNotificationRules(
+	ctx context.Context, request interfaces.NotificationRuleGetRequest) (
+	*interfaces.NotificationRuleAttributes, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNotificationRuleManager) DeleteNotificationRules(
+	ctx context.Context, request interfaces.NotificationRuleGetRequest) (
+	*interfaces.NotificationRuleDeleteResponse, error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, request)
+	}
+	return &interfaces.NotificationRuleDeleteResponse{}, nil
+}
@@ -0,0 +1,57 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type CreateNotificationSubscriptionFunc func(ctx context.Context, request interfaces.NotificationSubscription) (
+	*interfaces.NotificationSubscription, error)
+type UpdateNotificationSubscriptionFunc func(ctx context.Context, request interfaces.NotificationSubscription) (
+	*interfaces.NotificationSubscription, error)
+type ListNotificationSubscriptionsFunc func(ctx context.Context,
+	request interfaces.NotificationSubscriptionListRequest) (*interfaces.NotificationSubscriptionList, error)
+type DeleteNotificationSubscriptionFunc func(ctx context.Context,
+	request interfaces.NotificationSubscriptionDeleteRequest) (*interfaces.NotificationSubscriptionDeleteResponse, error)
+
+type MockNotificationSubscriptionManager struct {
+	CreateFunc CreateNotificationSubscriptionFunc
+	UpdateFunc UpdateNotificationSubscriptionFunc
+	ListFunc   ListNotificationSubscriptionsFunc
+	DeleteFunc DeleteNotificationSubscriptionFunc
+}
+
+func (m *MockNotificationSubscriptionManager) CreateNotificationSubscription(
+	ctx context.Context, request interfaces.NotificationSubscription) (*interfaces.NotificationSubscription, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNotificationSubscriptionManager) UpdateNotificationSubscription(
+	ctx context.Context, request interfaces.NotificationSubscription) (*interfaces.NotificationSubscription, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNotificationSubscriptionManager) ListNotificationSubscriptions(
+	ctx context.Context, request interfaces.NotificationSubscriptionListRequest) (
+	*interfaces.NotificationSubscriptionList, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, request)
+	}
+	return &interfaces.NotificationSubscriptionList{}, nil
+}
+
+func (m *MockNotificationSubscriptionManager) DeleteNotificationSubscription(
+	ctx context.Context, request interfaces.NotificationSubscriptionDeleteRequest) (
+	*interfaces.NotificationSubscriptionDeleteResponse, error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, request)
+	}
+	return &interfaces.NotificationSubscriptionDeleteResponse{}, nil
+}
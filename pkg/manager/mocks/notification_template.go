@@ -0,0 +1,58 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type UpdateNotificationTemplatesFunc func(ctx context.Context, request interfaces.NotificationTemplateAttributes) (
+	*interfaces.NotificationTemplateUpdateResponse, error)
+type GetNotificationTemplatesFunc func(ctx context.Context, request interfaces.NotificationTemplateGetRequest) (
+	*interfaces.NotificationTemplateAttributes, error)
+type DeleteNotificationTemplatesFunc func(ctx context.Context, request interfaces.NotificationTemplateGetRequest) (
+	*interfaces.NotificationTemplateDeleteResponse, error)
+type PreviewNotificationFunc func(ctx context.Context, request interfaces.NotificationPreviewRequest) (
+	*interfaces.NotificationPreviewResponse, error)
+
+type MockNotificationTemplateManager struct {
+	UpdateFunc  UpdateNotificationTemplatesFunc
+	GetFunc     GetNotificationTemplatesFunc
+	DeleteFunc  DeleteNotificationTemplatesFunc
+	PreviewFunc PreviewNotificationFunc
+}
+
+func (m *MockNotificationTemplateManager) UpdateNotificationTemplates(
+	ctx context.Context, request interfaces.NotificationTemplateAttributes) (
+	*interfaces.NotificationTemplateUpdateResponse, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, request)
+	}
+	return &interfaces.NotificationTemplateUpdateResponse{}, nil
+}
+
+func (m *MockNotificationTemplateManager) GetNotificationTemplates(
+	ctx context.Context, request interfaces.NotificationTemplateGetRequest) (
+	*interfaces.NotificationTemplateAttributes, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNotificationTemplateManager) DeleteNotificationTemplates(
+	ctx context.Context, request interfaces.NotificationTemplateGetRequest) (
+	*interfaces.NotificationTemplateDeleteResponse, error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, request)
+	}
+	return &interfaces.NotificationTemplateDeleteResponse{}, nil
+}
+
+func (m *MockNotificationTemplateManager) PreviewNotification(
+	ctx context.Context, request interfaces.NotificationPreviewRequest) (*interfaces.NotificationPreviewResponse, error) {
+	if m.PreviewFunc != nil {
+		return m.PreviewFunc(ctx, request)
+	}
+	return nil, nil
+}
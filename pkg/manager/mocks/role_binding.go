@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type CreateRoleBindingFunc func(ctx context.Context, request interfaces.RoleBinding) (*interfaces.RoleBinding, error)
+type ListRoleBindingsFunc func(ctx context.Context, request interfaces.RoleBindingListRequest) (
+	*interfaces.RoleBindingList, error)
+type DeleteRoleBindingFunc func(ctx context.Context, request interfaces.RoleBindingDeleteRequest) (
+	*interfaces.RoleBindingDeleteResponse, error)
+
+type MockRoleBindingManager struct {
+	CreateFunc CreateRoleBindingFunc
+	ListFunc   ListRoleBindingsFunc
+	DeleteFunc DeleteRoleBindingFunc
+}
+
+func (m *MockRoleBindingManager) CreateRoleBinding(
+	ctx context.Context, request interfaces.RoleBinding) (*interfaces.RoleBinding, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockRoleBindingManager) ListRoleBindings(
+	ctx context.Context, request interfaces.RoleBindingListRequest) (*interfaces.RoleBindingList, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockRoleBindingManager) DeleteRoleBinding(
+	ctx context.Context, request interfaces.RoleBindingDeleteRequest) (*interfaces.RoleBindingDeleteResponse, error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -0,0 +1,220 @@
+// Package rbac authorizes admin service requests against the roles bound to the authenticated identity in the
+// project and domain of each request.
+package rbac
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	authConfig "github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const adminServicePrefix = "/flyteidl.service.AdminService/"
+
+type authorizerMetrics struct {
+	Scope                 promutils.Scope
+	Denied                prometheus.Counter
+	MissingScope          prometheus.Counter
+	RoleBindingLookupFail prometheus.Counter
+}
+
+// Authorizer decides whether the authenticated identity of a request may call an admin service method.
+type Authorizer struct {
+	config          authConfig.AuthorizationConfig
+	roleBindingRepo repoInterfaces.RoleBindingRepoInterface
+	metrics         authorizerMetrics
+}
+
+// Returns the values of a claim listing groups, which IdPs encode as an array or a single string.
+func getClaimValues(claim interface{}) []string {
+	switch values := claim.(type) {
+	case string:
+		return []string{values}
+	case []string:
+		return values
+	case []interface{}:
+		result := make([]string, 0, len(values))
+		for _, value := range values {
+			if s, ok := value.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// Returns the domain of the email address claimed by the identity, falling back to the address of its user info.
+func getEmailDomain(identityContext auth.IdentityContext, emailClaim string) string {
+	email := identityContext.UserInfo().GetEmail()
+	if len(emailClaim) > 0 {
+		if claimed, ok := identityContext.Claims()[emailClaim].(string); ok && len(claimed) > 0 {
+			email = claimed
+		}
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
+
+// Returns the subjects roles may be bound to for an identity: its user and app IDs, the groups it is a member of
+// and its email domain.
+func getSubjects(identityContext auth.IdentityContext, claims authConfig.ClaimsConfig) []repoInterfaces.RoleBindingSubject {
+	subjects := make([]repoInterfaces.RoleBindingSubject, 0, 2)
+	if len(identityContext.UserID()) > 0 {
+		subjects = append(subjects, repoInterfaces.RoleBindingSubject{
+			Type: models.RoleBindingSubjectUser,
+			Name: identityContext.UserID(),
+		})
+	}
+	if len(identityContext.AppID()) > 0 {
+		subjects = append(subjects, repoInterfaces.RoleBindingSubject{
+			Type: models.RoleBindingSubjectApp,
+			Name: identityContext.AppID(),
+		})
+	}
+	if len(claims.Groups) > 0 {
+		for _, group := range getClaimValues(identityContext.Claims()[claims.Groups]) {
+			subjects = append(subjects, repoInterfaces.RoleBindingSubject{
+				Type: models.RoleBindingSubjectGroup,
+				Name: group,
+			})
+		}
+	}
+	if emailDomain := getEmailDomain(identityContext, claims.Email); len(emailDomain) > 0 {
+		subjects = append(subjects, repoInterfaces.RoleBindingSubject{
+			Type: models.RoleBindingSubjectEmailDomain,
+			Name: emailDomain,
+		})
+	}
+	return subjects
+}
+
+// Returns whether a project or domain of a statically defined binding, which may be a pattern, matches that of a
+// request. Bindings without a project or domain apply to all of them.
+func scopeMatches(pattern, value string) bool {
+	if len(pattern) == 0 || pattern == value {
+		return true
+	}
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
+
+func (a *Authorizer) hasStaticRoleBinding(subjects []repoInterfaces.RoleBindingSubject, permission Permission,
+	project, domain string) bool {
+	for _, binding := range a.config.RoleBindings {
+		if !roleGrants(binding.Role, permission) || !scopeMatches(binding.Project, project) ||
+			!scopeMatches(binding.Domain, domain) {
+			continue
+		}
+		for _, subject := range subjects {
+			if binding.SubjectType == subject.Type && binding.Subject == subject.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (a *Authorizer) hasRoleBinding(ctx context.Context, subjects []repoInterfaces.RoleBindingSubject,
+	permission Permission, project, domain string) (bool, error) {
+	if a.hasStaticRoleBinding(subjects, permission, project, domain) {
+		return true, nil
+	}
+	bindings, err := a.roleBindingRepo.ListMatching(ctx, subjects, project, domain)
+	if err != nil {
+		return false, err
+	}
+	for _, binding := range bindings {
+		if roleGrants(binding.Role, permission) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Authorize returns an Unauthenticated error unless the identity authenticated in the context was granted the scope
+// the admin service method requires, and a PermissionDenied error unless it has a role granting the permission the
+// method requires in the project and domain of the request. Unauthenticated requests are left to the authentication
+// interceptors and roles aren't checked while authorization is disabled.
+func (a *Authorizer) Authorize(ctx context.Context, method string, request interface{}) error {
+	identityContext := auth.IdentityContextFromContext(ctx)
+	if identityContext.IsEmpty() {
+		return nil
+	}
+
+	if scope, allowed := scopesAllow(identityContext.Scopes(), method); !allowed {
+		a.metrics.MissingScope.Inc()
+		return status.Errorf(codes.Unauthenticated, "authenticated user doesn't have required scope [%s]", scope)
+	}
+	if !a.config.Enabled {
+		return nil
+	}
+
+	permission, ok := methodPermissions[method]
+	if !ok {
+		a.metrics.Denied.Inc()
+		return errors.NewFlyteAdminErrorf(codes.PermissionDenied, "method [%s] is not authorized", method)
+	}
+	if permission == PermissionNone {
+		return nil
+	}
+
+	project, domain := getRequestResource(request)
+	allowed, err := a.hasRoleBinding(ctx, getSubjects(identityContext, a.config.Claims), permission, project, domain)
+	if err != nil {
+		a.metrics.RoleBindingLookupFail.Inc()
+		logger.Errorf(ctx, "failed to look up role bindings to authorize [%s], error: %v", method, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to authorize request")
+	}
+	if !allowed {
+		a.metrics.Denied.Inc()
+		logger.Infof(ctx, "denied [%s] for user [%s] app [%s] in project [%s] domain [%s]", method,
+			identityContext.UserID(), identityContext.AppID(), project, domain)
+		return errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+			"not permitted to call [%s] in project [%s] domain [%s]", method, project, domain)
+	}
+	return nil
+}
+
+// UnaryServerInterceptor authorizes calls of the admin service methods. The methods of other services, like the
+// identity service, may be called by any authenticated identity.
+func (a *Authorizer) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (resp interface{}, err error) {
+	if strings.HasPrefix(info.FullMethod, adminServicePrefix) {
+		if err := a.Authorize(ctx, path.Base(info.FullMethod), req); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+func NewAuthorizer(config authConfig.AuthorizationConfig, roleBindingRepo repoInterfaces.RoleBindingRepoInterface,
+	scope promutils.Scope) *Authorizer {
+	return &Authorizer{
+		config:          config,
+		roleBindingRepo: roleBindingRepo,
+		metrics: authorizerMetrics{
+			Scope: scope,
+			Denied: scope.MustNewCounter("denied",
+				"requests denied for lack of a role granting the required permission"),
+			MissingScope: scope.MustNewCounter("missing_scope",
+				"requests denied because the token wasn't granted the required scope"),
+			RoleBindingLookupFail: scope.MustNewCounter("role_binding_lookup_failures",
+				"requests which failed to be authorized because role bindings couldn't be looked up"),
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	authConfig "github.com/flyteorg/flyteadmin/auth/config"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var terminateRequest = &admin.ExecutionTerminateRequest{Id: executionID}
+
+func identityContext(userID, appID string, scopes ...string) context.Context {
+	return auth.NewIdentityContext("", userID, appID, time.Now(), sets.NewString(scopes...), nil).WithContext(
+		context.Background())
+}
+
+func newAuthorizer(bindings []authConfig.RoleBinding, repo *mocks.MockRoleBindingRepo) *Authorizer {
+	return NewAuthorizer(authConfig.AuthorizationConfig{
+		Enabled:      true,
+		RoleBindings: bindings,
+	}, repo, promutils.NewTestScope())
+}
+
+func assertCode(t *testing.T, code codes.Code, err error) {
+	assert.Error(t, err)
+	assert.Equal(t, code, status.Code(err))
+}
+
+func TestAuthorize_StaticRoleBinding(t *testing.T) {
+	authorizer := newAuthorizer([]authConfig.RoleBinding{
+		{SubjectType: models.RoleBindingSubjectApp, Subject: "flytepropeller", Role: models.RoleContributor},
+		{SubjectType: models.RoleBindingSubjectUser, Subject: "alice", Role: models.RoleViewer, Project: "project"},
+	}, &mocks.MockRoleBindingRepo{})
+
+	ctx := identityContext("", "flytepropeller", auth.ScopeAll)
+	assert.NoError(t, authorizer.Authorize(ctx, "TerminateExecution", terminateRequest))
+	assertCode(t, codes.PermissionDenied, authorizer.Authorize(ctx, "RegisterProject", &admin.Project{Id: "p"}))
+
+	ctx = identityContext("alice", "", auth.ScopeAll)
+	assert.NoError(t, authorizer.Authorize(ctx, "GetExecution",
+		&admin.WorkflowExecutionGetRequest{Id: executionID}))
+	assertCode(t, codes.PermissionDenied, authorizer.Authorize(ctx, "TerminateExecution", terminateRequest))
+	assertCode(t, codes.PermissionDenied, authorizer.Authorize(ctx, "ListExecutions",
+		&admin.ResourceListRequest{Id: &admin.NamedEntityIdentifier{Project: "other", Domain: "domain"}}))
+}
+
+func TestAuthorize_GroupRoleBinding(t *testing.T) {
+	authorizer := NewAuthorizer(authConfig.AuthorizationConfig{
+		Enabled: true,
+		RoleBindings: []authConfig.RoleBinding{
+			{SubjectType: models.RoleBindingSubjectGroup, Subject: "ml-platform", Role: models.RoleContributor,
+				Project: "ml-*"},
+		},
+		Claims: authConfig.ClaimsConfig{Groups: "groups"},
+	}, &mocks.MockRoleBindingRepo{}, promutils.NewTestScope())
+
+	ctx := auth.NewIdentityContext("", "alice", "", time.Now(), sets.NewString(auth.ScopeAll), nil).WithClaims(
+		map[string]interface{}{"groups": []interface{}{"data", "ml-platform"}}).WithContext(context.Background())
+	assert.NoError(t, authorizer.Authorize(ctx, "TerminateExecution", &admin.ExecutionTerminateRequest{
+		Id: &core.WorkflowExecutionIdentifier{Project: "ml-training", Domain: "development", Name: "name"},
+	}))
+	assertCode(t, codes.PermissionDenied, authorizer.Authorize(ctx, "TerminateExecution", terminateRequest))
+}
+
+func TestGetSubjects(t *testing.T) {
+	identity := auth.NewIdentityContext("", "alice", "app", time.Now(), nil, &service.UserInfoResponse{
+		Email: "alice@Example.com",
+	}).WithClaims(map[string]interface{}{"roles": "admins"})
+	assert.Equal(t, []repoInterfaces.RoleBindingSubject{
+		{Type: models.RoleBindingSubjectUser, Name: "alice"},
+		{Type: models.RoleBindingSubjectApp, Name: "app"},
+		{Type: models.RoleBindingSubjectGroup, Name: "admins"},
+		{Type: models.RoleBindingSubjectEmailDomain, Name: "example.com"},
+	}, getSubjects(identity, authConfig.ClaimsConfig{Groups: "roles", Email: "email"}))
+
+	identity = identity.WithClaims(map[string]interface{}{"email": "alice@flyte.org"})
+	assert.Equal(t, []repoInterfaces.RoleBindingSubject{
+		{Type: models.RoleBindingSubjectUser, Name: "alice"},
+		{Type: models.RoleBindingSubjectApp, Name: "app"},
+		{Type: models.RoleBindingSubjectEmailDomain, Name: "flyte.org"},
+	}, getSubjects(identity, authConfig.ClaimsConfig{Groups: "roles", Email: "email"}))
+}
+
+func TestAuthorize_StoredRoleBinding(t *testing.T) {
+	authorizer := newAuthorizer(nil, &mocks.MockRoleBindingRepo{
+		ListMatchingFunction: func(ctx context.Context, subjects []repoInterfaces.RoleBindingSubject,
+			project, domain string) ([]models.RoleBinding, error) {
+			assert.Equal(t, []repoInterfaces.RoleBindingSubject{
+				{Type: models.RoleBindingSubjectUser, Name: "alice"},
+			}, subjects)
+			assert.Equal(t, "project", project)
+			assert.Equal(t, "domain", domain)
+			return []models.RoleBinding{
+				{SubjectType: models.RoleBindingSubjectUser, Subject: "alice", Role: models.RoleViewer},
+				{SubjectType: models.RoleBindingSubjectUser, Subject: "alice", Role: models.RoleContributor,
+					Project: "project", Domain: "domain"},
+			}, nil
+		},
+	})
+
+	ctx := identityContext("alice", "", auth.ScopeAll)
+	assert.NoError(t, authorizer.Authorize(ctx, "TerminateExecution", terminateRequest))
+	assertCode(t, codes.PermissionDenied, authorizer.Authorize(ctx, "UpdateProjectDomainAttributes",
+		&admin.ProjectDomainAttributesUpdateRequest{Attributes: &admin.ProjectDomainAttributes{
+			Project: "project", Domain: "domain",
+		}}))
+}
+
+func TestAuthorize_RoleBindingLookupFailure(t *testing.T) {
+	authorizer := newAuthorizer(nil, &mocks.MockRoleBindingRepo{
+		ListMatchingFunction: func(ctx context.Context, subjects []repoInterfaces.RoleBindingSubject,
+			project, domain string) ([]models.RoleBinding, error) {
+			return nil, errors.New("foo")
+		},
+	})
+	assertCode(t, codes.Internal, authorizer.Authorize(identityContext("alice", "", auth.ScopeAll),
+		"TerminateExecution", terminateRequest))
+}
+
+func TestAuthorize_Unrestricted(t *testing.T) {
+	authorizer := newAuthorizer(nil, &mocks.MockRoleBindingRepo{})
+	assert.NoError(t, authorizer.Authorize(context.Background(), "TerminateExecution", terminateRequest))
+	assert.NoError(t, authorizer.Authorize(identityContext("alice", "", auth.ScopeAll), "ListProjects",
+		&admin.ProjectListRequest{}))
+	assertCode(t, codes.PermissionDenied, authorizer.Authorize(identityContext("alice", "", auth.ScopeAll),
+		"UnknownMethod", terminateRequest))
+
+	disabled := NewAuthorizer(authConfig.AuthorizationConfig{}, &mocks.MockRoleBindingRepo{}, promutils.NewTestScope())
+	assert.NoError(t, disabled.Authorize(identityContext("alice", "", auth.ScopeAll), "TerminateExecution",
+		terminateRequest))
+}
+
+func TestAuthorize_Scopes(t *testing.T) {
+	authorizer := NewAuthorizer(authConfig.AuthorizationConfig{}, &mocks.MockRoleBindingRepo{}, promutils.NewTestScope())
+	ctx := identityContext("", "dashboard", auth.ScopeExecutionsRead)
+	assert.NoError(t, authorizer.Authorize(ctx, "GetExecution", &admin.WorkflowExecutionGetRequest{Id: executionID}))
+	assertCode(t, codes.Unauthenticated, authorizer.Authorize(ctx, "TerminateExecution", terminateRequest))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	authorizer := newAuthorizer(nil, &mocks.MockRoleBindingRepo{})
+	var handled bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled = true
+		return nil, nil
+	}
+
+	_, err := authorizer.UnaryServerInterceptor(identityContext("alice", ""), terminateRequest,
+		&grpc.UnaryServerInfo{FullMethod: "/flyteidl.service.AdminService/TerminateExecution"}, handler)
+	assertCode(t, codes.Unauthenticated, err)
+
+	_, err = authorizer.UnaryServerInterceptor(identityContext("alice", "", auth.ScopeAll), terminateRequest,
+		&grpc.UnaryServerInfo{FullMethod: "/flyteidl.service.AdminService/TerminateExecution"}, handler)
+	assertCode(t, codes.PermissionDenied, err)
+	assert.False(t, handled)
+
+	_, err = authorizer.UnaryServerInterceptor(identityContext("alice", "", auth.ScopeAll),
+		&admin.ProjectListRequest{}, &grpc.UnaryServerInfo{FullMethod: "/flyteidl.service.IdentityService/UserInfo"},
+		handler)
+	assert.NoError(t, err)
+	assert.True(t, handled)
+}
@@ -0,0 +1,107 @@
+package rbac
+
+import "github.com/flyteorg/flyteadmin/pkg/repositories/models"
+
+// Permission is the access a method requires in the project and domain of its request.
+type Permission int
+
+const (
+	// Granted to any authenticated identity.
+	PermissionNone Permission = iota
+	// Reading entities, executions and configuration.
+	PermissionRead
+	// Registering entities, launching and terminating executions and recording their events.
+	PermissionWrite
+	// Changing the configuration of projects and domains and managing their role bindings.
+	PermissionAdmin
+)
+
+// The most privileged permission each role grants, along with all the less privileged ones.
+var rolePermissions = map[string]Permission{
+	models.RoleViewer:      PermissionRead,
+	models.RoleContributor: PermissionWrite,
+	models.RoleAdmin:       PermissionAdmin,
+}
+
+func roleGrants(role string, permission Permission) bool {
+	granted, ok := rolePermissions[role]
+	return ok && granted >= permission
+}
+
+// The permissions required by the methods of the admin service, including those served as JSON over HTTP. Methods
+// which aren't listed are denied. Requests without a project, like registering a project or deleting a notification
+// subscription by ID, require the permission in all projects.
+var methodPermissions = map[string]Permission{
+	"GetVersion":   PermissionNone,
+	"ListProjects": PermissionNone,
+	// Identities manage their own access tokens.
+	"CreatePersonalAccessToken": PermissionNone,
+	"ListAccessTokens":          PermissionNone,
+	"RevokeAccessToken":         PermissionNone,
+
+	"GetTask":                       PermissionRead,
+	"ListTaskIds":                   PermissionRead,
+	"ListTasks":                     PermissionRead,
+	"GetWorkflow":                   PermissionRead,
+	"ListWorkflowIds":               PermissionRead,
+	"ListWorkflows":                 PermissionRead,
+	"GetLaunchPlan":                 PermissionRead,
+	"GetActiveLaunchPlan":           PermissionRead,
+	"ListActiveLaunchPlans":         PermissionRead,
+	"ListLaunchPlanIds":             PermissionRead,
+	"ListLaunchPlans":               PermissionRead,
+	"GetExecution":                  PermissionRead,
+	"GetExecutionData":              PermissionRead,
+	"ListExecutions":                PermissionRead,
+	"GetNodeExecution":              PermissionRead,
+	"ListNodeExecutions":            PermissionRead,
+	"ListNodeExecutionsForTask":     PermissionRead,
+	"GetNodeExecutionData":          PermissionRead,
+	"GetTaskExecution":              PermissionRead,
+	"ListTaskExecutions":            PermissionRead,
+	"GetTaskExecutionData":          PermissionRead,
+	"GetProjectDomainAttributes":    PermissionRead,
+	"GetWorkflowAttributes":         PermissionRead,
+	"ListMatchableAttributes":       PermissionRead,
+	"ListNamedEntities":             PermissionRead,
+	"GetNamedEntity":                PermissionRead,
+	"GetExecutionTimeline":          PermissionRead,
+	"GetNotificationTemplates":      PermissionRead,
+	"PreviewNotification":           PermissionRead,
+	"ListNotificationDeliveries":    PermissionRead,
+	"GetNotificationRules":          PermissionRead,
+	"ListNotificationSubscriptions": PermissionRead,
+
+	"CreateTask":                     PermissionWrite,
+	"CreateWorkflow":                 PermissionWrite,
+	"CreateLaunchPlan":               PermissionWrite,
+	"UpdateLaunchPlan":               PermissionWrite,
+	"CreateExecution":                PermissionWrite,
+	"RelaunchExecution":              PermissionWrite,
+	"RecoverExecution":               PermissionWrite,
+	"TerminateExecution":             PermissionWrite,
+	"CreateWorkflowEvent":            PermissionWrite,
+	"CreateNodeEvent":                PermissionWrite,
+	"CreateTaskEvent":                PermissionWrite,
+	"UpdateNamedEntity":              PermissionWrite,
+	"ResendNotificationDelivery":     PermissionWrite,
+	"CreateNotificationSubscription": PermissionWrite,
+	"UpdateNotificationSubscription": PermissionWrite,
+	"DeleteNotificationSubscription": PermissionWrite,
+
+	"RegisterProject":               PermissionAdmin,
+	"UpdateProject":                 PermissionAdmin,
+	"UpdateProjectDomainAttributes": PermissionAdmin,
+	"DeleteProjectDomainAttributes": PermissionAdmin,
+	"UpdateWorkflowAttributes":      PermissionAdmin,
+	"DeleteWorkflowAttributes":      PermissionAdmin,
+	"UpdateNotificationTemplates":   PermissionAdmin,
+	"DeleteNotificationTemplates":   PermissionAdmin,
+	"UpdateNotificationRules":       PermissionAdmin,
+	"DeleteNotificationRules":       PermissionAdmin,
+	"CreateRoleBinding":             PermissionAdmin,
+	"ListRoleBindings":              PermissionAdmin,
+	"DeleteRoleBinding":             PermissionAdmin,
+	"ListAuditLogs":                 PermissionAdmin,
+	"CreateServiceAccountToken":     PermissionAdmin,
+}
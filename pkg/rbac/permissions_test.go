@@ -0,0 +1,29 @@
+package rbac
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMethodPermissions_CoverAdminService(t *testing.T) {
+	serviceType := reflect.TypeOf((*service.AdminServiceServer)(nil)).Elem()
+	for i := 0; i < serviceType.NumMethod(); i++ {
+		method := serviceType.Method(i).Name
+		_, ok := methodPermissions[method]
+		assert.True(t, ok, "no permission defined for %s", method)
+	}
+}
+
+func TestRoleGrants(t *testing.T) {
+	assert.True(t, roleGrants(models.RoleViewer, PermissionRead))
+	assert.False(t, roleGrants(models.RoleViewer, PermissionWrite))
+	assert.True(t, roleGrants(models.RoleContributor, PermissionRead))
+	assert.True(t, roleGrants(models.RoleContributor, PermissionWrite))
+	assert.False(t, roleGrants(models.RoleContributor, PermissionAdmin))
+	assert.True(t, roleGrants(models.RoleAdmin, PermissionAdmin))
+	assert.False(t, roleGrants("owner", PermissionNone))
+}
@@ -0,0 +1,109 @@
+package rbac
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// Implemented by identifiers and requests which carry their project and domain.
+type projectDomainGetter interface {
+	GetProject() string
+	GetDomain() string
+}
+
+// Returns the project and domain a request applies to. Either is empty when the request spans all of them.
+func getRequestResource(request interface{}) (project, domain string) {
+	switch r := request.(type) {
+	case projectDomainGetter:
+		return r.GetProject(), r.GetDomain()
+
+	// Admin service requests which identify the entity, execution or attributes they apply to.
+	case *admin.ObjectGetRequest:
+		return getRequestResource(r.GetId())
+	case *admin.ResourceListRequest:
+		return getRequestResource(r.GetId())
+	case *admin.TaskCreateRequest:
+		return getRequestResource(r.GetId())
+	case *admin.WorkflowCreateRequest:
+		return getRequestResource(r.GetId())
+	case *admin.LaunchPlanCreateRequest:
+		return getRequestResource(r.GetId())
+	case *admin.LaunchPlanUpdateRequest:
+		return getRequestResource(r.GetId())
+	case *admin.ActiveLaunchPlanRequest:
+		return getRequestResource(r.GetId())
+	case *admin.ExecutionRelaunchRequest:
+		return getRequestResource(r.GetId())
+	case *admin.ExecutionRecoverRequest:
+		return getRequestResource(r.GetId())
+	case *admin.WorkflowExecutionGetRequest:
+		return getRequestResource(r.GetId())
+	case *admin.WorkflowExecutionGetDataRequest:
+		return getRequestResource(r.GetId())
+	case *admin.ExecutionTerminateRequest:
+		return getRequestResource(r.GetId())
+	case *admin.NodeExecutionGetRequest:
+		return getRequestResource(r.GetId().GetExecutionId())
+	case *admin.NodeExecutionListRequest:
+		return getRequestResource(r.GetWorkflowExecutionId())
+	case *admin.NodeExecutionForTaskListRequest:
+		return getRequestResource(r.GetTaskExecutionId().GetNodeExecutionId().GetExecutionId())
+	case *admin.NodeExecutionGetDataRequest:
+		return getRequestResource(r.GetId().GetExecutionId())
+	case *admin.TaskExecutionGetRequest:
+		return getRequestResource(r.GetId().GetNodeExecutionId().GetExecutionId())
+	case *admin.TaskExecutionListRequest:
+		return getRequestResource(r.GetNodeExecutionId().GetExecutionId())
+	case *admin.TaskExecutionGetDataRequest:
+		return getRequestResource(r.GetId().GetNodeExecutionId().GetExecutionId())
+	case *admin.WorkflowExecutionEventRequest:
+		return getRequestResource(r.GetEvent().GetExecutionId())
+	case *admin.NodeExecutionEventRequest:
+		return getRequestResource(r.GetEvent().GetId().GetExecutionId())
+	case *admin.TaskExecutionEventRequest:
+		return getRequestResource(r.GetEvent().GetParentNodeExecutionId().GetExecutionId())
+	case *admin.ProjectDomainAttributesUpdateRequest:
+		return getRequestResource(r.GetAttributes())
+	case *admin.WorkflowAttributesUpdateRequest:
+		return getRequestResource(r.GetAttributes())
+	case *admin.NamedEntityGetRequest:
+		return getRequestResource(r.GetId())
+	case *admin.NamedEntityUpdateRequest:
+		return getRequestResource(r.GetId())
+	case *admin.Project:
+		return r.GetId(), ""
+
+	// Requests of the endpoints served as JSON over HTTP.
+	case *interfaces.ExecutionTimelineRequest:
+		return r.Project, r.Domain
+	case *interfaces.NotificationTemplateGetRequest:
+		return r.Project, r.Domain
+	case *interfaces.NotificationTemplateAttributes:
+		return r.Project, r.Domain
+	case *interfaces.NotificationPreviewRequest:
+		return r.Project, r.Domain
+	case *interfaces.NotificationDeliveryListRequest:
+		return r.Project, r.Domain
+	case *interfaces.NotificationDeliveryResendRequest:
+		return r.Project, r.Domain
+	case *interfaces.NotificationRuleGetRequest:
+		return r.Project, r.Domain
+	case *interfaces.NotificationRuleAttributes:
+		return r.Project, r.Domain
+	case *interfaces.NotificationSubscription:
+		return r.Project, r.Domain
+	case *interfaces.NotificationSubscriptionListRequest:
+		return r.Project, r.Domain
+	case *interfaces.NotificationSubscriptionDeleteRequest:
+		return r.Project, r.Domain
+	case *interfaces.RoleBinding:
+		return r.Project, r.Domain
+	case *interfaces.RoleBindingListRequest:
+		return r.Project, r.Domain
+	case *interfaces.RoleBindingDeleteRequest:
+		return r.Project, r.Domain
+	case *interfaces.AuditLogListRequest:
+		return r.Project, r.Domain
+	}
+	return "", ""
+}
@@ -0,0 +1,52 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/stretchr/testify/assert"
+)
+
+var executionID = &core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func TestGetRequestResource(t *testing.T) {
+	for _, request := range []interface{}{
+		&admin.ObjectGetRequest{Id: &core.Identifier{Project: "project", Domain: "domain", Name: "wf"}},
+		&admin.ResourceListRequest{Id: &admin.NamedEntityIdentifier{Project: "project", Domain: "domain"}},
+		&admin.ExecutionCreateRequest{Project: "project", Domain: "domain"},
+		&admin.ExecutionTerminateRequest{Id: executionID},
+		&admin.NodeExecutionGetRequest{Id: &core.NodeExecutionIdentifier{ExecutionId: executionID}},
+		&admin.TaskExecutionListRequest{NodeExecutionId: &core.NodeExecutionIdentifier{ExecutionId: executionID}},
+		&admin.TaskExecutionEventRequest{Event: &event.TaskExecutionEvent{
+			ParentNodeExecutionId: &core.NodeExecutionIdentifier{ExecutionId: executionID},
+		}},
+		&admin.ProjectDomainAttributesUpdateRequest{Attributes: &admin.ProjectDomainAttributes{
+			Project: "project", Domain: "domain",
+		}},
+		&interfaces.NotificationSubscription{Project: "project", Domain: "domain"},
+		&interfaces.RoleBindingDeleteRequest{ID: 1, Project: "project", Domain: "domain"},
+		&interfaces.NotificationSubscriptionDeleteRequest{ID: 1, Project: "project", Domain: "domain"},
+		&interfaces.NotificationDeliveryResendRequest{ID: 1, Project: "project", Domain: "domain"},
+	} {
+		project, domain := getRequestResource(request)
+		assert.Equal(t, "project", project, "%T", request)
+		assert.Equal(t, "domain", domain, "%T", request)
+	}
+}
+
+func TestGetRequestResource_AllProjects(t *testing.T) {
+	project, domain := getRequestResource(&admin.Project{Id: "project"})
+	assert.Equal(t, "project", project)
+	assert.Empty(t, domain)
+
+	project, domain = getRequestResource(&admin.TaskExecutionEventRequest{})
+	assert.Empty(t, project)
+	assert.Empty(t, domain)
+}
@@ -0,0 +1,107 @@
+package rbac
+
+import (
+	"github.com/flyteorg/flyteadmin/auth"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// The scopes tokens need, besides `all`, to call the methods of the admin service, including those served as JSON
+// over HTTP. Methods mapped to an empty scope may be called with any token and those which aren't listed require
+// `all`.
+var methodScopes = map[string]string{
+	"GetVersion":   "",
+	"ListProjects": "",
+	// Tokens restricted to fine-grained scopes can't mint or revoke tokens.
+	"CreatePersonalAccessToken": auth.ScopeAll,
+	"CreateServiceAccountToken": auth.ScopeAll,
+	"ListAccessTokens":          auth.ScopeAll,
+	"RevokeAccessToken":         auth.ScopeAll,
+
+	"GetTask":               auth.ScopeRegistrationRead,
+	"ListTaskIds":           auth.ScopeRegistrationRead,
+	"ListTasks":             auth.ScopeRegistrationRead,
+	"GetWorkflow":           auth.ScopeRegistrationRead,
+	"ListWorkflowIds":       auth.ScopeRegistrationRead,
+	"ListWorkflows":         auth.ScopeRegistrationRead,
+	"GetLaunchPlan":         auth.ScopeRegistrationRead,
+	"GetActiveLaunchPlan":   auth.ScopeRegistrationRead,
+	"ListActiveLaunchPlans": auth.ScopeRegistrationRead,
+	"ListLaunchPlanIds":     auth.ScopeRegistrationRead,
+	"ListLaunchPlans":       auth.ScopeRegistrationRead,
+	"ListNamedEntities":     auth.ScopeRegistrationRead,
+	"GetNamedEntity":        auth.ScopeRegistrationRead,
+
+	"CreateTask":        auth.ScopeRegistrationWrite,
+	"CreateWorkflow":    auth.ScopeRegistrationWrite,
+	"CreateLaunchPlan":  auth.ScopeRegistrationWrite,
+	"UpdateLaunchPlan":  auth.ScopeRegistrationWrite,
+	"UpdateNamedEntity": auth.ScopeRegistrationWrite,
+
+	"GetExecution":                  auth.ScopeExecutionsRead,
+	"GetExecutionData":              auth.ScopeExecutionsRead,
+	"ListExecutions":                auth.ScopeExecutionsRead,
+	"GetNodeExecution":              auth.ScopeExecutionsRead,
+	"ListNodeExecutions":            auth.ScopeExecutionsRead,
+	"ListNodeExecutionsForTask":     auth.ScopeExecutionsRead,
+	"GetNodeExecutionData":          auth.ScopeExecutionsRead,
+	"GetTaskExecution":              auth.ScopeExecutionsRead,
+	"ListTaskExecutions":            auth.ScopeExecutionsRead,
+	"GetTaskExecutionData":          auth.ScopeExecutionsRead,
+	"GetExecutionTimeline":          auth.ScopeExecutionsRead,
+	"ListNotificationDeliveries":    auth.ScopeExecutionsRead,
+	"ListNotificationSubscriptions": auth.ScopeExecutionsRead,
+
+	"CreateExecution":                auth.ScopeExecutionsWrite,
+	"RelaunchExecution":              auth.ScopeExecutionsWrite,
+	"RecoverExecution":               auth.ScopeExecutionsWrite,
+	"TerminateExecution":             auth.ScopeExecutionsWrite,
+	"CreateWorkflowEvent":            auth.ScopeExecutionsWrite,
+	"CreateNodeEvent":                auth.ScopeExecutionsWrite,
+	"CreateTaskEvent":                auth.ScopeExecutionsWrite,
+	"ResendNotificationDelivery":     auth.ScopeExecutionsWrite,
+	"CreateNotificationSubscription": auth.ScopeExecutionsWrite,
+	"UpdateNotificationSubscription": auth.ScopeExecutionsWrite,
+	"DeleteNotificationSubscription": auth.ScopeExecutionsWrite,
+
+	"GetProjectDomainAttributes": auth.ScopeAttributesRead,
+	"GetWorkflowAttributes":      auth.ScopeAttributesRead,
+	"ListMatchableAttributes":    auth.ScopeAttributesRead,
+	"GetNotificationTemplates":   auth.ScopeAttributesRead,
+	"PreviewNotification":        auth.ScopeAttributesRead,
+	"GetNotificationRules":       auth.ScopeAttributesRead,
+
+	"RegisterProject":               auth.ScopeAttributesAdmin,
+	"UpdateProject":                 auth.ScopeAttributesAdmin,
+	"UpdateProjectDomainAttributes": auth.ScopeAttributesAdmin,
+	"DeleteProjectDomainAttributes": auth.ScopeAttributesAdmin,
+	"UpdateWorkflowAttributes":      auth.ScopeAttributesAdmin,
+	"DeleteWorkflowAttributes":      auth.ScopeAttributesAdmin,
+	"UpdateNotificationTemplates":   auth.ScopeAttributesAdmin,
+	"DeleteNotificationTemplates":   auth.ScopeAttributesAdmin,
+	"UpdateNotificationRules":       auth.ScopeAttributesAdmin,
+	"DeleteNotificationRules":       auth.ScopeAttributesAdmin,
+	"CreateRoleBinding":             auth.ScopeAttributesAdmin,
+	"ListRoleBindings":              auth.ScopeAttributesAdmin,
+	"DeleteRoleBinding":             auth.ScopeAttributesAdmin,
+	"ListAuditLogs":                 auth.ScopeAttributesAdmin,
+}
+
+// The write and admin scopes which imply each read scope.
+var impliedScopes = map[string]string{
+	auth.ScopeExecutionsRead:   auth.ScopeExecutionsWrite,
+	auth.ScopeRegistrationRead: auth.ScopeRegistrationWrite,
+	auth.ScopeAttributesRead:   auth.ScopeAttributesAdmin,
+}
+
+// Returns the scope required to call a method and whether the granted scopes include it.
+func scopesAllow(granted sets.String, method string) (string, bool) {
+	required, ok := methodScopes[method]
+	if !ok {
+		return auth.ScopeAll, granted.Has(auth.ScopeAll)
+	}
+	if len(required) == 0 || granted.Has(auth.ScopeAll) || granted.Has(required) {
+		return required, true
+	}
+	implying, ok := impliedScopes[required]
+	return required, ok && granted.Has(implying)
+}
@@ -0,0 +1,39 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestMethodScopes_CoverMethodPermissions(t *testing.T) {
+	for method := range methodPermissions {
+		_, ok := methodScopes[method]
+		assert.True(t, ok, "no scope defined for %s", method)
+	}
+	assert.Len(t, methodScopes, len(methodPermissions))
+}
+
+func TestScopesAllow(t *testing.T) {
+	for _, testCase := range []struct {
+		granted  sets.String
+		method   string
+		required string
+		allowed  bool
+	}{
+		{sets.NewString(auth.ScopeAll), "TerminateExecution", auth.ScopeExecutionsWrite, true},
+		{sets.NewString(auth.ScopeExecutionsWrite), "TerminateExecution", auth.ScopeExecutionsWrite, true},
+		{sets.NewString(auth.ScopeExecutionsWrite), "GetExecution", auth.ScopeExecutionsRead, true},
+		{sets.NewString(auth.ScopeExecutionsRead), "TerminateExecution", auth.ScopeExecutionsWrite, false},
+		{sets.NewString(auth.ScopeExecutionsWrite), "CreateTask", auth.ScopeRegistrationWrite, false},
+		{sets.NewString(auth.ScopeAttributesAdmin), "GetWorkflowAttributes", auth.ScopeAttributesRead, true},
+		{sets.NewString(), "ListProjects", "", true},
+		{sets.NewString(auth.ScopeExecutionsRead), "UnknownMethod", auth.ScopeAll, false},
+	} {
+		required, allowed := scopesAllow(testCase.granted, testCase.method)
+		assert.Equal(t, testCase.required, required, testCase.method)
+		assert.Equal(t, testCase.allowed, allowed, "%s with %v", testCase.method, testCase.granted.List())
+	}
+}
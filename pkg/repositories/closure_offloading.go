@@ -0,0 +1,569 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	dataInterfaces "github.com/flyteorg/flyteadmin/pkg/data/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repoErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+// Closures are offloaded under the metadata storage prefix, for instance metadata/admin/closures/executions/...
+const closuresStorageKey = "closures"
+
+type closureOffloaderMetrics struct {
+	OffloadedClosures       prometheus.Counter
+	HydratedClosures        prometheus.Counter
+	DeletedClosures         prometheus.Counter
+	ClosureDeletionFailures prometheus.Counter
+}
+
+// Offloads serialized closures which exceed a size threshold to blob storage, in which case their rows only store a
+// reference to the closure, and hydrates models with offloaded closures when they're read.
+type ClosureOffloader struct {
+	store          *storage.DataStore
+	deleter        dataInterfaces.BlobDeleterInterface
+	storagePrefix  []string
+	thresholdBytes int
+	metrics        closureOffloaderMetrics
+}
+
+// Writes the closure to blob storage when it exceeds the threshold, or when it was offloaded before so that the
+// offloaded closure never goes stale. Returns the closure and closure reference the row should store.
+//
+// Every write goes to a new blob, so the closure a row references is only replaced once the row referencing its
+// replacement is written. The blob the row referenced before is superseded then, see write.
+func (o *ClosureOffloader) offload(ctx context.Context, closure []byte, reference storage.DataReference,
+	nestedKeys ...string) ([]byte, storage.DataReference, error) {
+	if len(closure) == 0 || (reference == "" && (o.thresholdBytes <= 0 || len(closure) <= o.thresholdBytes)) {
+		return closure, reference, nil
+	}
+	keys := append(append(append([]string{}, o.storagePrefix...), closuresStorageKey), nestedKeys...)
+	reference, err := o.store.ConstructReference(ctx, o.store.GetBaseContainerFQN(ctx),
+		append(keys, uuid.New().String())...)
+	if err != nil {
+		return nil, "", errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to construct closure reference for %v: %v", nestedKeys, err)
+	}
+	err = o.store.WriteRaw(ctx, reference, int64(len(closure)), storage.Options{}, bytes.NewReader(closure))
+	if err != nil {
+		return nil, "", errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to offload closure to [%s]: %v", reference, err)
+	}
+	o.metrics.OffloadedClosures.Inc()
+	return nil, reference, nil
+}
+
+// Deletes the offloaded closure. Failures are only logged, leaving the blob behind, since the row it belonged to was
+// written either way.
+func (o *ClosureOffloader) delete(ctx context.Context, reference storage.DataReference) {
+	if err := o.deleter.Delete(ctx, reference); err != nil {
+		logger.Warnf(ctx, "Failed to delete offloaded closure [%s]: %v", reference, err)
+		o.metrics.ClosureDeletionFailures.Inc()
+		return
+	}
+	o.metrics.DeletedClosures.Inc()
+}
+
+// Writes the row of a model whose closure reference changed from the superseded one to the given one, and deletes the
+// offloaded closure which isn't referenced afterwards. That's the superseded closure when the row was written, and
+// the new closure when the write was rejected. Other failures leave the new closure behind, as the row may have been
+// written regardless.
+func (o *ClosureOffloader) write(ctx context.Context, superseded, reference storage.DataReference,
+	write func() error) error {
+	err := write()
+	if reference == superseded {
+		return err
+	}
+	switch {
+	case err == nil:
+		if superseded != "" {
+			o.delete(ctx, superseded)
+		}
+	case repoErrors.IsConcurrentUpdateError(err) || isAlreadyExistsError(err):
+		if reference != "" {
+			o.delete(ctx, reference)
+		}
+	}
+	return err
+}
+
+func isAlreadyExistsError(err error) bool {
+	adminErr, ok := err.(errors.FlyteAdminError)
+	return ok && adminErr.Code() == codes.AlreadyExists
+}
+
+// Returns the offloaded closure when there's a reference to one, and the closure stored in the row otherwise.
+func (o *ClosureOffloader) hydrate(
+	ctx context.Context, closure []byte, reference storage.DataReference) ([]byte, error) {
+	if reference == "" {
+		return closure, nil
+	}
+	reader, err := o.store.ReadRaw(ctx, reference)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to read offloaded closure from [%s]: %v", reference, err)
+	}
+	defer reader.Close()
+	closure, err = ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to read offloaded closure from [%s]: %v", reference, err)
+	}
+	o.metrics.HydratedClosures.Inc()
+	return closure, nil
+}
+
+func (o *ClosureOffloader) offloadExecution(ctx context.Context, execution *models.Execution) error {
+	closure, reference, err := o.offload(ctx, execution.Closure, execution.ClosureReference, "executions",
+		execution.Project, execution.Domain, execution.Name)
+	if err != nil {
+		return err
+	}
+	execution.Closure, execution.ClosureReference = closure, reference
+	return nil
+}
+
+func (o *ClosureOffloader) hydrateExecution(ctx context.Context, execution *models.Execution) error {
+	closure, err := o.hydrate(ctx, execution.Closure, execution.ClosureReference)
+	if err != nil {
+		return err
+	}
+	execution.Closure = closure
+	return nil
+}
+
+func (o *ClosureOffloader) offloadNodeExecution(ctx context.Context, nodeExecution *models.NodeExecution) error {
+	closure, reference, err := o.offload(ctx, nodeExecution.Closure, nodeExecution.ClosureReference,
+		"node_executions", nodeExecution.Project, nodeExecution.Domain, nodeExecution.Name, nodeExecution.NodeID)
+	if err != nil {
+		return err
+	}
+	nodeExecution.Closure, nodeExecution.ClosureReference = closure, reference
+	return nil
+}
+
+func (o *ClosureOffloader) hydrateNodeExecution(ctx context.Context, nodeExecution *models.NodeExecution) error {
+	closure, err := o.hydrate(ctx, nodeExecution.Closure, nodeExecution.ClosureReference)
+	if err != nil {
+		return err
+	}
+	nodeExecution.Closure = closure
+	return nil
+}
+
+func (o *ClosureOffloader) offloadTaskExecution(ctx context.Context, taskExecution *models.TaskExecution) error {
+	var retryAttempt string
+	if taskExecution.RetryAttempt != nil {
+		retryAttempt = strconv.FormatUint(uint64(*taskExecution.RetryAttempt), 10)
+	}
+	closure, reference, err := o.offload(ctx, taskExecution.Closure, taskExecution.ClosureReference,
+		"task_executions", taskExecution.NodeExecutionKey.ExecutionKey.Project,
+		taskExecution.NodeExecutionKey.ExecutionKey.Domain, taskExecution.NodeExecutionKey.ExecutionKey.Name,
+		taskExecution.NodeID, taskExecution.TaskKey.Project, taskExecution.TaskKey.Domain, taskExecution.TaskKey.Name,
+		taskExecution.Version, retryAttempt)
+	if err != nil {
+		return err
+	}
+	taskExecution.Closure, taskExecution.ClosureReference = closure, reference
+	return nil
+}
+
+func (o *ClosureOffloader) hydrateTaskExecution(ctx context.Context, taskExecution *models.TaskExecution) error {
+	closure, err := o.hydrate(ctx, taskExecution.Closure, taskExecution.ClosureReference)
+	if err != nil {
+		return err
+	}
+	taskExecution.Closure = closure
+	return nil
+}
+
+// Returns the rows following the given id which store closures larger than the threshold inline.
+func findClosuresToOffload(db *gorm.DB, afterID uint, thresholdBytes, batchSize int) *gorm.DB {
+	return db.Where("id > ? AND LENGTH(closure) > ?", afterID, thresholdBytes).Order("id").Limit(batchSize)
+}
+
+// Replaces the closure stored in the row of the model with the reference to its offloaded copy, unless the row was
+// updated since it was read. The state version is incremented too, so that updates of the model read before it was
+// offloaded don't write the closure back inline.
+func (o *ClosureOffloader) storeClosureReference(ctx context.Context, db *gorm.DB, model interface{},
+	stateVersion uint32, reference storage.DataReference) error {
+	return o.write(ctx, "", reference, func() error {
+		tx := db.Model(model).Where("state_version = ?", stateVersion).UpdateColumns(map[string]interface{}{
+			"closure":           nil,
+			"closure_reference": reference,
+			"state_version":     gorm.Expr("state_version + 1"),
+		})
+		if tx.Error != nil {
+			return tx.Error
+		}
+		if tx.RowsAffected == 0 {
+			return repoErrors.GetConcurrentUpdateError("closure")
+		}
+		return nil
+	})
+}
+
+// Offloads the closures stored in existing rows which exceed the threshold, reading the rows in batches of the given
+// size. Returns the number of offloaded closures.
+func (o *ClosureOffloader) OffloadExistingClosures(ctx context.Context, db *gorm.DB, batchSize int) (int, error) {
+	if o.thresholdBytes <= 0 {
+		return 0, fmt.Errorf("closure offloading is disabled, set closureOffloadingThresholdBytes to enable it")
+	}
+	var offloaded int
+	for afterID := uint(0); ; {
+		var executions []models.Execution
+		if err := findClosuresToOffload(db, afterID, o.thresholdBytes, batchSize).Find(&executions).Error; err != nil {
+			return offloaded, err
+		}
+		if len(executions) == 0 {
+			break
+		}
+		for idx := range executions {
+			afterID = executions[idx].ID
+			if err := o.offloadExecution(ctx, &executions[idx]); err != nil {
+				return offloaded, err
+			}
+			err := o.storeClosureReference(ctx, db, &executions[idx], executions[idx].StateVersion,
+				executions[idx].ClosureReference)
+			if repoErrors.IsConcurrentUpdateError(err) {
+				// The row was updated since it was read, which offloaded its closure if it still exceeds the threshold.
+				continue
+			} else if err != nil {
+				return offloaded, err
+			}
+			offloaded++
+		}
+	}
+	for afterID := uint(0); ; {
+		var nodeExecutions []models.NodeExecution
+		if err := findClosuresToOffload(db, afterID, o.thresholdBytes, batchSize).Find(&nodeExecutions).Error; err != nil {
+			return offloaded, err
+		}
+		if len(nodeExecutions) == 0 {
+			break
+		}
+		for idx := range nodeExecutions {
+			afterID = nodeExecutions[idx].ID
+			if err := o.offloadNodeExecution(ctx, &nodeExecutions[idx]); err != nil {
+				return offloaded, err
+			}
+			err := o.storeClosureReference(ctx, db, &nodeExecutions[idx], nodeExecutions[idx].StateVersion,
+				nodeExecutions[idx].ClosureReference)
+			if repoErrors.IsConcurrentUpdateError(err) {
+				// The row was updated since it was read, which offloaded its closure if it still exceeds the threshold.
+				continue
+			} else if err != nil {
+				return offloaded, err
+			}
+			offloaded++
+		}
+	}
+	for afterID := uint(0); ; {
+		var taskExecutions []models.TaskExecution
+		if err := findClosuresToOffload(db, afterID, o.thresholdBytes, batchSize).Find(&taskExecutions).Error; err != nil {
+			return offloaded, err
+		}
+		if len(taskExecutions) == 0 {
+			break
+		}
+		for idx := range taskExecutions {
+			afterID = taskExecutions[idx].ID
+			if err := o.offloadTaskExecution(ctx, &taskExecutions[idx]); err != nil {
+				return offloaded, err
+			}
+			err := o.storeClosureReference(ctx, db, &taskExecutions[idx], taskExecutions[idx].StateVersion,
+				taskExecutions[idx].ClosureReference)
+			if repoErrors.IsConcurrentUpdateError(err) {
+				// The row was updated since it was read, which offloaded its closure if it still exceeds the threshold.
+				continue
+			} else if err != nil {
+				return offloaded, err
+			}
+			offloaded++
+		}
+	}
+	logger.Infof(ctx, "Offloaded %d closures", offloaded)
+	return offloaded, nil
+}
+
+func NewClosureOffloader(store *storage.DataStore, deleter dataInterfaces.BlobDeleterInterface,
+	storagePrefix []string, thresholdBytes int, scope promutils.Scope) *ClosureOffloader {
+	return &ClosureOffloader{
+		store:          store,
+		deleter:        deleter,
+		storagePrefix:  storagePrefix,
+		thresholdBytes: thresholdBytes,
+		metrics: closureOffloaderMetrics{
+			OffloadedClosures: scope.MustNewCounter("offloaded_closures",
+				"count of closures written to blob storage instead of the database"),
+			HydratedClosures: scope.MustNewCounter("hydrated_closures",
+				"count of offloaded closures read from blob storage"),
+			DeletedClosures: scope.MustNewCounter("deleted_closures",
+				"count of offloaded closures deleted from blob storage once they were no longer referenced"),
+			ClosureDeletionFailures: scope.MustNewCounter("closure_deletion_failures",
+				"count of unreferenced offloaded closures which failed to be deleted from blob storage"),
+		},
+	}
+}
+
+// Offloads the closures of written executions and hydrates those of read executions.
+type closureOffloadingExecutionRepo struct {
+	interfaces.ExecutionRepoInterface
+	offloader *ClosureOffloader
+}
+
+func (r *closureOffloadingExecutionRepo) write(ctx context.Context, execution models.Execution,
+	write func(execution models.Execution) error) error {
+	superseded := execution.ClosureReference
+	if err := r.offloader.offloadExecution(ctx, &execution); err != nil {
+		return err
+	}
+	return r.offloader.write(ctx, superseded, execution.ClosureReference, func() error {
+		return write(execution)
+	})
+}
+
+func (r *closureOffloadingExecutionRepo) Create(ctx context.Context, input models.Execution) error {
+	return r.write(ctx, input, func(execution models.Execution) error {
+		return r.ExecutionRepoInterface.Create(ctx, execution)
+	})
+}
+
+func (r *closureOffloadingExecutionRepo) Update(ctx context.Context, execution models.Execution) error {
+	return r.write(ctx, execution, func(execution models.Execution) error {
+		return r.ExecutionRepoInterface.Update(ctx, execution)
+	})
+}
+
+func (r *closureOffloadingExecutionRepo) UpdateWithOutboxEvent(
+	ctx context.Context, execution models.Execution, event models.OutboxEvent) error {
+	return r.write(ctx, execution, func(execution models.Execution) error {
+		return r.ExecutionRepoInterface.UpdateWithOutboxEvent(ctx, execution, event)
+	})
+}
+
+func (r *closureOffloadingExecutionRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+	execution, err := r.ExecutionRepoInterface.Get(ctx, input)
+	if err != nil {
+		return models.Execution{}, err
+	}
+	if err := r.offloader.hydrateExecution(ctx, &execution); err != nil {
+		return models.Execution{}, err
+	}
+	return execution, nil
+}
+
+func (r *closureOffloadingExecutionRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+	output, err := r.ExecutionRepoInterface.List(ctx, input)
+	if err != nil {
+		return interfaces.ExecutionCollectionOutput{}, err
+	}
+	for idx := range output.Executions {
+		if err := r.offloader.hydrateExecution(ctx, &output.Executions[idx]); err != nil {
+			return interfaces.ExecutionCollectionOutput{}, err
+		}
+	}
+	return output, nil
+}
+
+// Offloads the closures of written node executions and hydrates those of read node executions. Node executions are
+// written by pointer, so callers keep observing the closure they wrote rather than the offloaded one, and the closure
+// reference they read when the write fails.
+type closureOffloadingNodeExecutionRepo struct {
+	interfaces.NodeExecutionRepoInterface
+	offloader *ClosureOffloader
+}
+
+func (r *closureOffloadingNodeExecutionRepo) write(ctx context.Context, execution *models.NodeExecution,
+	write func(execution *models.NodeExecution) error) (err error) {
+	closure, superseded := execution.Closure, execution.ClosureReference
+	defer func() {
+		execution.Closure = closure
+		if err != nil {
+			execution.ClosureReference = superseded
+		}
+	}()
+	if err := r.offloader.offloadNodeExecution(ctx, execution); err != nil {
+		return err
+	}
+	return r.offloader.write(ctx, superseded, execution.ClosureReference, func() error {
+		return write(execution)
+	})
+}
+
+func (r *closureOffloadingNodeExecutionRepo) Create(ctx context.Context, execution *models.NodeExecution) error {
+	return r.write(ctx, execution, func(execution *models.NodeExecution) error {
+		return r.NodeExecutionRepoInterface.Create(ctx, execution)
+	})
+}
+
+func (r *closureOffloadingNodeExecutionRepo) Update(ctx context.Context, execution *models.NodeExecution) error {
+	return r.write(ctx, execution, func(execution *models.NodeExecution) error {
+		return r.NodeExecutionRepoInterface.Update(ctx, execution)
+	})
+}
+
+func (r *closureOffloadingNodeExecutionRepo) CreateWithOutboxEvent(
+	ctx context.Context, execution *models.NodeExecution, event models.OutboxEvent) error {
+	return r.write(ctx, execution, func(execution *models.NodeExecution) error {
+		return r.NodeExecutionRepoInterface.CreateWithOutboxEvent(ctx, execution, event)
+	})
+}
+
+func (r *closureOffloadingNodeExecutionRepo) UpdateWithOutboxEvent(
+	ctx context.Context, execution *models.NodeExecution, event models.OutboxEvent) error {
+	return r.write(ctx, execution, func(execution *models.NodeExecution) error {
+		return r.NodeExecutionRepoInterface.UpdateWithOutboxEvent(ctx, execution, event)
+	})
+}
+
+func (r *closureOffloadingNodeExecutionRepo) Get(
+	ctx context.Context, input interfaces.NodeExecutionResource) (models.NodeExecution, error) {
+	nodeExecution, err := r.NodeExecutionRepoInterface.Get(ctx, input)
+	if err != nil {
+		return models.NodeExecution{}, err
+	}
+	if err := r.offloader.hydrateNodeExecution(ctx, &nodeExecution); err != nil {
+		return models.NodeExecution{}, err
+	}
+	return nodeExecution, nil
+}
+
+func (r *closureOffloadingNodeExecutionRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.NodeExecutionCollectionOutput, error) {
+	output, err := r.NodeExecutionRepoInterface.List(ctx, input)
+	if err != nil {
+		return interfaces.NodeExecutionCollectionOutput{}, err
+	}
+	for idx := range output.NodeExecutions {
+		if err := r.offloader.hydrateNodeExecution(ctx, &output.NodeExecutions[idx]); err != nil {
+			return interfaces.NodeExecutionCollectionOutput{}, err
+		}
+	}
+	return output, nil
+}
+
+// Offloads the closures of written task executions and hydrates those of read task executions.
+type closureOffloadingTaskExecutionRepo struct {
+	interfaces.TaskExecutionRepoInterface
+	offloader *ClosureOffloader
+}
+
+func (r *closureOffloadingTaskExecutionRepo) write(ctx context.Context, execution models.TaskExecution,
+	write func(execution models.TaskExecution) error) error {
+	superseded := execution.ClosureReference
+	if err := r.offloader.offloadTaskExecution(ctx, &execution); err != nil {
+		return err
+	}
+	return r.offloader.write(ctx, superseded, execution.ClosureReference, func() error {
+		return write(execution)
+	})
+}
+
+func (r *closureOffloadingTaskExecutionRepo) Create(ctx context.Context, input models.TaskExecution) error {
+	return r.write(ctx, input, func(execution models.TaskExecution) error {
+		return r.TaskExecutionRepoInterface.Create(ctx, execution)
+	})
+}
+
+func (r *closureOffloadingTaskExecutionRepo) Update(ctx context.Context, execution models.TaskExecution) error {
+	return r.write(ctx, execution, func(execution models.TaskExecution) error {
+		return r.TaskExecutionRepoInterface.Update(ctx, execution)
+	})
+}
+
+func (r *closureOffloadingTaskExecutionRepo) CreateWithOutboxEvent(
+	ctx context.Context, input models.TaskExecution, event models.OutboxEvent) error {
+	return r.write(ctx, input, func(execution models.TaskExecution) error {
+		return r.TaskExecutionRepoInterface.CreateWithOutboxEvent(ctx, execution, event)
+	})
+}
+
+func (r *closureOffloadingTaskExecutionRepo) UpdateWithOutboxEvent(
+	ctx context.Context, execution models.TaskExecution, event models.OutboxEvent) error {
+	return r.write(ctx, execution, func(execution models.TaskExecution) error {
+		return r.TaskExecutionRepoInterface.UpdateWithOutboxEvent(ctx, execution, event)
+	})
+}
+
+func (r *closureOffloadingTaskExecutionRepo) Get(
+	ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+	taskExecution, err := r.TaskExecutionRepoInterface.Get(ctx, input)
+	if err != nil {
+		return models.TaskExecution{}, err
+	}
+	if err := r.offloader.hydrateTaskExecution(ctx, &taskExecution); err != nil {
+		return models.TaskExecution{}, err
+	}
+	return taskExecution, nil
+}
+
+func (r *closureOffloadingTaskExecutionRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
+	output, err := r.TaskExecutionRepoInterface.List(ctx, input)
+	if err != nil {
+		return interfaces.TaskExecutionCollectionOutput{}, err
+	}
+	for idx := range output.TaskExecutions {
+		if err := r.offloader.hydrateTaskExecution(ctx, &output.TaskExecutions[idx]); err != nil {
+			return interfaces.TaskExecutionCollectionOutput{}, err
+		}
+	}
+	return output, nil
+}
+
+// A repository which offloads large closures of executions, node executions and task executions to blob storage.
+type closureOffloadingRepo struct {
+	RepositoryInterface
+	executionRepo     interfaces.ExecutionRepoInterface
+	nodeExecutionRepo interfaces.NodeExecutionRepoInterface
+	taskExecutionRepo interfaces.TaskExecutionRepoInterface
+}
+
+func (r *closureOffloadingRepo) ExecutionRepo() interfaces.ExecutionRepoInterface {
+	return r.executionRepo
+}
+
+func (r *closureOffloadingRepo) NodeExecutionRepo() interfaces.NodeExecutionRepoInterface {
+	return r.nodeExecutionRepo
+}
+
+func (r *closureOffloadingRepo) TaskExecutionRepo() interfaces.TaskExecutionRepoInterface {
+	return r.taskExecutionRepo
+}
+
+// Returns a repository which offloads closures exceeding the offloader's threshold to blob storage. Models read from
+// the repository are hydrated with their offloaded closures, so callers, and the transformers they use, never observe
+// whether a closure was offloaded.
+func NewClosureOffloadingRepo(repo RepositoryInterface, offloader *ClosureOffloader) RepositoryInterface {
+	return &closureOffloadingRepo{
+		RepositoryInterface: repo,
+		executionRepo: &closureOffloadingExecutionRepo{
+			ExecutionRepoInterface: repo.ExecutionRepo(),
+			offloader:              offloader,
+		},
+		nodeExecutionRepo: &closureOffloadingNodeExecutionRepo{
+			NodeExecutionRepoInterface: repo.NodeExecutionRepo(),
+			offloader:                  offloader,
+		},
+		taskExecutionRepo: &closureOffloadingTaskExecutionRepo{
+			TaskExecutionRepoInterface: repo.TaskExecutionRepo(),
+			offloader:                  offloader,
+		},
+	}
+}
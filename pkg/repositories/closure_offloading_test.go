@@ -0,0 +1,260 @@
+package repositories
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	dataMocks "github.com/flyteorg/flyteadmin/pkg/data/mocks"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/promutils/labeled"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+var smallClosure = []byte("small")
+var largeClosure = []byte("a closure exceeding the threshold")
+
+const closureOffloadingThresholdBytes = 16
+
+func init() {
+	labeled.SetMetricKeys(contextutils.AppNameKey)
+}
+
+// Returns an offloader, the store it offloads closures to and the references of the closures it deleted.
+func getClosureOffloaderForTest(t *testing.T, thresholdBytes int) (
+	*ClosureOffloader, *storage.DataStore, *[]storage.DataReference) {
+	store, err := storage.NewDataStore(&storage.Config{
+		Type: storage.TypeMemory,
+	}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	var deleted []storage.DataReference
+	deleter := dataMocks.MockBlobDeleter{
+		DeleteCallback: func(ctx context.Context, reference storage.DataReference) error {
+			deleted = append(deleted, reference)
+			return nil
+		},
+	}
+	return NewClosureOffloader(store, &deleter, []string{"metadata", "admin"}, thresholdBytes,
+		promutils.NewTestScope()), store, &deleted
+}
+
+func getSQLiteDbForTest(t *testing.T) (*gorm.DB, config.DbConfig) {
+	dbConfig := config.DbConfig{
+		Type:   config.SQLiteDbType,
+		DbName: filepath.Join(t.TempDir(), "admin.db"),
+	}
+	db := config.OpenDbConnection(config.NewSQLiteConfigProvider(dbConfig, promutils.NewTestScope()))
+	assert.NoError(t, gormigrate.New(db, gormigrate.DefaultOptions, config.Migrations).Migrate())
+	return db, dbConfig
+}
+
+func readOffloadedClosure(t *testing.T, store *storage.DataStore, reference string) []byte {
+	reader, err := store.ReadRaw(context.Background(), storage.DataReference(reference))
+	assert.NoError(t, err)
+	defer reader.Close()
+	closure, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	return closure
+}
+
+func getStoredClosure(t *testing.T, db *gorm.DB, table string) ([]byte, string) {
+	var closure []byte
+	var reference *string
+	assert.NoError(t, db.Table(table).Select("closure, closure_reference").Row().Scan(&closure, &reference))
+	if reference == nil {
+		return closure, ""
+	}
+	return closure, *reference
+}
+
+var nodeExecutionKey = models.NodeExecutionKey{
+	ExecutionKey: models.ExecutionKey{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	},
+	NodeID: "node",
+}
+
+func TestClosureOffloadingRepo(t *testing.T) {
+	ctx := context.Background()
+	db, dbConfig := getSQLiteDbForTest(t)
+	defer db.Close()
+	offloader, store, deleted := getClosureOffloaderForTest(t, closureOffloadingThresholdBytes)
+	repository := NewClosureOffloadingRepo(
+		GetRepository(GetRepoConfig(dbConfig), dbConfig, promutils.NewTestScope()), offloader)
+
+	// Closures below the threshold are stored in the database.
+	assert.NoError(t, repository.ExecutionRepo().Create(ctx, models.Execution{
+		ExecutionKey: nodeExecutionKey.ExecutionKey,
+		Spec:         []byte("spec"),
+		Closure:      smallClosure,
+	}))
+	closure, reference := getStoredClosure(t, db, "executions")
+	assert.Equal(t, smallClosure, closure)
+	assert.Empty(t, reference)
+
+	// Closures above the threshold are stored in blob storage.
+	nodeExecution := models.NodeExecution{
+		NodeExecutionKey: nodeExecutionKey,
+		Phase:            core.NodeExecution_RUNNING.String(),
+		Closure:          largeClosure,
+	}
+	assert.NoError(t, repository.NodeExecutionRepo().Create(ctx, &nodeExecution))
+	assert.Equal(t, largeClosure, nodeExecution.Closure)
+	offloadedReference := string(nodeExecution.ClosureReference)
+	assert.True(t, strings.HasPrefix(offloadedReference,
+		"/metadata/admin/closures/node_executions/project/domain/name/node/"), offloadedReference)
+	closure, reference = getStoredClosure(t, db, "node_executions")
+	assert.Empty(t, closure)
+	assert.Equal(t, offloadedReference, reference)
+	assert.Equal(t, largeClosure, readOffloadedClosure(t, store, offloadedReference))
+
+	nodeExecution, err := repository.NodeExecutionRepo().Get(ctx, interfaces.NodeExecutionResource{
+		NodeExecutionIdentifier: core.NodeExecutionIdentifier{
+			NodeId: "node",
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, largeClosure, nodeExecution.Closure)
+
+	// Once offloaded, closures stay offloaded so the offloaded copy never goes stale. Each version is offloaded to a
+	// new blob, and the superseded one is deleted once the row references the new one.
+	staleNodeExecution := nodeExecution
+	nodeExecution.Closure = smallClosure
+	assert.NoError(t, repository.NodeExecutionRepo().Update(ctx, &nodeExecution))
+	closure, reference = getStoredClosure(t, db, "node_executions")
+	assert.Empty(t, closure)
+	assert.NotEqual(t, offloadedReference, reference)
+	assert.Equal(t, storage.DataReference(reference), nodeExecution.ClosureReference)
+	assert.Equal(t, smallClosure, readOffloadedClosure(t, store, reference))
+	assert.Equal(t, []storage.DataReference{storage.DataReference(offloadedReference)}, *deleted)
+
+	// The closures of rejected writes are deleted, leaving the row and the blob it references as they were.
+	staleNodeExecution.Closure = largeClosure
+	err = repository.NodeExecutionRepo().Update(ctx, &staleNodeExecution)
+	assert.Equal(t, codes.Aborted, err.(adminErrors.FlyteAdminError).Code())
+	assert.Equal(t, storage.DataReference(offloadedReference), staleNodeExecution.ClosureReference)
+	assert.Len(t, *deleted, 2)
+	assert.NotEqual(t, storage.DataReference(reference), (*deleted)[1])
+	_, storedReference := getStoredClosure(t, db, "node_executions")
+	assert.Equal(t, reference, storedReference)
+	phaseFilter, err := common.NewSingleValueFilter(
+		common.NodeExecution, common.Equal, "phase", core.NodeExecution_RUNNING.String())
+	assert.NoError(t, err)
+	output, err := repository.NodeExecutionRepo().List(ctx, interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{phaseFilter},
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output.NodeExecutions, 1)
+	assert.Equal(t, smallClosure, output.NodeExecutions[0].Closure)
+}
+
+func TestOffloadExistingClosures(t *testing.T) {
+	ctx := context.Background()
+	db, dbConfig := getSQLiteDbForTest(t)
+	defer db.Close()
+	repository := GetRepository(GetRepoConfig(dbConfig), dbConfig, promutils.NewTestScope())
+	assert.NoError(t, repository.ExecutionRepo().Create(ctx, models.Execution{
+		ExecutionKey: nodeExecutionKey.ExecutionKey,
+		Spec:         []byte("spec"),
+		Closure:      smallClosure,
+	}))
+	retryAttempt := uint32(1)
+	assert.NoError(t, repository.TaskExecutionRepo().Create(ctx, models.TaskExecution{
+		TaskExecutionKey: models.TaskExecutionKey{
+			TaskKey: models.TaskKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "task",
+				Version: "version",
+			},
+			NodeExecutionKey: nodeExecutionKey,
+			RetryAttempt:     &retryAttempt,
+		},
+		Closure: largeClosure,
+	}))
+
+	staleExecution, err := repository.ExecutionRepo().Get(ctx, interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	})
+	assert.NoError(t, err)
+
+	offloader, _, _ := getClosureOffloaderForTest(t, 0)
+	_, err = offloader.OffloadExistingClosures(ctx, db, 1)
+	assert.EqualError(t, err, "closure offloading is disabled, set closureOffloadingThresholdBytes to enable it")
+
+	offloader, store, deleted := getClosureOffloaderForTest(t, closureOffloadingThresholdBytes)
+	offloaded, err := offloader.OffloadExistingClosures(ctx, db, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, offloaded)
+
+	closure, reference := getStoredClosure(t, db, "executions")
+	assert.Equal(t, smallClosure, closure)
+	assert.Empty(t, reference)
+	closure, reference = getStoredClosure(t, db, "task_executions")
+	assert.Empty(t, closure)
+	assert.True(t, strings.HasPrefix(reference,
+		"/metadata/admin/closures/task_executions/project/domain/name/node/project/domain/task/version/1/"), reference)
+	assert.Equal(t, largeClosure, readOffloadedClosure(t, store, reference))
+	assert.Empty(t, *deleted)
+
+	taskExecution, err := NewClosureOffloadingRepo(repository, offloader).TaskExecutionRepo().Get(ctx,
+		interfaces.GetTaskExecutionInput{
+			TaskExecutionID: core.TaskExecutionIdentifier{
+				TaskId: &core.Identifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "task",
+					Version: "version",
+				},
+				NodeExecutionId: &core.NodeExecutionIdentifier{
+					NodeId: "node",
+					ExecutionId: &core.WorkflowExecutionIdentifier{
+						Project: "project",
+						Domain:  "domain",
+						Name:    "name",
+					},
+				},
+				RetryAttempt: 1,
+			},
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, largeClosure, taskExecution.Closure)
+
+	// Updates of executions read before their closure was offloaded are rejected.
+	staleTaskExecution := taskExecution
+	staleTaskExecution.StateVersion = 0
+	staleTaskExecution.Phase = "RUNNING"
+	err = repository.TaskExecutionRepo().Update(ctx, staleTaskExecution)
+	assert.Equal(t, codes.Aborted, err.(adminErrors.FlyteAdminError).Code())
+	// Executions which weren't offloaded are still updated.
+	staleExecution.Phase = "RUNNING"
+	assert.NoError(t, repository.ExecutionRepo().Update(ctx, staleExecution))
+
+	// Offloaded closures aren't offloaded again.
+	offloaded, err = offloader.OffloadExistingClosures(ctx, db, 1)
+	assert.NoError(t, err)
+	assert.Zero(t, offloaded)
+}
@@ -0,0 +1,103 @@
+package config
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Limits of the pool of connections to a database. Limits set to 0 are disabled.
+type ConnectionPoolConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+}
+
+func getConnectionPoolConfig(config DbConfig) ConnectionPoolConfig {
+	return ConnectionPoolConfig{
+		MaxOpenConns:    config.MaxOpenConns,
+		MaxIdleConns:    config.MaxIdleConns,
+		ConnMaxLifetime: config.ConnMaxLifetime,
+	}
+}
+
+func configureConnectionPool(db *sql.DB, config ConnectionPoolConfig) {
+	if config.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(config.MaxOpenConns)
+	}
+	if config.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(config.MaxIdleConns)
+	}
+	if config.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(config.ConnMaxLifetime)
+	}
+}
+
+// Exports the statistics of a connection pool as gauges, which are read from the pool whenever metrics are collected.
+type dbStatsCollector struct {
+	db     *sql.DB
+	gauges []dbStatsGauge
+}
+
+type dbStatsGauge struct {
+	desc  *prometheus.Desc
+	value func(stats sql.DBStats) float64
+}
+
+func (c *dbStatsCollector) Describe(descs chan<- *prometheus.Desc) {
+	for _, gauge := range c.gauges {
+		descs <- gauge.desc
+	}
+}
+
+func (c *dbStatsCollector) Collect(metrics chan<- prometheus.Metric) {
+	stats := c.db.Stats()
+	for _, gauge := range c.gauges {
+		metrics <- prometheus.MustNewConstMetric(gauge.desc, prometheus.GaugeValue, gauge.value(stats))
+	}
+}
+
+func newDbStatsCollector(db *sql.DB, scope promutils.Scope) *dbStatsCollector {
+	newGauge := func(name, description string, value func(stats sql.DBStats) float64) dbStatsGauge {
+		return dbStatsGauge{
+			desc:  prometheus.NewDesc(scope.NewScopedMetricName(name), description, nil, nil),
+			value: value,
+		}
+	}
+	return &dbStatsCollector{
+		db: db,
+		gauges: []dbStatsGauge{
+			newGauge("max_open_connections", "maximum number of open connections to the database",
+				func(stats sql.DBStats) float64 { return float64(stats.MaxOpenConnections) }),
+			newGauge("open_connections", "number of established connections to the database",
+				func(stats sql.DBStats) float64 { return float64(stats.OpenConnections) }),
+			newGauge("in_use_connections", "number of connections currently in use",
+				func(stats sql.DBStats) float64 { return float64(stats.InUse) }),
+			newGauge("idle_connections", "number of idle connections",
+				func(stats sql.DBStats) float64 { return float64(stats.Idle) }),
+			newGauge("wait_count", "total number of connections waited for",
+				func(stats sql.DBStats) float64 { return float64(stats.WaitCount) }),
+			newGauge("wait_duration_seconds", "total time blocked waiting for a new connection",
+				func(stats sql.DBStats) float64 { return stats.WaitDuration.Seconds() }),
+			newGauge("max_idle_closed", "total number of connections closed due to the maximum of idle connections",
+				func(stats sql.DBStats) float64 { return float64(stats.MaxIdleClosed) }),
+			newGauge("max_idle_time_closed", "total number of connections closed due to the maximum idle time",
+				func(stats sql.DBStats) float64 { return float64(stats.MaxIdleTimeClosed) }),
+			newGauge("max_lifetime_closed", "total number of connections closed due to the maximum lifetime",
+				func(stats sql.DBStats) float64 { return float64(stats.MaxLifetimeClosed) }),
+		},
+	}
+}
+
+// Registers the connection pool statistics of the database in the scope. A database opened again in the same scope
+// keeps reporting the statistics of the first connection pool.
+func registerDbStats(db *sql.DB, scope promutils.Scope) {
+	if err := prometheus.Register(newDbStatsCollector(db, scope)); err != nil {
+		logger.Warningf(context.Background(), "Failed to register the connection pool metrics of %s: %v",
+			scope.CurrentScope(), err)
+	}
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenDbConnection_ConnectionPool(t *testing.T) {
+	scope := mockScope.NewTestScope()
+	db := OpenDbConnection(NewSQLiteConfigProvider(DbConfig{
+		DbName:          filepath.Join(t.TempDir(), "admin.db"),
+		MaxOpenConns:    5,
+		MaxIdleConns:    2,
+		ConnMaxLifetime: time.Minute,
+	}, scope))
+	defer db.Close()
+	assert.NoError(t, db.DB().Ping())
+	assert.Equal(t, 5, db.DB().Stats().MaxOpenConnections)
+
+	collector := newDbStatsCollector(db.DB(), scope)
+	assert.Equal(t, len(collector.gauges), testutil.CollectAndCount(collector))
+	name := scope.NewScopedMetricName("max_open_connections")
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(fmt.Sprintf(`
+		# HELP %s maximum number of open connections to the database
+		# TYPE %s gauge
+		%s 5
+	`, name, name, name)), name))
+}
+
+func TestOpenDbConnection_DefaultConnectionPool(t *testing.T) {
+	db := OpenDbConnection(NewSQLiteConfigProvider(DbConfig{
+		DbName: filepath.Join(t.TempDir(), "admin.db"),
+	}, mockScope.NewTestScope()))
+	defer db.Close()
+	// The pool is unlimited when no limits are configured.
+	assert.Equal(t, 0, db.DB().Stats().MaxOpenConnections)
+}
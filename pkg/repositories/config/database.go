@@ -1,28 +1,72 @@
 package config
 
-import "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+import (
+	"fmt"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+)
+
+// Supported values for the database type.
+const (
+	PostgresDbType = "postgres"
+	SQLiteDbType   = "sqlite"
+	MySQLDbType    = "mysql"
+)
 
 // Database config. Contains values necessary to open a database connection.
 type DbConfig struct {
 	BaseConfig
+	Type         string `json:"type"`
 	Host         string `json:"host"`
 	Port         int    `json:"port"`
 	DbName       string `json:"dbname"`
 	User         string `json:"user"`
 	Password     string `json:"password"`
 	ExtraOptions string `json:"options"`
+	// Connection pool settings, the limits are disabled when 0.
+	MaxOpenConns    int           `json:"maxOpenConns"`
+	MaxIdleConns    int           `json:"maxIdleConns"`
+	ConnMaxLifetime time.Duration `json:"connMaxLifetime"`
+	// The optional read replica of the database.
+	ReadReplica *DbConfig `json:"readReplica"`
 }
 
 func NewDbConfig(dbConfigValues interfaces.DbConfig) DbConfig {
+	var readReplica *DbConfig
+	if dbConfigValues.ReadReplica != nil {
+		replicaConfig := NewDbConfig(*dbConfigValues.ReadReplica)
+		readReplica = &replicaConfig
+	}
 	return DbConfig{
 		BaseConfig: BaseConfig{
 			IsDebug: dbConfigValues.Debug,
 		},
-		Host:         dbConfigValues.Host,
-		Port:         dbConfigValues.Port,
-		DbName:       dbConfigValues.DbName,
-		User:         dbConfigValues.User,
-		Password:     dbConfigValues.Password,
-		ExtraOptions: dbConfigValues.ExtraOptions,
+		Type:            dbConfigValues.Type,
+		Host:            dbConfigValues.Host,
+		Port:            dbConfigValues.Port,
+		DbName:          dbConfigValues.DbName,
+		User:            dbConfigValues.User,
+		Password:        dbConfigValues.Password,
+		ExtraOptions:    dbConfigValues.ExtraOptions,
+		MaxOpenConns:    dbConfigValues.MaxOpenConns,
+		MaxIdleConns:    dbConfigValues.MaxIdleConns,
+		ConnMaxLifetime: dbConfigValues.ConnMaxLifetime,
+		ReadReplica:     readReplica,
+	}
+}
+
+// Returns the connection config provider for the configured database type, which defaults to Postgres.
+func NewDbConnectionConfigProvider(config DbConfig, scope promutils.Scope) DbConnectionConfigProvider {
+	switch config.Type {
+	case "", PostgresDbType:
+		return NewPostgresConfigProvider(config, scope)
+	case SQLiteDbType:
+		return NewSQLiteConfigProvider(config, scope)
+	case MySQLDbType:
+		return NewMySQLConfigProvider(config, scope)
+	default:
+		panic(fmt.Sprintf("Invalid database type %v", config.Type))
 	}
 }
@@ -4,12 +4,13 @@ import (
 	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/storage"
 )
 
 /*
 	IMPORTANT: You'll observe several models are redefined below with named index tags *omitted*. This is because
-	postgres requires that index names be unique across *all* tables. If you modify Task, Execution, NodeExecution or
-	TaskExecution models in code be sure to update the appropriate duplicate definitions here.
+	postgres requires that index names be unique across *all* tables. If you modify Task, Execution, NodeExecution,
+	TaskExecution or TaskExecutionEvent models in code be sure to update the appropriate duplicate definitions here.
 */
 
 type TaskKey struct {
@@ -52,6 +53,10 @@ type NodeExecution struct {
 	LaunchedExecution models.Execution `gorm:"foreignkey:ParentNodeExecutionID"`
 	// In the case of dynamic workflow nodes, the remote closure is uploaded to the path specified here.
 	DynamicWorkflowRemoteClosureReference string
+	// The location of the closure in blob storage when it was offloaded there, in which case Closure is empty.
+	ClosureReference storage.DataReference
+	// Incremented by every update, which only applies when the version is still the one the updated model was read at.
+	StateVersion uint32
 }
 
 type TaskExecutionKey struct {
@@ -84,4 +89,19 @@ type TaskExecution struct {
 	Duration               time.Duration
 	// The child node executions (if any) launched by this task execution.
 	ChildNodeExecution []NodeExecution `gorm:"foreignkey:ParentTaskExecutionID"`
+	// The location of the closure in blob storage when it was offloaded there, in which case Closure is empty.
+	ClosureReference storage.DataReference
+	// Incremented by every update, which only applies when the version is still the one the updated model was read at.
+	StateVersion uint32
+}
+
+type TaskExecutionEvent struct {
+	models.BaseModel
+	TaskKey
+	NodeExecutionKey
+	RetryAttempt *uint32 `gorm:"primary_key;AUTO_INCREMENT:FALSE"`
+	RequestID    string
+	OccurredAt   time.Time
+	Phase        string `gorm:"primary_key"`
+	PhaseVersion uint32 `gorm:"primary_key;AUTO_INCREMENT:FALSE"`
 }
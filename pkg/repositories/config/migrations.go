@@ -1,6 +1,10 @@
 package config
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/jinzhu/gorm"
@@ -117,7 +121,7 @@ var Migrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&models.Execution{}).Error
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS cluster").Error
+			return dropColumnsIfExist(tx, "executions", "cluster")
 		},
 	},
 	// Update projects table to add description column
@@ -127,7 +131,7 @@ var Migrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&models.Project{}).Error
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE projects DROP COLUMN IF EXISTS description").Error
+			return dropColumnsIfExist(tx, "projects", "description")
 		},
 	},
 	// Add offloaded URIs to table
@@ -137,7 +141,7 @@ var Migrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&models.Execution{}).Error
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS InputsURI, DROP COLUMN IF EXISTS UserInputsURI").Error
+			return dropColumnsIfExist(tx, "executions", "InputsURI", "UserInputsURI")
 		},
 	},
 	// Create named_entity_metadata table.
@@ -167,7 +171,7 @@ var Migrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&models.Task{}).Error
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE tasks DROP COLUMN IF EXISTS type").Error
+			return dropColumnsIfExist(tx, "tasks", "type")
 		},
 	},
 	// Add state to name entity model
@@ -194,10 +198,10 @@ var Migrations = []*gormigrate.Migration{
 	{
 		ID: "2020-04-03-workflow-state",
 		Migrate: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE workflows DROP COLUMN IF EXISTS state").Error
+			return dropColumnsIfExist(tx, "workflows", "state")
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE workflows ADD COLUMN IF NOT EXISTS state integer;").Error
+			return addColumnIfNotExists(tx, "workflows", "state", "integer")
 		},
 	},
 	// Modify the executions & node_execution table, if necessary
@@ -220,7 +224,7 @@ var Migrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&models.Execution{}).Error
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS task_id").Error
+			return dropColumnsIfExist(tx, "executions", "task_id")
 		},
 	},
 
@@ -326,4 +330,304 @@ var Migrations = []*gormigrate.Migration{
 			return tx.DropTable("schedulable_entities_snapshot").Error
 		},
 	},
+
+	// Create notification deliveries table.
+	{
+		ID: "2021-08-12-notification_deliveries",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NotificationDelivery{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("notification_deliveries").Error
+		},
+	},
+
+	// Create notification subscriptions table.
+	{
+		ID: "2021-08-19-notification_subscriptions",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NotificationSubscription{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("notification_subscriptions").Error
+		},
+	},
+
+	// Create the transactional outbox table for external events.
+	{
+		ID: "2021-08-20-outbox_events",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.OutboxEvent{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("outbox_events").Error
+		},
+	},
+
+	// SQLite only allows the surrogate id to be the table's primary key, so the natural primary keys of tables are
+	// enforced with unique indexes instead.
+	{
+		ID: "2021-08-23-sqlite-unique-keys",
+		Migrate: func(tx *gorm.DB) error {
+			if tx.Dialect().GetName() != SQLite {
+				return nil
+			}
+			return createUniqueKeyIndexes(tx, &models.Project{}, &models.Task{}, &models.Workflow{},
+				&models.LaunchPlan{}, &models.Execution{}, &models.ExecutionEvent{}, &NodeExecution{},
+				&models.NodeExecutionEvent{}, &TaskExecution{}, &models.NamedEntityMetadata{},
+				&schedulerModels.SchedulableEntity{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if tx.Dialect().GetName() != SQLite {
+				return nil
+			}
+			return dropUniqueKeyIndexes(tx, &models.Project{}, &models.Task{}, &models.Workflow{},
+				&models.LaunchPlan{}, &models.Execution{}, &models.ExecutionEvent{}, &NodeExecution{},
+				&models.NodeExecutionEvent{}, &TaskExecution{}, &models.NamedEntityMetadata{},
+				&schedulerModels.SchedulableEntity{})
+		},
+	},
+
+	// Partition the execution event, node execution event and task execution tables by creation month in Postgres.
+	// Partitions of later months are created by the `migrate partitions` command.
+	{
+		ID: "2021-08-26-partition-event-tables",
+		Migrate: func(tx *gorm.DB) error {
+			if tx.Dialect().GetName() != Postgres {
+				return nil
+			}
+			now := time.Now()
+			for _, model := range []interface{}{&models.ExecutionEvent{}, &models.NodeExecutionEvent{}, &TaskExecution{}} {
+				if err := partitionTable(tx, model, now); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if tx.Dialect().GetName() != Postgres {
+				return nil
+			}
+			for _, model := range []interface{}{&models.ExecutionEvent{}, &models.NodeExecutionEvent{}, &TaskExecution{}} {
+				_, uniqueKey := getUniqueKey(tx, model)
+				if err := rebuildTable(tx, model, "", uniqueKey, func(table, replaced string) error {
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+
+	// Add references to closures offloaded to blob storage.
+	{
+		ID: "2021-08-30-closure-references",
+		Migrate: func(tx *gorm.DB) error {
+			for _, table := range []string{"executions", "node_executions", "task_executions"} {
+				if err := addColumnIfNotExists(tx, table, "closure_reference", "text"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, table := range []string{"executions", "node_executions", "task_executions"} {
+				if err := dropColumnsIfExist(tx, table, "closure_reference"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+
+	// Create the task execution events table, partitioned by creation month in Postgres like the other event tables.
+	{
+		ID: "2021-09-01-task-execution-events",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&TaskExecutionEvent{}).Error; err != nil {
+				return err
+			}
+			switch tx.Dialect().GetName() {
+			case Postgres:
+				return partitionTable(tx, &TaskExecutionEvent{}, time.Now())
+			case SQLite:
+				return createUniqueKeyIndexes(tx, &TaskExecutionEvent{})
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("task_execution_events").Error
+		},
+	},
+
+	// Add the versions guarding execution state updates against concurrent writes.
+	{
+		ID: "2021-09-08-execution-state-versions",
+		Migrate: func(tx *gorm.DB) error {
+			for _, table := range []string{"executions", "node_executions", "task_executions"} {
+				if err := addColumnIfNotExists(tx, table, "state_version", "integer NOT NULL DEFAULT 0"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, table := range []string{"executions", "node_executions", "task_executions"} {
+				if err := dropColumnsIfExist(tx, table, "state_version"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+
+	// Create role bindings table.
+	{
+		ID: "2021-09-13-role_bindings",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.RoleBinding{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("role_bindings").Error
+		},
+	},
+
+	// Create access tokens table.
+	{
+		ID: "2021-09-20-access_tokens",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.AccessToken{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("access_tokens").Error
+		},
+	},
+
+	// Create revoked tokens table.
+	{
+		ID: "2021-09-27-revoked_tokens",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.RevokedToken{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("revoked_tokens").Error
+		},
+	},
+
+	// Create audit logs table.
+	{
+		ID: "2021-10-04-audit_logs",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.AuditLog{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("audit_logs").Error
+		},
+	},
+
+	// Create the signing key ring table.
+	{
+		ID: "2021-10-11-signing_key_rings",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.SigningKeyRing{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("signing_key_rings").Error
+		},
+	},
+
+	// Add dead-lettering of outbox events and the lease of the outbox relay.
+	{
+		ID: "2021-10-18-outbox_relay_leases",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.OutboxEvent{}, &models.OutboxRelayLease{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.DropTable("outbox_relay_leases").Error; err != nil {
+				return err
+			}
+			return dropColumnsIfExist(tx, "outbox_events", "dead_lettered_at")
+		},
+	},
+
+	// Add the claims and email of the owner to access tokens.
+	{
+		ID: "2021-10-18-access_token_claims",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.AccessToken{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "access_tokens", "claims", "email")
+		},
+	},
+}
+
+// Drops the columns which exist in the table. SQLite and MySQL, unlike Postgres, don't support DROP COLUMN IF EXISTS.
+func dropColumnsIfExist(tx *gorm.DB, table string, columns ...string) error {
+	if tx.Dialect().GetName() == Postgres {
+		clauses := make([]string, len(columns))
+		for idx, column := range columns {
+			clauses[idx] = fmt.Sprintf("DROP COLUMN IF EXISTS %s", column)
+		}
+		return tx.Exec(fmt.Sprintf("ALTER TABLE %s %s", table, strings.Join(clauses, ", "))).Error
+	}
+	for _, column := range columns {
+		if !tx.Dialect().HasColumn(table, column) {
+			continue
+		}
+		if err := tx.Table(table).DropColumn(column).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Adds the column unless it already exists. SQLite and MySQL, unlike Postgres, don't support ADD COLUMN IF NOT EXISTS.
+func addColumnIfNotExists(tx *gorm.DB, table, column, columnType string) error {
+	if tx.Dialect().GetName() == Postgres {
+		return tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s %s;", table, column, columnType)).Error
+	}
+	if tx.Dialect().HasColumn(table, column) {
+		return nil
+	}
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s;", table, column, columnType)).Error
+}
+
+// Returns the table and primary key columns, other than the surrogate id, of a model.
+func getUniqueKey(tx *gorm.DB, model interface{}) (string, []string) {
+	scope := tx.NewScope(model)
+	var columns []string
+	for _, field := range scope.PrimaryFields() {
+		if field.DBName != "id" {
+			columns = append(columns, field.DBName)
+		}
+	}
+	return scope.TableName(), columns
+}
+
+func createUniqueKeyIndexes(tx *gorm.DB, models ...interface{}) error {
+	for _, model := range models {
+		table, columns := getUniqueKey(tx, model)
+		if len(columns) == 0 {
+			continue
+		}
+		if err := tx.Model(model).AddUniqueIndex(fmt.Sprintf("%s_pkey", table), columns...).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dropUniqueKeyIndexes(tx *gorm.DB, models ...interface{}) error {
+	for _, model := range models {
+		table, columns := getUniqueKey(tx, model)
+		if len(columns) == 0 {
+			continue
+		}
+		if err := tx.Model(model).RemoveIndex(fmt.Sprintf("%s_pkey", table)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/mysql" // Required to import database driver.
+)
+
+const MySQL = "mysql"
+
+// Applied to every MySQL connection. Times are scanned into time.Time values and text is exchanged in full UTF-8.
+const mysqlConnectionOptions = "parseTime=true&charset=utf8mb4"
+
+// MySQL implementation for DbConnectionConfigProvider, compatible with Aurora MySQL.
+type MySQLConfigProvider struct {
+	config DbConfig
+	scope  promutils.Scope
+}
+
+func NewMySQLConfigProvider(config DbConfig, scope promutils.Scope) DbConnectionConfigProvider {
+	return &MySQLConfigProvider{
+		config: config,
+		scope:  scope,
+	}
+}
+
+func (p *MySQLConfigProvider) GetType() string {
+	return MySQL
+}
+
+func (p *MySQLConfigProvider) GetArgs() string {
+	credentials := p.config.User
+	if p.config.Password != "" {
+		credentials = fmt.Sprintf("%s:%s", p.config.User, p.config.Password)
+	}
+	if len(p.config.ExtraOptions) == 0 {
+		return fmt.Sprintf("%s@tcp(%s:%d)/%s?%s",
+			credentials, p.config.Host, p.config.Port, p.config.DbName, mysqlConnectionOptions)
+	}
+	return fmt.Sprintf("%s@tcp(%s:%d)/%s?%s&%s",
+		credentials, p.config.Host, p.config.Port, p.config.DbName, mysqlConnectionOptions, p.config.ExtraOptions)
+}
+
+func (p *MySQLConfigProvider) WithDebugModeEnabled() {
+	p.config.IsDebug = true
+}
+
+func (p *MySQLConfigProvider) WithDebugModeDisabled() {
+	p.config.IsDebug = false
+}
+
+func (p *MySQLConfigProvider) IsDebug() bool {
+	return p.config.IsDebug
+}
+
+func (p *MySQLConfigProvider) GetConnectionPoolConfig() ConnectionPoolConfig {
+	return getConnectionPoolConfig(p.config)
+}
+
+func (p *MySQLConfigProvider) GetScope() promutils.Scope {
+	return p.scope
+}
+
+// Key columns are limited to ASCII so that composite keys of varchar(255) columns fit within the InnoDB maximum key
+// length. Binary collations make comparisons, including LIKE, case-sensitive as they are in Postgres.
+const (
+	mysqlKeyColumnCharset = "CHARACTER SET ascii COLLATE ascii_bin"
+	mysqlColumnCharset    = "CHARACTER SET utf8mb4 COLLATE utf8mb4_bin"
+)
+
+// The dialect registered by gorm for MySQL, which mysqlDialect defers to.
+var mysqlBaseDialectType = func() reflect.Type {
+	dialect, ok := gorm.GetDialect(MySQL)
+	if !ok {
+		panic("gorm mysql dialect is not registered")
+	}
+	return reflect.TypeOf(dialect).Elem()
+}()
+
+// Adapts the gorm MySQL dialect so that the column types created by migrations behave like their Postgres
+// counterparts: unsized strings and byte slices are unbounded, times keep microseconds and auto-incremented ids which
+// aren't primary keys are unique keys, as MySQL requires of them.
+type mysqlDialect struct {
+	gorm.Dialect
+}
+
+func (d *mysqlDialect) SetDB(db gorm.SQLCommon) {
+	// gorm instantiates a zero value dialect for every connection.
+	d.Dialect = reflect.New(mysqlBaseDialectType).Interface().(gorm.Dialect)
+	d.Dialect.SetDB(db)
+}
+
+func (d *mysqlDialect) DataTypeOf(field *gorm.StructField) string {
+	if _, ok := field.TagSettingsGet("TYPE"); ok {
+		return d.Dialect.DataTypeOf(field)
+	}
+	dataValue, _, size, additionalType := gorm.ParseFieldStructForDialect(field, d)
+	_, sized := field.TagSettingsGet("SIZE")
+	var sqlType string
+	switch dataValue.Kind() {
+	case reflect.String:
+		switch {
+		case isMySQLKeyColumn(field):
+			sqlType = fmt.Sprintf("varchar(%d) %s", size, mysqlKeyColumnCharset)
+		case sized && size > 0 && size < 65532:
+			sqlType = fmt.Sprintf("varchar(%d) %s", size, mysqlColumnCharset)
+		default:
+			sqlType = fmt.Sprintf("longtext %s", mysqlColumnCharset)
+		}
+	case reflect.Struct:
+		if _, ok := dataValue.Interface().(time.Time); !ok {
+			return d.Dialect.DataTypeOf(field)
+		}
+		if _, ok := field.TagSettingsGet("NOT NULL"); ok || field.IsPrimaryKey {
+			sqlType = "DATETIME(6)"
+		} else {
+			sqlType = "DATETIME(6) NULL"
+		}
+	default:
+		if gorm.IsByteArrayOrSlice(dataValue) && !sized {
+			sqlType = "longblob"
+			break
+		}
+		sqlType = d.Dialect.DataTypeOf(field)
+		if strings.Contains(sqlType, "AUTO_INCREMENT") && !field.IsPrimaryKey {
+			return fmt.Sprintf("%s UNIQUE", sqlType)
+		}
+		return sqlType
+	}
+	if strings.TrimSpace(additionalType) == "" {
+		return sqlType
+	}
+	return fmt.Sprintf("%s %s", sqlType, additionalType)
+}
+
+func isMySQLKeyColumn(field *gorm.StructField) bool {
+	if field.IsPrimaryKey {
+		return true
+	}
+	for _, setting := range []string{"INDEX", "UNIQUE_INDEX", "UNIQUE"} {
+		if _, ok := field.TagSettingsGet(setting); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	gorm.RegisterDialect(MySQL, &mysqlDialect{})
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstructMySQLArgs(t *testing.T) {
+	mysqlConfigProvider := NewMySQLConfigProvider(DbConfig{
+		BaseConfig: BaseConfig{
+			IsDebug: true,
+		},
+		Host:     "localhost",
+		Port:     3306,
+		DbName:   "flyteadmin",
+		User:     "flyte",
+		Password: "pass",
+	}, mockScope.NewTestScope())
+
+	assert.Equal(t, "mysql", mysqlConfigProvider.GetType())
+	assert.Equal(t, "flyte:pass@tcp(localhost:3306)/flyteadmin?parseTime=true&charset=utf8mb4",
+		mysqlConfigProvider.GetArgs())
+	assert.True(t, mysqlConfigProvider.IsDebug())
+}
+
+func TestConstructMySQLArgsWithExtraOptions(t *testing.T) {
+	mysqlConfigProvider := NewMySQLConfigProvider(DbConfig{
+		Host:         "localhost",
+		Port:         3306,
+		DbName:       "flyteadmin",
+		User:         "flyte",
+		ExtraOptions: "tls=true",
+	}, mockScope.NewTestScope())
+
+	assert.Equal(t, "flyte@tcp(localhost:3306)/flyteadmin?parseTime=true&charset=utf8mb4&tls=true",
+		mysqlConfigProvider.GetArgs())
+}
+
+type mysqlTestModel struct {
+	ID          uint `gorm:"index;AUTO_INCREMENT"`
+	CreatedAt   time.Time
+	DeletedAt   *time.Time `sql:"index"`
+	Project     string     `gorm:"primary_key"`
+	Workflow    string     `gorm:"unique_index:test_idx"`
+	Description string     `gorm:"type:varchar(300)"`
+	Cluster     string     `gorm:"size:100"`
+	Message     string
+	Closure     []byte `gorm:"not null"`
+	Digest      []byte `gorm:"size:32"`
+	Attempts    int
+}
+
+func TestMySQLDialect_DataTypeOf(t *testing.T) {
+	dialect, ok := gorm.GetDialect(MySQL)
+	assert.True(t, ok)
+	dialect.SetDB(nil)
+
+	dataTypes := make(map[string]string)
+	for _, field := range (&gorm.Scope{Value: &mysqlTestModel{}}).GetModelStruct().StructFields {
+		dataTypes[field.Name] = dialect.DataTypeOf(field)
+	}
+	assert.Equal(t, map[string]string{
+		"ID":          "int unsigned AUTO_INCREMENT UNIQUE",
+		"CreatedAt":   "DATETIME(6) NULL",
+		"DeletedAt":   "DATETIME(6) NULL",
+		"Project":     "varchar(255) CHARACTER SET ascii COLLATE ascii_bin",
+		"Workflow":    "varchar(255) CHARACTER SET ascii COLLATE ascii_bin",
+		"Description": "varchar(300)",
+		"Cluster":     "varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin",
+		"Message":     "longtext CHARACTER SET utf8mb4 COLLATE utf8mb4_bin",
+		"Closure":     "longblob NOT NULL",
+		"Digest":      "varbinary(32)",
+		"Attempts":    "int",
+	}, dataTypes)
+	assert.Equal(t, MySQL, dialect.GetName())
+}
@@ -0,0 +1,239 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/jinzhu/gorm"
+)
+
+// In Postgres the fastest growing tables are range partitioned by the month their rows were created in, so that
+// expired months can be detached and dropped rather than deleted row by row. Because the partition key has to be part
+// of every unique constraint, the created_at column is part of the primary key of partitioned tables.
+const partitionKey = "created_at"
+
+// Monthly partitions are named after their parent table, for instance task_executions_p2021_08.
+const partitionNameFormat = "%s_p%04d_%02d"
+
+// Rows which don't fall in any monthly partition, for instance because partition maintenance hasn't run, are kept in
+// the default partition.
+const defaultPartitionFormat = "%s_default"
+
+const partitionBoundFormat = "2006-01-02"
+
+// The models of all the tables which are partitioned by creation month in Postgres.
+var partitionedModels = []interface{}{&models.ExecutionEvent{}, &models.NodeExecutionEvent{}, &TaskExecution{},
+	&TaskExecutionEvent{}}
+
+// Options for pre-creating and expiring partitions of the partitioned tables.
+type PartitionMaintenanceOptions struct {
+	// The number of months, following the current one, for which partitions are created ahead of time.
+	PremakeMonths int
+	// The number of months, including the current one, for which partitions are retained. Partitions of earlier
+	// months are detached from their tables. Partitions are retained indefinitely when unset.
+	RetentionMonths int
+	// Whether expired partitions are dropped after being detached.
+	DropExpired bool
+}
+
+// Returns the first instant of the month t falls in.
+func getMonthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func getPartitionName(table string, month time.Time) string {
+	return fmt.Sprintf(partitionNameFormat, table, month.Year(), month.Month())
+}
+
+// Returns the month a partition holds the rows of, unless the partition isn't a monthly partition of the table.
+func parsePartitionMonth(table, partition string) (time.Time, bool) {
+	var year, month int
+	if _, err := fmt.Sscanf(strings.TrimPrefix(partition, table), "_p%04d_%02d", &year, &month); err != nil {
+		return time.Time{}, false
+	}
+	if getPartitionName(table, time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)) != partition {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC), true
+}
+
+// Returns the names of the partitions currently attached to the table.
+func listPartitions(tx *gorm.DB, table string) ([]string, error) {
+	rows, err := tx.Raw("SELECT child.relname FROM pg_inherits "+
+		"INNER JOIN pg_class parent ON pg_inherits.inhparent = parent.oid "+
+		"INNER JOIN pg_class child ON pg_inherits.inhrelid = child.oid "+
+		"WHERE parent.relname = ?", table).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var partitions []string
+	for rows.Next() {
+		var partition string
+		if err := rows.Scan(&partition); err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, partition)
+	}
+	return partitions, rows.Err()
+}
+
+// Creates the partition holding the rows created in the month, unless it already exists. Rows of the month which were
+// written to the default partition in the meantime are moved to the new partition.
+func createMonthlyPartition(tx *gorm.DB, table string, month time.Time) error {
+	partition := getPartitionName(table, month)
+	if tx.Dialect().HasTable(partition) {
+		return nil
+	}
+	defaultPartition := fmt.Sprintf(defaultPartitionFormat, table)
+	from := month.Format(partitionBoundFormat)
+	to := month.AddDate(0, 1, 0).Format(partitionBoundFormat)
+	monthFilter := fmt.Sprintf("%s >= '%s' AND %s < '%s'", partitionKey, from, partitionKey, to)
+	createPartition := fmt.Sprintf("CREATE TABLE %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+		partition, table, from, to)
+
+	var strayRows int
+	if err := tx.Table(defaultPartition).Where(monthFilter).Count(&strayRows).Error; err != nil {
+		return err
+	}
+	if strayRows == 0 {
+		return tx.Exec(createPartition).Error
+	}
+	// Postgres refuses to create a partition for rows the default partition holds, so the default partition is
+	// detached while its rows of the month are moved.
+	return tx.Transaction(func(tx *gorm.DB) error {
+		statements := []string{
+			fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", table, defaultPartition),
+			createPartition,
+			fmt.Sprintf("INSERT INTO %s SELECT * FROM %s WHERE %s", partition, defaultPartition, monthFilter),
+			fmt.Sprintf("DELETE FROM %s WHERE %s", defaultPartition, monthFilter),
+			fmt.Sprintf("ALTER TABLE %s ATTACH PARTITION %s DEFAULT", table, defaultPartition),
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Hands the sequence generating the surrogate ids of the table over to the table which replaces it, so that dropping
+// the replaced table doesn't drop the sequence too.
+func moveIDSequence(tx *gorm.DB, from, to string) error {
+	var sequence *string
+	if err := tx.Raw("SELECT pg_get_serial_sequence(?, 'id')", from).Row().Scan(&sequence); err != nil {
+		return err
+	}
+	if sequence == nil {
+		return nil
+	}
+	return tx.Exec(fmt.Sprintf("ALTER SEQUENCE %s OWNED BY %s.id", *sequence, to)).Error
+}
+
+// Replaces the table of the model with a copy of it. The copy is created using the given clause, for instance to
+// partition it, and has the given primary key. Indexes of the model are recreated afterwards.
+func rebuildTable(tx *gorm.DB, model interface{}, createClause string, primaryKey []string,
+	createPartitions func(table, replaced string) error) error {
+	table := tx.NewScope(model).TableName()
+	replaced := fmt.Sprintf("%s_replaced", table)
+	if err := tx.Exec(fmt.Sprintf("ALTER TABLE %s RENAME TO %s", table, replaced)).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec(fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS) %s",
+		table, replaced, createClause)).Error; err != nil {
+		return err
+	}
+	if err := createPartitions(table, replaced); err != nil {
+		return err
+	}
+	if err := tx.Exec(fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", table, replaced)).Error; err != nil {
+		return err
+	}
+	if err := moveIDSequence(tx, replaced, table); err != nil {
+		return err
+	}
+	if err := tx.DropTable(replaced).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (%s)",
+		table, strings.Join(primaryKey, ", "))).Error; err != nil {
+		return err
+	}
+	return tx.AutoMigrate(model).Error
+}
+
+// Converts the table of the model into one partitioned by creation month. Partitions are created for every month
+// from that of the oldest row up to the current one.
+func partitionTable(tx *gorm.DB, model interface{}, now time.Time) error {
+	_, uniqueKey := getUniqueKey(tx, model)
+	return rebuildTable(tx, model, fmt.Sprintf("PARTITION BY RANGE (%s)", partitionKey),
+		append(uniqueKey, partitionKey), func(table, replaced string) error {
+			if err := tx.Exec(fmt.Sprintf("CREATE TABLE %s PARTITION OF %s DEFAULT",
+				fmt.Sprintf(defaultPartitionFormat, table), table)).Error; err != nil {
+				return err
+			}
+			var oldest *time.Time
+			if err := tx.Table(replaced).Select(fmt.Sprintf("MIN(%s)", partitionKey)).Row().Scan(&oldest); err != nil {
+				return err
+			}
+			month := getMonthStart(now)
+			if oldest != nil && oldest.Before(month) {
+				month = getMonthStart(*oldest)
+			}
+			for ; !month.After(now); month = month.AddDate(0, 1, 0) {
+				if err := createMonthlyPartition(tx, table, month); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+}
+
+// Creates the partitions of upcoming months and detaches, and optionally drops, those of expired months for each of
+// the partitioned tables.
+func MaintainPartitions(ctx context.Context, db *gorm.DB, now time.Time, options PartitionMaintenanceOptions) error {
+	if db.Dialect().GetName() != Postgres {
+		return fmt.Errorf("partitioned tables are only supported by postgres, not %s", db.Dialect().GetName())
+	}
+	currentMonth := getMonthStart(now)
+	for _, model := range partitionedModels {
+		table := db.NewScope(model).TableName()
+		for months := 0; months <= options.PremakeMonths; months++ {
+			if err := createMonthlyPartition(db, table, currentMonth.AddDate(0, months, 0)); err != nil {
+				return fmt.Errorf("failed to create partition of %s: %w", table, err)
+			}
+		}
+		if options.RetentionMonths <= 0 {
+			continue
+		}
+		oldestRetainedMonth := currentMonth.AddDate(0, 1-options.RetentionMonths, 0)
+		partitions, err := listPartitions(db, table)
+		if err != nil {
+			return fmt.Errorf("failed to list partitions of %s: %w", table, err)
+		}
+		for _, partition := range partitions {
+			month, ok := parsePartitionMonth(table, partition)
+			if !ok || !month.Before(oldestRetainedMonth) {
+				continue
+			}
+			if err := db.Exec(fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", table, partition)).Error; err != nil {
+				return fmt.Errorf("failed to detach partition %s: %w", partition, err)
+			}
+			logger.Infof(ctx, "Detached expired partition %s of %s", partition, table)
+			if !options.DropExpired {
+				continue
+			}
+			if err := db.DropTable(partition).Error; err != nil {
+				return fmt.Errorf("failed to drop partition %s: %w", partition, err)
+			}
+			logger.Infof(ctx, "Dropped expired partition %s", partition)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMonthStart(t *testing.T) {
+	assert.Equal(t, time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC),
+		getMonthStart(time.Date(2021, time.August, 31, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2021, time.September, 1, 0, 0, 0, 0, time.UTC),
+		getMonthStart(time.Date(2021, time.August, 31, 22, 0, 0, 0, time.FixedZone("UTC-4", -4*60*60))))
+}
+
+func TestPartitionNames(t *testing.T) {
+	month := time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "task_executions_p2021_08", getPartitionName("task_executions", month))
+
+	parsedMonth, ok := parsePartitionMonth("task_executions", "task_executions_p2021_08")
+	assert.True(t, ok)
+	assert.Equal(t, month, parsedMonth)
+
+	for _, partition := range []string{"task_executions_default", "task_executions_p2021_08_old",
+		"execution_events_p2021_08", "task_executions_p2021_8"} {
+		_, ok = parsePartitionMonth("task_executions", partition)
+		assert.False(t, ok, partition)
+	}
+}
+
+func TestMaintainPartitionsRequiresPostgres(t *testing.T) {
+	db, err := gorm.Open(SQLite, ":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.EqualError(t, MaintainPartitions(context.Background(), db, time.Now(), PartitionMaintenanceOptions{}),
+		"partitioned tables are only supported by postgres, not sqlite3")
+}
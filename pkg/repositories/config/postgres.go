@@ -23,6 +23,10 @@ type DbConnectionConfigProvider interface {
 	WithDebugModeDisabled()
 	// Returns whether verbose logging is enabled or not.
 	IsDebug() bool
+	// Returns the limits of the pool of connections to the database.
+	GetConnectionPoolConfig() ConnectionPoolConfig
+	// Returns the scope the connection pool statistics are reported in.
+	GetScope() promutils.Scope
 }
 
 type BaseConfig struct {
@@ -69,6 +73,14 @@ func (p *PostgresConfigProvider) IsDebug() bool {
 	return p.config.IsDebug
 }
 
+func (p *PostgresConfigProvider) GetConnectionPoolConfig() ConnectionPoolConfig {
+	return getConnectionPoolConfig(p.config)
+}
+
+func (p *PostgresConfigProvider) GetScope() promutils.Scope {
+	return p.scope
+}
+
 // Opens a connection to the database specified in the config.
 // You must call CloseDbConnection at the end of your session!
 func OpenDbConnection(config DbConnectionConfigProvider) *gorm.DB {
@@ -77,6 +89,8 @@ func OpenDbConnection(config DbConnectionConfigProvider) *gorm.DB {
 		panic(err)
 	}
 	db.LogMode(config.IsDebug())
+	configureConnectionPool(db.DB(), config.GetConnectionPoolConfig())
+	registerDbStats(db.DB(), config.GetScope())
 	validations.RegisterCallbacks(db)
 	return db
 }
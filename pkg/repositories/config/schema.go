@@ -0,0 +1,259 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/jinzhu/gorm"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+// The models of all the tables of the admin database, which the live schema is verified against. Task execution tables
+// are verified against the migration models, because the task key of the current models declares the indexes of the
+// tasks table, which can't be created again for other tables.
+var schemaModels = []interface{}{
+	&models.Project{},
+	&models.Task{},
+	&models.Workflow{},
+	&models.LaunchPlan{},
+	&models.NamedEntityMetadata{},
+	&models.Execution{},
+	&models.ExecutionEvent{},
+	&models.NodeExecution{},
+	&models.NodeExecutionEvent{},
+	&TaskExecution{},
+	&TaskExecutionEvent{},
+	&models.Resource{},
+	&models.NotificationDelivery{},
+	&models.NotificationSubscription{},
+	&models.OutboxEvent{},
+	&models.OutboxRelayLease{},
+	&models.RoleBinding{},
+	&models.AccessToken{},
+	&models.RevokedToken{},
+	&models.AuditLog{},
+	&models.SigningKeyRing{},
+	&schedulerModels.SchedulableEntity{},
+	&schedulerModels.ScheduleEntitiesSnapshot{},
+}
+
+// Whether a migration was applied to the database.
+type MigrationStatus struct {
+	ID      string
+	Applied bool
+	// Migrations applied by other versions of admin, for instance newer ones, aren't known to this one.
+	Known bool
+}
+
+// Returns the status of the known migrations, in the order they are applied in, followed by the applied migrations
+// which aren't known.
+func GetMigrationStatus(db *gorm.DB) ([]MigrationStatus, error) {
+	applied := make(map[string]bool)
+	options := gormigrate.DefaultOptions
+	if db.Dialect().HasTable(options.TableName) {
+		var ids []string
+		if err := db.Table(options.TableName).Pluck(options.IDColumnName, &ids).Error; err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			applied[id] = true
+		}
+	}
+	statuses := make([]MigrationStatus, 0, len(Migrations))
+	for _, migration := range Migrations {
+		statuses = append(statuses, MigrationStatus{
+			ID:      migration.ID,
+			Applied: applied[migration.ID],
+			Known:   true,
+		})
+		delete(applied, migration.ID)
+	}
+	unknown := make([]string, 0, len(applied))
+	for id := range applied {
+		unknown = append(unknown, id)
+	}
+	sort.Strings(unknown)
+	for _, id := range unknown {
+		statuses = append(statuses, MigrationStatus{
+			ID:      id,
+			Applied: true,
+		})
+	}
+	return statuses, nil
+}
+
+// Returns whether all the known migrations were applied to the database.
+func IsSchemaCurrent(db *gorm.DB) (bool, error) {
+	statuses, err := GetMigrationStatus(db)
+	if err != nil {
+		return false, err
+	}
+	for _, status := range statuses {
+		if !status.Applied {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// A difference between the table of a model and its definition.
+type SchemaDifference struct {
+	Table string
+	// The column or index which differs, if the table exists.
+	Object string
+	Reason string
+}
+
+func (d SchemaDifference) String() string {
+	if d.Object == "" {
+		return fmt.Sprintf("%s: %s", d.Table, d.Reason)
+	}
+	return fmt.Sprintf("%s.%s: %s", d.Table, d.Object, d.Reason)
+}
+
+// Drivers report the types of columns differently than they are declared, for instance int8 for bigint in Postgres,
+// and sizes are often omitted, so types are compared by kind. MySQL stores booleans as tinyint.
+var columnTypeKinds = []struct {
+	substring string
+	kind      string
+}{
+	{"bool", "boolean"},
+	{"tinyint", "boolean"},
+	{"int", "integer"},
+	{"serial", "integer"},
+	{"char", "text"},
+	{"text", "text"},
+	{"clob", "text"},
+	{"bytea", "binary"},
+	{"blob", "binary"},
+	{"binary", "binary"},
+	{"timestamp", "timestamp"},
+	{"datetime", "timestamp"},
+	{"date", "timestamp"},
+	{"time", "timestamp"},
+	{"numeric", "numeric"},
+	{"decimal", "numeric"},
+	{"real", "numeric"},
+	{"double", "numeric"},
+	{"float", "numeric"},
+}
+
+func getColumnTypeKind(columnType string) string {
+	columnType = strings.ToLower(columnType)
+	for _, typeKind := range columnTypeKinds {
+		if strings.Contains(columnType, typeKind.substring) {
+			return typeKind.kind
+		}
+	}
+	return columnType
+}
+
+// Returns the kinds of the columns of the table by name.
+func getColumnKinds(db *gorm.DB, table string) (map[string]string, error) {
+	rows, err := db.Table(table).Limit(0).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	kinds := make(map[string]string, len(columnTypes))
+	for _, columnType := range columnTypes {
+		kinds[columnType.Name()] = getColumnTypeKind(columnType.DatabaseTypeName())
+	}
+	return kinds, nil
+}
+
+// Returns the names of the indexes gorm creates for the model when migrating it.
+func getIndexNames(db *gorm.DB, model interface{}) []string {
+	scope := db.NewScope(model)
+	var names []string
+	seen := make(map[string]bool)
+	for _, field := range scope.GetStructFields() {
+		for _, setting := range []struct {
+			tag    string
+			prefix string
+		}{{"INDEX", "idx"}, {"UNIQUE_INDEX", "uix"}} {
+			value, ok := field.TagSettingsGet(setting.tag)
+			if !ok {
+				continue
+			}
+			for _, name := range strings.Split(value, ",") {
+				if name == setting.tag || name == "" {
+					name = scope.Dialect().BuildKeyName(setting.prefix, scope.TableName(), field.DBName)
+				}
+				name, _ = scope.Dialect().NormalizeIndexAndColumn(name, field.DBName)
+				if !seen[name] {
+					seen[name] = true
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+func verifyModelSchema(db *gorm.DB, model interface{}) ([]SchemaDifference, error) {
+	scope := db.NewScope(model)
+	table := scope.TableName()
+	if !db.Dialect().HasTable(table) {
+		return []SchemaDifference{{Table: table, Reason: "table is missing"}}, nil
+	}
+	actualKinds, err := getColumnKinds(db, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the columns of %s: %w", table, err)
+	}
+	var differences []SchemaDifference
+	for _, field := range scope.GetStructFields() {
+		if !field.IsNormal || field.IsIgnored {
+			continue
+		}
+		actualKind, ok := actualKinds[field.DBName]
+		if !ok {
+			differences = append(differences, SchemaDifference{Table: table, Object: field.DBName,
+				Reason: "column is missing"})
+			continue
+		}
+		delete(actualKinds, field.DBName)
+		expectedType := scope.Dialect().DataTypeOf(field)
+		if expectedKind := getColumnTypeKind(expectedType); expectedKind != actualKind {
+			differences = append(differences, SchemaDifference{Table: table, Object: field.DBName,
+				Reason: fmt.Sprintf("column is of kind %s rather than %s (%s)", actualKind, expectedKind, expectedType)})
+		}
+	}
+	unexpected := make([]string, 0, len(actualKinds))
+	for column := range actualKinds {
+		unexpected = append(unexpected, column)
+	}
+	sort.Strings(unexpected)
+	for _, column := range unexpected {
+		differences = append(differences, SchemaDifference{Table: table, Object: column,
+			Reason: "column isn't defined by the model"})
+	}
+	for _, index := range getIndexNames(db, model) {
+		if !db.Dialect().HasIndex(table, index) {
+			differences = append(differences, SchemaDifference{Table: table, Object: index,
+				Reason: "index is missing"})
+		}
+	}
+	return differences, nil
+}
+
+// Compares the tables of the admin database with the models defining them, and returns the missing tables, the
+// missing, unexpected and mistyped columns, and the missing indexes.
+func VerifySchema(db *gorm.DB) ([]SchemaDifference, error) {
+	var differences []SchemaDifference
+	for _, model := range schemaModels {
+		modelDifferences, err := verifyModelSchema(db, model)
+		if err != nil {
+			return nil, err
+		}
+		differences = append(differences, modelDifferences...)
+	}
+	return differences, nil
+}
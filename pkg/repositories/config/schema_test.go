@@ -0,0 +1,86 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+func getSQLiteDbForSchemaTest(t *testing.T) *gorm.DB {
+	return OpenDbConnection(NewSQLiteConfigProvider(DbConfig{
+		DbName: filepath.Join(t.TempDir(), "admin.db"),
+	}, mockScope.NewTestScope()))
+}
+
+func TestGetMigrationStatus(t *testing.T) {
+	db := getSQLiteDbForSchemaTest(t)
+	defer db.Close()
+
+	statuses, err := GetMigrationStatus(db)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, len(Migrations))
+	assert.Equal(t, MigrationStatus{ID: Migrations[0].ID, Known: true}, statuses[0])
+	current, err := IsSchemaCurrent(db)
+	assert.NoError(t, err)
+	assert.False(t, current)
+
+	m := gormigrate.New(db, gormigrate.DefaultOptions, Migrations)
+	assert.NoError(t, m.MigrateTo(Migrations[1].ID))
+	// Migrations applied by a newer version of admin.
+	assert.NoError(t, db.Exec("INSERT INTO migrations (id) VALUES ('2099-01-01-future')").Error)
+	statuses, err = GetMigrationStatus(db)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, len(Migrations)+1)
+	assert.Equal(t, MigrationStatus{ID: Migrations[1].ID, Applied: true, Known: true}, statuses[1])
+	assert.Equal(t, MigrationStatus{ID: Migrations[2].ID, Known: true}, statuses[2])
+	assert.Equal(t, MigrationStatus{ID: "2099-01-01-future", Applied: true}, statuses[len(Migrations)])
+
+	assert.NoError(t, m.Migrate())
+	current, err = IsSchemaCurrent(db)
+	assert.NoError(t, err)
+	assert.True(t, current)
+}
+
+func TestVerifySchema_SQLite(t *testing.T) {
+	db := getSQLiteDbForSchemaTest(t)
+	defer db.Close()
+
+	differences, err := VerifySchema(db)
+	assert.NoError(t, err)
+	assert.Len(t, differences, len(schemaModels))
+	assert.Contains(t, differences, SchemaDifference{Table: "projects", Reason: "table is missing"})
+
+	assert.NoError(t, gormigrate.New(db, gormigrate.DefaultOptions, Migrations).Migrate())
+	differences, err = VerifySchema(db)
+	assert.NoError(t, err)
+	assert.Empty(t, differences)
+
+	assert.NoError(t, dropColumnsIfExist(db, "executions", "state_version"))
+	assert.NoError(t, addColumnIfNotExists(db, "executions", "legacy", "text"))
+	assert.NoError(t, db.Table("tasks").RemoveIndex("task_project_domain_name_idx").Error)
+	assert.NoError(t, db.DropTable("outbox_events").Error)
+	assert.NoError(t, db.Exec("CREATE TABLE outbox_events (id integer, created_at datetime, event_type text, "+
+		"partition_key text, payload blob, published_at datetime, attempts text, last_error text, "+
+		"dead_lettered_at datetime)").Error)
+	differences, err = VerifySchema(db)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"tasks.task_project_domain_name_idx: index is missing",
+		"executions.state_version: column is missing",
+		"executions.legacy: column isn't defined by the model",
+		"outbox_events.attempts: column is of kind text rather than integer (integer)",
+		"outbox_events.idx_outbox_events_published_at: index is missing",
+	}, getSchemaDifferenceStrings(differences))
+}
+
+func getSchemaDifferenceStrings(differences []SchemaDifference) []string {
+	strings := make([]string, len(differences))
+	for i, difference := range differences {
+		strings[i] = difference.String()
+	}
+	return strings
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/flyteorg/flytestdlib/promutils"
+	_ "github.com/jinzhu/gorm/dialects/sqlite" // Required to import database driver.
+)
+
+const SQLite = "sqlite3"
+
+// Applied to every SQLite connection. Write-ahead logging lets readers proceed alongside a writer, and writers wait on
+// locks held by concurrent writers, including those of other processes, rather than failing immediately.
+const sqliteConnectionOptions = "_busy_timeout=5000&_journal_mode=WAL"
+
+// SQLite implementation for DbConnectionConfigProvider, for single-node deployments and tests. The database name is
+// the path of the database file, which is created if it doesn't exist.
+type SQLiteConfigProvider struct {
+	config DbConfig
+	scope  promutils.Scope
+}
+
+func NewSQLiteConfigProvider(config DbConfig, scope promutils.Scope) DbConnectionConfigProvider {
+	return &SQLiteConfigProvider{
+		config: config,
+		scope:  scope,
+	}
+}
+
+func (p *SQLiteConfigProvider) GetType() string {
+	return SQLite
+}
+
+func (p *SQLiteConfigProvider) GetArgs() string {
+	if len(p.config.ExtraOptions) == 0 {
+		return fmt.Sprintf("file:%s?%s", p.config.DbName, sqliteConnectionOptions)
+	}
+	return fmt.Sprintf("file:%s?%s&%s", p.config.DbName, sqliteConnectionOptions, p.config.ExtraOptions)
+}
+
+func (p *SQLiteConfigProvider) WithDebugModeEnabled() {
+	p.config.IsDebug = true
+}
+
+func (p *SQLiteConfigProvider) WithDebugModeDisabled() {
+	p.config.IsDebug = false
+}
+
+func (p *SQLiteConfigProvider) IsDebug() bool {
+	return p.config.IsDebug
+}
+
+func (p *SQLiteConfigProvider) GetConnectionPoolConfig() ConnectionPoolConfig {
+	return getConnectionPoolConfig(p.config)
+}
+
+func (p *SQLiteConfigProvider) GetScope() promutils.Scope {
+	return p.scope
+}
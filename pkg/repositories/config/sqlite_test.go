@@ -0,0 +1,86 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+func TestConstructSQLiteArgs(t *testing.T) {
+	sqliteConfigProvider := NewSQLiteConfigProvider(DbConfig{
+		BaseConfig: BaseConfig{
+			IsDebug: true,
+		},
+		DbName: "/var/lib/flyteadmin/admin.db",
+	}, mockScope.NewTestScope())
+
+	assert.Equal(t, "sqlite3", sqliteConfigProvider.GetType())
+	assert.Equal(t, "file:/var/lib/flyteadmin/admin.db?_busy_timeout=5000&_journal_mode=WAL",
+		sqliteConfigProvider.GetArgs())
+	assert.True(t, sqliteConfigProvider.IsDebug())
+}
+
+func TestConstructSQLiteArgsWithExtraOptions(t *testing.T) {
+	sqliteConfigProvider := NewSQLiteConfigProvider(DbConfig{
+		DbName:       "admin.db",
+		ExtraOptions: "_foreign_keys=on",
+	}, mockScope.NewTestScope())
+
+	assert.Equal(t, "file:admin.db?_busy_timeout=5000&_journal_mode=WAL&_foreign_keys=on",
+		sqliteConfigProvider.GetArgs())
+}
+
+func TestNewDbConnectionConfigProvider(t *testing.T) {
+	assert.Equal(t, Postgres, NewDbConnectionConfigProvider(DbConfig{}, mockScope.NewTestScope()).GetType())
+	assert.Equal(t, Postgres, NewDbConnectionConfigProvider(DbConfig{Type: PostgresDbType},
+		mockScope.NewTestScope()).GetType())
+	assert.Equal(t, SQLite, NewDbConnectionConfigProvider(DbConfig{Type: SQLiteDbType},
+		mockScope.NewTestScope()).GetType())
+	assert.Equal(t, MySQL, NewDbConnectionConfigProvider(DbConfig{Type: MySQLDbType},
+		mockScope.NewTestScope()).GetType())
+	assert.Panics(t, func() {
+		NewDbConnectionConfigProvider(DbConfig{Type: "oracle"}, mockScope.NewTestScope())
+	})
+}
+
+func TestMigrations_SQLite(t *testing.T) {
+	db := OpenDbConnection(NewSQLiteConfigProvider(DbConfig{
+		DbName: filepath.Join(t.TempDir(), "admin.db"),
+	}, mockScope.NewTestScope()))
+	defer db.Close()
+
+	m := gormigrate.New(db, gormigrate.DefaultOptions, Migrations)
+	assert.NoError(t, m.Migrate())
+	for _, table := range []string{"projects", "tasks", "executions", "node_executions", "task_executions",
+		"named_entity_metadata", "resources", "outbox_events"} {
+		assert.True(t, db.HasTable(table), table)
+	}
+
+	// Natural primary keys are enforced by unique indexes.
+	insertTask := "INSERT INTO tasks (project, domain, name, version, closure) VALUES ('p', 'd', 'n', 'v', x'00')"
+	assert.NoError(t, db.Exec(insertTask).Error)
+	assert.Error(t, db.Exec(insertTask).Error)
+	insertMetadata := "INSERT INTO named_entity_metadata (resource_type, project, domain, name) VALUES (1, 'p', 'd', 'n')"
+	assert.NoError(t, db.Exec(insertMetadata).Error)
+	assert.Error(t, db.Exec(insertMetadata).Error)
+
+	assert.NoError(t, m.RollbackTo("2021-08-20-outbox_events"))
+	assert.NoError(t, db.Exec(insertTask).Error)
+}
+
+func TestDropColumnsIfExist_SQLite(t *testing.T) {
+	db := OpenDbConnection(NewSQLiteConfigProvider(DbConfig{
+		DbName: filepath.Join(t.TempDir(), "admin.db"),
+	}, mockScope.NewTestScope()))
+	defer db.Close()
+	assert.NoError(t, db.Exec("CREATE TABLE workflows (name varchar(255), state integer)").Error)
+
+	assert.NoError(t, dropColumnsIfExist(db, "workflows", "state", "missing"))
+	assert.False(t, db.Dialect().HasColumn("workflows", "state"))
+	assert.NoError(t, addColumnIfNotExists(db, "workflows", "state", "integer"))
+	assert.NoError(t, addColumnIfNotExists(db, "workflows", "state", "integer"))
+	assert.True(t, db.Dialect().HasColumn("workflows", "state"))
+}
@@ -12,6 +12,7 @@ const (
 	notFound          = "missing entity of type %s with identifier %v"
 	idNotFound        = "missing entity of type %s"
 	invalidInput      = "missing and/or invalid parameters: %s"
+	concurrentUpdate  = "entity of type %s was updated since it was read"
 )
 
 func GetMissingEntityError(entityType string, identifier proto.Message) errors.FlyteAdminError {
@@ -29,3 +30,27 @@ func GetMissingEntityByIDError(entityType string) errors.FlyteAdminError {
 func GetInvalidInputError(input string) errors.FlyteAdminError {
 	return errors.NewFlyteAdminErrorf(codes.InvalidArgument, invalidInput, input)
 }
+
+// A failed update of an entity which was updated concurrently since it was read. It reaches clients as an aborted
+// request, but unlike other aborted requests it is known to be safe to retry.
+type concurrentUpdateError struct {
+	errors.FlyteAdminError
+}
+
+func GetConcurrentUpdateError(entityType string) errors.FlyteAdminError {
+	return concurrentUpdateError{errors.NewFlyteAdminErrorf(codes.Aborted, concurrentUpdate, entityType)}
+}
+
+// Returns whether the error is a failed update of an entity which was updated concurrently since it was read. The
+// update may succeed when retried on a fresh read of the entity.
+func IsConcurrentUpdateError(err error) bool {
+	_, ok := err.(concurrentUpdateError)
+	return ok
+}
+
+// Returns whether the error is a database error which may not recur when the failed operation is retried, such as a
+// dropped connection or a deadlock.
+func IsTransientError(err error) bool {
+	adminErr, ok := err.(errors.FlyteAdminError)
+	return ok && adminErr.Code() == codes.Unavailable
+}
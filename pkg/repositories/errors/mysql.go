@@ -0,0 +1,113 @@
+// MySQL-specific implementation of an ErrorTransformer.
+// This errors utility translates MySQL server error numbers, as defined in
+// https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html, into internal error types.
+package errors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jinzhu/gorm"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+// MySQL error numbers
+const (
+	mysqlDuplicateEntry      uint16 = 1062
+	mysqlUndefinedTable      uint16 = 1146
+	mysqlIncorrectValue      uint16 = 1366
+	mysqlDataTooLongForField uint16 = 1406
+	mysqlTooManyConnections  uint16 = 1040
+	mysqlLockWaitTimeout     uint16 = 1205
+	mysqlLockDeadlock        uint16 = 1213
+)
+
+// MySQL reports unique constraint violations as "Duplicate entry '<value>' for key '<key>'".
+const mysqlDuplicateEntryKeySeparator = "for key "
+
+const (
+	defaultMySQLError    = "failed database operation with %s"
+	invalidMySQLArgument = "invalid value for database column: %s"
+)
+
+type mysqlErrorTransformerMetrics struct {
+	Scope              promutils.Scope
+	NotFound           prometheus.Counter
+	GormError          prometheus.Counter
+	AlreadyExistsError prometheus.Counter
+	UndefinedTable     prometheus.Counter
+	InvalidValue       prometheus.Counter
+	TransientError     prometheus.Counter
+	MySQLError         prometheus.Counter
+}
+
+type mysqlErrorTransformer struct {
+	metrics mysqlErrorTransformerMetrics
+}
+
+func (m *mysqlErrorTransformer) fromGormError(err error) errors.FlyteAdminError {
+	switch err.Error() {
+	case gorm.ErrRecordNotFound.Error():
+		m.metrics.NotFound.Inc()
+		return errors.NewFlyteAdminErrorf(codes.NotFound, "entry not found")
+	default:
+		m.metrics.GormError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Internal, unexpectedType, err)
+	}
+}
+
+func (m *mysqlErrorTransformer) ToFlyteAdminError(err error) errors.FlyteAdminError {
+	mysqlError, ok := err.(*mysql.MySQLError)
+	if !ok {
+		return m.fromGormError(err)
+	}
+	switch mysqlError.Number {
+	case mysqlDuplicateEntry:
+		m.metrics.AlreadyExistsError.Inc()
+		key := mysqlError.Message
+		if idx := strings.LastIndex(key, mysqlDuplicateEntryKeySeparator); idx >= 0 {
+			key = strings.Trim(key[idx+len(mysqlDuplicateEntryKeySeparator):], "'")
+		}
+		return errors.NewFlyteAdminErrorf(codes.AlreadyExists, uniqueConstraintViolation, key, mysqlError.Message)
+	case mysqlUndefinedTable:
+		m.metrics.UndefinedTable.Inc()
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, unsupportedTableOperation, mysqlError.Message)
+	case mysqlIncorrectValue, mysqlDataTooLongForField:
+		// Unlike Postgres text columns, MySQL key columns are bounded in length and limited to ASCII.
+		m.metrics.InvalidValue.Inc()
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, invalidMySQLArgument, mysqlError.Message)
+	case mysqlTooManyConnections, mysqlLockWaitTimeout, mysqlLockDeadlock:
+		m.metrics.TransientError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Unavailable, transientFailure, mysqlError.Message)
+	default:
+		m.metrics.MySQLError.Inc()
+		return errors.NewFlyteAdminError(codes.Unknown, fmt.Sprintf(defaultMySQLError, mysqlError.Message))
+	}
+}
+
+func NewMySQLErrorTransformer(scope promutils.Scope) ErrorTransformer {
+	metrics := mysqlErrorTransformerMetrics{
+		Scope: scope,
+		NotFound: scope.MustNewCounter("not_found",
+			"count of all queries for entities not found in the database"),
+		GormError: scope.MustNewCounter("gorm_error",
+			"unspecified gorm error returned by database operation"),
+		AlreadyExistsError: scope.MustNewCounter("already_exists",
+			"counts for when a unique constraint was violated in a database operation"),
+		UndefinedTable: scope.MustNewCounter("undefined_table",
+			"database operations referencing an undefined table"),
+		InvalidValue: scope.MustNewCounter("invalid_value",
+			"database operations writing values which don't fit their column"),
+		TransientError: scope.MustNewCounter("transient_error",
+			"database operations which failed transiently and may succeed when retried"),
+		MySQLError: scope.MustNewCounter("mysql_error",
+			"unspecified mysql error returned in a database operation"),
+	}
+	return &mysqlErrorTransformer{
+		metrics: metrics,
+	}
+}
@@ -0,0 +1,75 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	flyteAdminError "github.com/flyteorg/flyteadmin/pkg/errors"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestMySQLToFlyteAdminError_InvalidMySQLError(t *testing.T) {
+	transformedErr := NewMySQLErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(errors.New("foo"))
+	assert.Equal(t, codes.Internal, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.Equal(t, "unexpected error type for: foo", transformedErr.Error())
+}
+
+func TestMySQLToFlyteAdminError_NotFound(t *testing.T) {
+	transformedErr := NewMySQLErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(gorm.ErrRecordNotFound)
+	assert.Equal(t, codes.NotFound, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+}
+
+func TestMySQLToFlyteAdminError_UniqueConstraintViolation(t *testing.T) {
+	err := &mysql.MySQLError{
+		Number:  1062,
+		Message: "Duplicate entry 'project-domain-name-version' for key 'tasks.PRIMARY'",
+	}
+	transformedErr := NewMySQLErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.AlreadyExists, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.Equal(t, "value with matching tasks.PRIMARY already exists "+
+		"(Duplicate entry 'project-domain-name-version' for key 'tasks.PRIMARY')", transformedErr.Error())
+}
+
+func TestMySQLToFlyteAdminError_UndefinedTable(t *testing.T) {
+	err := &mysql.MySQLError{
+		Number:  1146,
+		Message: "Table 'flyteadmin.workflows' doesn't exist",
+	}
+	transformedErr := NewMySQLErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.InvalidArgument, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.Equal(t, "cannot query with specified table attributes: Table 'flyteadmin.workflows' doesn't exist",
+		transformedErr.Error())
+}
+
+func TestMySQLToFlyteAdminError_InvalidValue(t *testing.T) {
+	err := &mysql.MySQLError{
+		Number:  1406,
+		Message: "Data too long for column 'name' at row 1",
+	}
+	transformedErr := NewMySQLErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.InvalidArgument, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+}
+
+func TestMySQLToFlyteAdminError_UnrecognizedMySQLError(t *testing.T) {
+	err := &mysql.MySQLError{
+		Number:  1064,
+		Message: "You have an error in your SQL syntax",
+	}
+	transformedErr := NewMySQLErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.Unknown, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.Equal(t, "failed database operation with You have an error in your SQL syntax", transformedErr.Error())
+}
+
+func TestMySQLToFlyteAdminError_TransientError(t *testing.T) {
+	err := &mysql.MySQLError{
+		Number:  1213,
+		Message: "Deadlock found when trying to get lock; try restarting transaction",
+	}
+	transformedErr := NewMySQLErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.Unavailable, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.True(t, IsTransientError(transformedErr))
+}
@@ -7,6 +7,7 @@
 package errors
 
 import (
+	"database/sql/driver"
 	"fmt"
 
 	"github.com/flyteorg/flytestdlib/promutils"
@@ -22,14 +23,23 @@ import (
 const (
 	uniqueConstraintViolationCode = "23505"
 	undefinedTable                = "42P01"
+	serializationFailure          = "40001"
+	deadlockDetected              = "40P01"
+	tooManyConnections            = "53300"
+	adminShutdown                 = "57P01"
+	cannotConnectNow              = "57P03"
 )
 
+// Postgres reports connection failures with error codes of this class.
+const connectionExceptionClass = "08"
+
 // Error message format strings
 const (
 	unexpectedType            = "unexpected error type for: %v"
 	uniqueConstraintViolation = "value with matching %s already exists (%s)"
 	defaultPgError            = "failed database operation with %s"
 	unsupportedTableOperation = "cannot query with specified table attributes: %s"
+	transientFailure          = "transient failure of database operation with %s"
 )
 
 type postgresErrorTransformerMetrics struct {
@@ -38,6 +48,7 @@ type postgresErrorTransformerMetrics struct {
 	GormError          prometheus.Counter
 	AlreadyExistsError prometheus.Counter
 	UndefinedTable     prometheus.Counter
+	TransientError     prometheus.Counter
 	PostgresError      prometheus.Counter
 }
 
@@ -51,6 +62,9 @@ func (p *postgresErrorTransformer) fromGormError(err error) errors.FlyteAdminErr
 		p.metrics.NotFound.Inc()
 		return errors.NewFlyteAdminErrorf(codes.NotFound, "entry not found")
 		// If we want to intercept other gorm errors, add additional case statements here.
+	case driver.ErrBadConn.Error():
+		p.metrics.TransientError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Unavailable, transientFailure, err)
 	default:
 		p.metrics.GormError.Inc()
 		return errors.NewFlyteAdminErrorf(codes.Internal, unexpectedType, err)
@@ -69,7 +83,14 @@ func (p *postgresErrorTransformer) ToFlyteAdminError(err error) errors.FlyteAdmi
 	case undefinedTable:
 		p.metrics.UndefinedTable.Inc()
 		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, unsupportedTableOperation, pqError.Message)
+	case serializationFailure, deadlockDetected, tooManyConnections, adminShutdown, cannotConnectNow:
+		p.metrics.TransientError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Unavailable, transientFailure, pqError.Message)
 	default:
+		if pqError.Code.Class() == connectionExceptionClass {
+			p.metrics.TransientError.Inc()
+			return errors.NewFlyteAdminErrorf(codes.Unavailable, transientFailure, pqError.Message)
+		}
 		p.metrics.PostgresError.Inc()
 		return errors.NewFlyteAdminError(codes.Unknown, fmt.Sprintf(defaultPgError, pqError.Message))
 	}
@@ -86,6 +107,8 @@ func NewPostgresErrorTransformer(scope promutils.Scope) ErrorTransformer {
 			"counts for when a unique constraint was violated in a database operation"),
 		UndefinedTable: scope.MustNewCounter("undefined_table",
 			"database operations referencing an undefined table"),
+		TransientError: scope.MustNewCounter("transient_error",
+			"database operations which failed transiently and may succeed when retried"),
 		PostgresError: scope.MustNewCounter("postgres_error",
 			"unspecified postgres error returned in a database operation"),
 	}
@@ -41,3 +41,17 @@ func TestToFlyteAdminError_UnrecognizedPostgresError(t *testing.T) {
 	assert.Equal(t, "failed database operation with message",
 		transformedErr.(flyteAdminError.FlyteAdminError).Error())
 }
+
+func TestToFlyteAdminError_TransientPostgresError(t *testing.T) {
+	for _, code := range []pq.ErrorCode{"40P01", "57P01", "08006"} {
+		err := &pq.Error{
+			Code:    code,
+			Message: "message",
+		}
+		transformedErr := NewPostgresErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+		assert.Equal(t, codes.Unavailable, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+		assert.Equal(t, "transient failure of database operation with message",
+			transformedErr.(flyteAdminError.FlyteAdminError).Error())
+		assert.Equal(t, true, IsTransientError(transformedErr))
+	}
+}
@@ -0,0 +1,98 @@
+// SQLite-specific implementation of an ErrorTransformer.
+// This errors utility translates SQLite result codes, as defined in https://www.sqlite.org/rescode.html, into internal
+// error types.
+package errors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"github.com/mattn/go-sqlite3"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+// SQLite reports unique constraint violations as "UNIQUE constraint failed: <table>.<column>, ...".
+const sqliteConstraintFailedSeparator = "constraint failed: "
+
+// SQLite reports references to undefined tables as generic errors with this message prefix.
+const sqliteUndefinedTablePrefix = "no such table"
+
+const defaultSQLiteError = "failed database operation with %s"
+
+type sqliteErrorTransformerMetrics struct {
+	Scope              promutils.Scope
+	NotFound           prometheus.Counter
+	GormError          prometheus.Counter
+	AlreadyExistsError prometheus.Counter
+	UndefinedTable     prometheus.Counter
+	TransientError     prometheus.Counter
+	SQLiteError        prometheus.Counter
+}
+
+type sqliteErrorTransformer struct {
+	metrics sqliteErrorTransformerMetrics
+}
+
+func (s *sqliteErrorTransformer) fromGormError(err error) errors.FlyteAdminError {
+	switch err.Error() {
+	case gorm.ErrRecordNotFound.Error():
+		s.metrics.NotFound.Inc()
+		return errors.NewFlyteAdminErrorf(codes.NotFound, "entry not found")
+	default:
+		s.metrics.GormError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Internal, unexpectedType, err)
+	}
+}
+
+func (s *sqliteErrorTransformer) ToFlyteAdminError(err error) errors.FlyteAdminError {
+	sqliteError, ok := err.(sqlite3.Error)
+	if !ok {
+		return s.fromGormError(err)
+	}
+	message := sqliteError.Error()
+	switch {
+	case sqliteError.ExtendedCode == sqlite3.ErrConstraintUnique ||
+		sqliteError.ExtendedCode == sqlite3.ErrConstraintPrimaryKey:
+		s.metrics.AlreadyExistsError.Inc()
+		constraint := message
+		if idx := strings.Index(message, sqliteConstraintFailedSeparator); idx >= 0 {
+			constraint = message[idx+len(sqliteConstraintFailedSeparator):]
+		}
+		return errors.NewFlyteAdminErrorf(codes.AlreadyExists, uniqueConstraintViolation, constraint, message)
+	case sqliteError.Code == sqlite3.ErrError && strings.HasPrefix(message, sqliteUndefinedTablePrefix):
+		s.metrics.UndefinedTable.Inc()
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, unsupportedTableOperation, message)
+	case sqliteError.Code == sqlite3.ErrBusy || sqliteError.Code == sqlite3.ErrLocked:
+		// Another connection holds a conflicting lock on the database.
+		s.metrics.TransientError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Unavailable, transientFailure, message)
+	default:
+		s.metrics.SQLiteError.Inc()
+		return errors.NewFlyteAdminError(codes.Unknown, fmt.Sprintf(defaultSQLiteError, message))
+	}
+}
+
+func NewSQLiteErrorTransformer(scope promutils.Scope) ErrorTransformer {
+	metrics := sqliteErrorTransformerMetrics{
+		Scope: scope,
+		NotFound: scope.MustNewCounter("not_found",
+			"count of all queries for entities not found in the database"),
+		GormError: scope.MustNewCounter("gorm_error",
+			"unspecified gorm error returned by database operation"),
+		AlreadyExistsError: scope.MustNewCounter("already_exists",
+			"counts for when a unique constraint was violated in a database operation"),
+		UndefinedTable: scope.MustNewCounter("undefined_table",
+			"database operations referencing an undefined table"),
+		TransientError: scope.MustNewCounter("transient_error",
+			"database operations which failed transiently and may succeed when retried"),
+		SQLiteError: scope.MustNewCounter("sqlite_error",
+			"unspecified sqlite error returned in a database operation"),
+	}
+	return &sqliteErrorTransformer{
+		metrics: metrics,
+	}
+}
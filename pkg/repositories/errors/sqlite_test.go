@@ -0,0 +1,76 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	flyteAdminError "github.com/flyteorg/flyteadmin/pkg/errors"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite" // Required to import database driver.
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func getSQLiteDbForTest(t *testing.T) *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	assert.NoError(t, db.Exec("CREATE TABLE tasks (name varchar(255), version varchar(255))").Error)
+	assert.NoError(t, db.Exec("CREATE UNIQUE INDEX tasks_pkey ON tasks(name, version)").Error)
+	return db
+}
+
+func TestSQLiteToFlyteAdminError_InvalidSQLiteError(t *testing.T) {
+	transformedErr := NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(errors.New("foo"))
+	assert.Equal(t, codes.Internal, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.Equal(t, "unexpected error type for: foo", transformedErr.Error())
+}
+
+func TestSQLiteToFlyteAdminError_NotFound(t *testing.T) {
+	transformedErr := NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(gorm.ErrRecordNotFound)
+	assert.Equal(t, codes.NotFound, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+}
+
+func TestSQLiteToFlyteAdminError_UniqueConstraintViolation(t *testing.T) {
+	db := getSQLiteDbForTest(t)
+	defer db.Close()
+	assert.NoError(t, db.Exec("INSERT INTO tasks (name, version) VALUES ('name', 'version')").Error)
+	err := db.Exec("INSERT INTO tasks (name, version) VALUES ('name', 'version')").Error
+
+	transformedErr := NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.AlreadyExists, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.Equal(t, "value with matching tasks.name, tasks.version already exists "+
+		"(UNIQUE constraint failed: tasks.name, tasks.version)", transformedErr.Error())
+}
+
+func TestSQLiteToFlyteAdminError_UndefinedTable(t *testing.T) {
+	db := getSQLiteDbForTest(t)
+	defer db.Close()
+	err := db.Exec("SELECT * FROM workflows").Error
+
+	transformedErr := NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.InvalidArgument, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.Equal(t, "cannot query with specified table attributes: no such table: workflows", transformedErr.Error())
+}
+
+func TestSQLiteToFlyteAdminError_UnrecognizedSQLiteError(t *testing.T) {
+	db := getSQLiteDbForTest(t)
+	defer db.Close()
+	err := db.Exec("SELECT * FROM").Error
+
+	transformedErr := NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.Unknown, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.Contains(t, transformedErr.Error(), "failed database operation with")
+}
+
+func TestSQLiteToFlyteAdminError_TransientError(t *testing.T) {
+	err := sqlite3.Error{
+		Code: sqlite3.ErrBusy,
+	}
+	transformedErr := NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.Unavailable, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.True(t, IsTransientError(transformedErr))
+	assert.False(t, IsTransientError(NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(
+		gorm.ErrRecordNotFound)))
+}
@@ -1,23 +1,30 @@
 package repositories
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/gormimpl"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	schedulerInterfaces "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
 	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
 )
 
 type RepoConfig int32
 
 const (
 	POSTGRES RepoConfig = 0
+	SQLITE   RepoConfig = 1
+	MYSQL    RepoConfig = 2
 )
 
 var RepositoryConfigurationName = map[int32]string{
 	0: "POSTGRES",
+	1: "SQLITE",
+	2: "MYSQL",
 }
 
 // The RepositoryInterface indicates the methods that each Repository must support.
@@ -34,9 +41,20 @@ type RepositoryInterface interface {
 	NodeExecutionRepo() interfaces.NodeExecutionRepoInterface
 	NodeExecutionEventRepo() interfaces.NodeExecutionEventRepoInterface
 	TaskExecutionRepo() interfaces.TaskExecutionRepoInterface
+	TaskExecutionEventRepo() interfaces.TaskExecutionEventRepoInterface
 	NamedEntityRepo() interfaces.NamedEntityRepoInterface
+	NotificationDeliveryRepo() interfaces.NotificationDeliveryRepoInterface
+	NotificationSubscriptionRepo() interfaces.NotificationSubscriptionRepoInterface
+	OutboxEventRepo() interfaces.OutboxEventRepoInterface
+	RoleBindingRepo() interfaces.RoleBindingRepoInterface
+	AccessTokenRepo() interfaces.AccessTokenRepoInterface
+	RevokedTokenRepo() interfaces.RevokedTokenRepoInterface
+	AuditLogRepo() interfaces.AuditLogRepoInterface
+	SigningKeyRingRepo() interfaces.SigningKeyRingRepoInterface
 	SchedulableEntityRepo() schedulerInterfaces.SchedulableEntityRepoInterface
 	ScheduleEntitiesSnapshotRepo() schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
+	// Returns an error when the database can't serve requests, because it is unreachable or its schema is outdated.
+	CheckReadiness(ctx context.Context) error
 }
 
 func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutils.Scope) RepositoryInterface {
@@ -44,11 +62,55 @@ func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutil
 	case POSTGRES:
 		postgresScope := scope.NewSubScope("postgres")
 		db := config.OpenDbConnection(config.NewPostgresConfigProvider(dbConfig, postgresScope))
+		db = withReadReplica(db, dbConfig, postgresScope)
 		return NewPostgresRepo(
 			db,
 			errors.NewPostgresErrorTransformer(postgresScope.NewSubScope("errors")),
 			postgresScope.NewSubScope("repositories"))
+	case SQLITE:
+		sqliteScope := scope.NewSubScope("sqlite")
+		db := config.OpenDbConnection(config.NewSQLiteConfigProvider(dbConfig, sqliteScope))
+		db = withReadReplica(db, dbConfig, sqliteScope)
+		// The gorm repositories are shared by all databases, only errors are translated differently.
+		return NewPostgresRepo(
+			db,
+			errors.NewSQLiteErrorTransformer(sqliteScope.NewSubScope("errors")),
+			sqliteScope.NewSubScope("repositories"))
+	case MYSQL:
+		mysqlScope := scope.NewSubScope("mysql")
+		db := config.OpenDbConnection(config.NewMySQLConfigProvider(dbConfig, mysqlScope))
+		db = withReadReplica(db, dbConfig, mysqlScope)
+		return NewPostgresRepo(
+			db,
+			errors.NewMySQLErrorTransformer(mysqlScope.NewSubScope("errors")),
+			mysqlScope.NewSubScope("repositories"))
 	default:
 		panic(fmt.Sprintf("Invalid repoType %v", repoType))
 	}
 }
+
+// Attaches a connection to the read replica of the database, when one is configured, to which repositories route reads.
+func withReadReplica(db *gorm.DB, dbConfig config.DbConfig, scope promutils.Scope) *gorm.DB {
+	if dbConfig.ReadReplica == nil {
+		return db
+	}
+	replicaConfig := *dbConfig.ReadReplica
+	replicaConfig.Type = dbConfig.Type
+	replica := config.OpenDbConnection(
+		config.NewDbConnectionConfigProvider(replicaConfig, scope.NewSubScope("read_replica")))
+	return gormimpl.WithReadReplica(db, replica)
+}
+
+// Returns the repository type for the configured database type, which defaults to Postgres.
+func GetRepoConfig(dbConfig config.DbConfig) RepoConfig {
+	switch dbConfig.Type {
+	case "", config.PostgresDbType:
+		return POSTGRES
+	case config.SQLiteDbType:
+		return SQLITE
+	case config.MySQLDbType:
+		return MYSQL
+	default:
+		panic(fmt.Sprintf("Invalid database type %v", dbConfig.Type))
+	}
+}
@@ -0,0 +1,166 @@
+package repositories
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+func TestGetRepoConfig(t *testing.T) {
+	assert.Equal(t, POSTGRES, GetRepoConfig(config.DbConfig{}))
+	assert.Equal(t, POSTGRES, GetRepoConfig(config.DbConfig{Type: config.PostgresDbType}))
+	assert.Equal(t, SQLITE, GetRepoConfig(config.DbConfig{Type: config.SQLiteDbType}))
+	assert.Equal(t, MYSQL, GetRepoConfig(config.DbConfig{Type: config.MySQLDbType}))
+	assert.Panics(t, func() {
+		GetRepoConfig(config.DbConfig{Type: "oracle"})
+	})
+}
+
+func getSQLiteRepositoryForTest(t *testing.T) RepositoryInterface {
+	dbConfig := config.DbConfig{
+		Type:   config.SQLiteDbType,
+		DbName: filepath.Join(t.TempDir(), "admin.db"),
+	}
+	db := config.OpenDbConnection(config.NewSQLiteConfigProvider(dbConfig, promutils.NewTestScope()))
+	defer db.Close()
+	assert.NoError(t, gormigrate.New(db, gormigrate.DefaultOptions, config.Migrations).Migrate())
+	return GetRepository(GetRepoConfig(dbConfig), dbConfig, promutils.NewTestScope())
+}
+
+func TestGetRepository_SQLite(t *testing.T) {
+	ctx := context.Background()
+	repository := getSQLiteRepositoryForTest(t)
+
+	for _, version := range []string{"v1", "v2"} {
+		assert.NoError(t, repository.TaskRepo().Create(ctx, models.Task{
+			TaskKey: models.TaskKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+				Version: version,
+			},
+			Closure: []byte("closure"),
+		}))
+	}
+	err := repository.TaskRepo().Create(ctx, models.Task{
+		TaskKey: models.TaskKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+			Version: "v1",
+		},
+		Closure: []byte("closure"),
+	})
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	task, err := repository.TaskRepo().Get(ctx, interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+		Version: "v2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("closure"), task.Closure)
+	_, err = repository.TaskRepo().Get(ctx, interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+		Version: "v3",
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	nameFilter, err := common.NewSingleValueFilter(common.Task, common.Equal, "name", "name")
+	assert.NoError(t, err)
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       "version",
+		Direction: admin.Sort_DESCENDING,
+	})
+	assert.NoError(t, err)
+	tasks, err := repository.TaskRepo().List(ctx, interfaces.ListResourceInput{
+		Limit:         10,
+		InlineFilters: []common.InlineFilter{nameFilter},
+		SortParameter: sortParameter,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, tasks.Tasks, 2)
+	assert.Equal(t, "v2", tasks.Tasks[0].Version)
+
+	// LIKE wildcards in contains filters are matched literally.
+	versionFilter, err := common.NewSingleValueFilter(common.Task, common.Contains, "version", "_")
+	assert.NoError(t, err)
+	tasks, err = repository.TaskRepo().List(ctx, interfaces.ListResourceInput{
+		Limit:         10,
+		InlineFilters: []common.InlineFilter{nameFilter, versionFilter},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, tasks.Tasks)
+}
+
+func TestGetRepository_ReadReplica(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	dbConfig := config.DbConfig{
+		Type:   config.SQLiteDbType,
+		DbName: filepath.Join(dir, "admin.db"),
+		ReadReplica: &config.DbConfig{
+			DbName: filepath.Join(dir, "replica.db"),
+		},
+	}
+	for _, dbName := range []string{dbConfig.DbName, dbConfig.ReadReplica.DbName} {
+		db := config.OpenDbConnection(config.NewSQLiteConfigProvider(config.DbConfig{DbName: dbName},
+			promutils.NewTestScope()))
+		assert.NoError(t, gormigrate.New(db, gormigrate.DefaultOptions, config.Migrations).Migrate())
+		assert.NoError(t, db.Close())
+	}
+	repository := GetRepository(GetRepoConfig(dbConfig), dbConfig, promutils.NewTestScope())
+
+	taskKey := models.TaskKey{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+		Version: "v1",
+	}
+	assert.NoError(t, repository.TaskRepo().Create(ctx, models.Task{TaskKey: taskKey, Closure: []byte("closure")}))
+	identifier := interfaces.Identifier{
+		Project: taskKey.Project,
+		Domain:  taskKey.Domain,
+		Name:    taskKey.Name,
+		Version: taskKey.Version,
+	}
+
+	// The write isn't replicated to the replica, which serves reads unless the primary is requested.
+	_, err := repository.TaskRepo().Get(ctx, identifier)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	task, err := repository.TaskRepo().Get(interfaces.WithReadFromPrimary(ctx), identifier)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("closure"), task.Closure)
+}
+
+func TestCheckReadiness_SQLite(t *testing.T) {
+	ctx := context.Background()
+	dbConfig := config.DbConfig{
+		Type:   config.SQLiteDbType,
+		DbName: filepath.Join(t.TempDir(), "admin.db"),
+	}
+	repository := GetRepository(GetRepoConfig(dbConfig), dbConfig, promutils.NewTestScope())
+	assert.EqualError(t, repository.CheckReadiness(ctx), "database has pending migrations")
+
+	db := config.OpenDbConnection(config.NewSQLiteConfigProvider(dbConfig, promutils.NewTestScope()))
+	defer db.Close()
+	m := gormigrate.New(db, gormigrate.DefaultOptions, config.Migrations)
+	assert.NoError(t, m.MigrateTo(config.Migrations[0].ID))
+	assert.EqualError(t, repository.CheckReadiness(ctx), "database has pending migrations")
+	assert.NoError(t, m.Migrate())
+	assert.NoError(t, repository.CheckReadiness(ctx))
+}
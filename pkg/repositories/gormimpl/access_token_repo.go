@@ -0,0 +1,97 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+const createdAtDescending = "created_at desc"
+
+// Implementation of AccessTokenRepoInterface.
+type AccessTokenRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *AccessTokenRepo) Create(ctx context.Context, input *models.AccessToken) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *AccessTokenRepo) Get(ctx context.Context, id uint) (models.AccessToken, error) {
+	var token models.AccessToken
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where(&models.AccessToken{ID: id}).Take(&token)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.AccessToken{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"access token [%d] not found", id)
+	}
+	if tx.Error != nil {
+		return models.AccessToken{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return token, nil
+}
+
+func (r *AccessTokenRepo) GetByHash(ctx context.Context, tokenHash string) (models.AccessToken, error) {
+	var token models.AccessToken
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where("token_hash = ?", tokenHash).Take(&token)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.AccessToken{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "access token not found")
+	}
+	if tx.Error != nil {
+		return models.AccessToken{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return token, nil
+}
+
+func (r *AccessTokenRepo) List(ctx context.Context, owner string) ([]models.AccessToken, error) {
+	var tokens []models.AccessToken
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Where("owner = ?", owner).Order(createdAtDescending).Find(&tokens)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tokens, nil
+}
+
+func (r *AccessTokenRepo) Revoke(ctx context.Context, id uint, revokedAt time.Time) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.AccessToken{}).Where("id = ?", id).Update("revoked_at", revokedAt)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "access token [%d] not found", id)
+	}
+	return nil
+}
+
+// Returns an instance of AccessTokenRepoInterface
+func NewAccessTokenRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.AccessTokenRepoInterface {
+	metrics := newMetrics(scope)
+	return &AccessTokenRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
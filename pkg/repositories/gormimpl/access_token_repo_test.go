@@ -0,0 +1,96 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func getMockAccessTokenResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         1,
+		"token_hash": "hash",
+		"name":       "ci",
+		"owner":      "user@example.com",
+		"scopes":     "executions:read executions:write",
+		"expires_at": time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCreateAccessToken(t *testing.T) {
+	accessTokenRepo := NewAccessTokenRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "access_tokens" ("created_at","updated_at","token_hash","name","owner",` +
+		`"service_account","scopes","claims","email","expires_at","revoked_at") VALUES (?,?,?,?,?,?,?,?,?,?,?)`)
+
+	err := accessTokenRepo.Create(context.Background(), &models.AccessToken{
+		TokenHash: "hash",
+		Name:      "ci",
+		Owner:     "user@example.com",
+		Scopes:    "all",
+		ExpiresAt: time.Now(),
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestGetAccessTokenByHash(t *testing.T) {
+	accessTokenRepo := NewAccessTokenRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "access_tokens"  WHERE (token_hash = hash) LIMIT 1`).WithReply(
+		[]map[string]interface{}{getMockAccessTokenResponse()})
+
+	token, err := accessTokenRepo.GetByHash(context.Background(), "hash")
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", token.Owner)
+	assert.Equal(t, "executions:read executions:write", token.Scopes)
+	assert.Nil(t, token.RevokedAt)
+}
+
+func TestGetAccessTokenByHash_NotFound(t *testing.T) {
+	accessTokenRepo := NewAccessTokenRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := accessTokenRepo.GetByHash(context.Background(), "hash")
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestListAccessTokens(t *testing.T) {
+	accessTokenRepo := NewAccessTokenRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "access_tokens"  WHERE (owner = user@example.com) ORDER BY created_at desc`).WithReply(
+		[]map[string]interface{}{getMockAccessTokenResponse()})
+
+	tokens, err := accessTokenRepo.List(context.Background(), "user@example.com")
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, "ci", tokens[0].Name)
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	accessTokenRepo := NewAccessTokenRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "access_tokens" SET "revoked_at" = ?, "updated_at" = ?  WHERE (id = ?)`).WithRowsNum(1)
+
+	assert.NoError(t, accessTokenRepo.Revoke(context.Background(), 1, time.Now()))
+	assert.True(t, query.Triggered)
+}
+
+func TestRevokeAccessToken_NotFound(t *testing.T) {
+	accessTokenRepo := NewAccessTokenRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	err := accessTokenRepo.Revoke(context.Background(), 1, time.Now())
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
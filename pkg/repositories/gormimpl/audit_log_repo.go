@@ -0,0 +1,68 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+const auditLogsMostRecentFirst = "received_at desc, id desc"
+
+// Implementation of AuditLogRepoInterface.
+type AuditLogRepo struct {
+	db               *gorm.DB
+	reader           readRouter
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *AuditLogRepo) Create(_ context.Context, input *models.AuditLog) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *AuditLogRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.AuditLog, error) {
+	// Unlike other list queries, the audit log may be listed without filters, by those authorized in all projects.
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	var auditLogs []models.AuditLog
+	tx := r.reader.db(ctx).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	} else {
+		tx = tx.Order(auditLogsMostRecentFirst)
+	}
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&auditLogs)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return auditLogs, nil
+}
+
+// Returns an instance of AuditLogRepoInterface
+func NewAuditLogRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.AuditLogRepoInterface {
+	metrics := newMetrics(scope)
+	return &AuditLogRepo{
+		db:               db,
+		reader:           newReadRouter(db, scope),
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
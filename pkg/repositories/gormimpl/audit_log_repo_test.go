@@ -0,0 +1,70 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAuditLog(t *testing.T) {
+	auditLogRepo := NewAuditLogRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	insert := GlobalMock.NewMock()
+	insert.WithQuery(`INSERT INTO "audit_logs" ("created_at","subject","client_id","token_issued_at","client_ip",` +
+		`"method","mode","project","domain","parameters","response_code","received_at","sent_at") ` +
+		`VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+
+	err := auditLogRepo.Create(context.Background(), &models.AuditLog{
+		Subject:      "subject",
+		Method:       "TerminateExecution",
+		Mode:         1,
+		Project:      "project",
+		Domain:       "domain",
+		Parameters:   []byte(`{"name":"name"}`),
+		ResponseCode: "OK",
+		ReceivedAt:   time.Now(),
+		SentAt:       time.Now(),
+	})
+	assert.NoError(t, err)
+	assert.True(t, insert.Triggered)
+}
+
+func TestListAuditLogs(t *testing.T) {
+	auditLogRepo := NewAuditLogRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "audit_logs"  WHERE (subject = alice) AND (received_at >= ` +
+		`2021-10-01 00:00:00 +0000 UTC) ORDER BY received_at desc, id desc LIMIT 10 OFFSET 20`).WithReply(
+		[]map[string]interface{}{
+			{"id": 2, "subject": "alice", "method": "TerminateExecution"},
+			{"id": 1, "subject": "alice", "method": "UpdateProjectDomainAttributes"},
+		})
+
+	subjectFilter, err := common.NewSingleValueFilter(common.AuditLog, common.Equal, "subject", "alice")
+	assert.NoError(t, err)
+	receivedAtFilter, err := common.NewSingleValueFilter(common.AuditLog, common.GreaterThanOrEqual, "received_at",
+		time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	auditLogs, err := auditLogRepo.List(context.Background(), interfaces.ListResourceInput{
+		Limit:         10,
+		Offset:        20,
+		InlineFilters: []common.InlineFilter{subjectFilter, receivedAtFilter},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, auditLogs, 2)
+	assert.Equal(t, "TerminateExecution", auditLogs[0].Method)
+}
+
+func TestListAuditLogs_MissingLimit(t *testing.T) {
+	auditLogRepo := NewAuditLogRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := auditLogRepo.List(context.Background(), interfaces.ListResourceInput{})
+	assert.Error(t, err)
+}
@@ -2,11 +2,14 @@ package gormimpl
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/storage"
 	"github.com/jinzhu/gorm"
 	"google.golang.org/grpc/codes"
 )
@@ -18,6 +21,12 @@ const Description = "description"
 const ResourceType = "resource_type"
 const State = "state"
 const ID = "id"
+const CreatedAt = "created_at"
+const UpdatedAt = "updated_at"
+const stateVersionColumn = "state_version"
+
+// Orders events by when they occurred, falling back to the order in which they were recorded.
+const eventTimelineOrder = "occurred_at asc, id asc"
 
 const executionTableName = "executions"
 const namedEntityMetadataTableName = "named_entity_metadata"
@@ -26,6 +35,9 @@ const nodeExecutionEventTableName = "node_event_executions"
 const taskExecutionTableName = "task_executions"
 const taskTableName = "tasks"
 
+// Postgres, MySQL and SQLite all accept at least this many bind parameters in a single statement.
+const maxInsertBatchParameters = 30000
+
 const limit = "limit"
 const filters = "filters"
 
@@ -79,6 +91,106 @@ func ValidateListInput(input interfaces.ListResourceInput) adminErrors.FlyteAdmi
 	return nil
 }
 
+// Updates skip blank fields, so the closure column is cleared explicitly once the closure was offloaded to blob storage.
+func clearOffloadedClosure(tx *gorm.DB, closureReference storage.DataReference) *gorm.DB {
+	if tx.Error != nil || closureReference == "" {
+		return tx
+	}
+	return tx.UpdateColumn("closure", nil)
+}
+
+// Applies the write of a model, read at the given state version, in a transaction which also inserts the outbox event
+// describing the write when there is one. The state version is incremented first, but only when it still matches: a
+// concurrent write of a model read at the same version then waits on this transaction and, matching no row once it
+// committed, fails with a concurrent update error rather than overwriting this write.
+func writeIfUnmodified(db *gorm.DB, errorTransformer errors.ErrorTransformer, entityType string, model interface{},
+	stateVersion *uint32, write func(tx *gorm.DB) *gorm.DB, event *models.OutboxEvent) error {
+	readVersion := *stateVersion
+	err := db.Transaction(func(tx *gorm.DB) error {
+		increment := tx.Model(model).Where(fmt.Sprintf("%s = ?", stateVersionColumn), readVersion).
+			UpdateColumn(stateVersionColumn, readVersion+1)
+		if increment.Error != nil {
+			return errorTransformer.ToFlyteAdminError(increment.Error)
+		}
+		if increment.RowsAffected == 0 {
+			return errors.GetConcurrentUpdateError(entityType)
+		}
+		*stateVersion = readVersion + 1
+		if err := write(tx).Error; err != nil {
+			return errorTransformer.ToFlyteAdminError(err)
+		}
+		if event != nil {
+			if err := tx.Create(event).Error; err != nil {
+				return errorTransformer.ToFlyteAdminError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		*stateVersion = readVersion
+		if _, ok := err.(adminErrors.FlyteAdminError); !ok {
+			return errorTransformer.ToFlyteAdminError(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// Inserts records of the same model using multi-row INSERT statements, rather than a statement per record, in a single
+// transaction. Like Create, this leaves blank auto-incremented columns to the database and sets blank timestamps, but
+// it doesn't invoke model hooks.
+func insertBatch(db *gorm.DB, records []interface{}) error {
+	if len(records) == 0 {
+		return nil
+	}
+	now := gorm.NowFunc()
+	var columns []string
+	var fieldNames []string
+	for _, field := range db.NewScope(records[0]).Fields() {
+		if !field.IsNormal || field.IsIgnored || (field.HasDefaultValue && field.IsBlank) {
+			continue
+		}
+		columns = append(columns, field.DBName)
+		fieldNames = append(fieldNames, field.Name)
+	}
+	rowPlaceholder := fmt.Sprintf("(%s)", strings.TrimSuffix(strings.Repeat("?,", len(columns)), ","))
+	rowsPerStatement := maxInsertBatchParameters / len(columns)
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		scope := tx.NewScope(records[0])
+		quotedColumns := make([]string, len(columns))
+		for i, column := range columns {
+			quotedColumns[i] = scope.Quote(column)
+		}
+		insert := fmt.Sprintf("INSERT INTO %s (%s) VALUES ", scope.QuotedTableName(), strings.Join(quotedColumns, ","))
+		for start := 0; start < len(records); start += rowsPerStatement {
+			end := start + rowsPerStatement
+			if end > len(records) {
+				end = len(records)
+			}
+			placeholders := make([]string, 0, end-start)
+			values := make([]interface{}, 0, (end-start)*len(columns))
+			for _, record := range records[start:end] {
+				recordScope := tx.NewScope(record)
+				for _, name := range fieldNames {
+					field, _ := recordScope.FieldByName(name)
+					if (name == "CreatedAt" || name == "UpdatedAt") && field.IsBlank {
+						if err := field.Set(now); err != nil {
+							return err
+						}
+					}
+					values = append(values, field.Field.Interface())
+				}
+				placeholders = append(placeholders, rowPlaceholder)
+			}
+			if err := tx.Exec(insert+strings.Join(placeholders, ","), values...).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func applyFilters(tx *gorm.DB, inlineFilters []common.InlineFilter, mapFilters []common.MapFilter) (*gorm.DB, error) {
 	for _, filter := range inlineFilters {
 		gormQueryExpr, err := filter.GetGormQueryExpr()
@@ -14,6 +14,7 @@ type ExecutionEventRepo struct {
 	db               *gorm.DB
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
+	reader           readRouter
 }
 
 func (r *ExecutionEventRepo) Create(ctx context.Context, input models.ExecutionEvent) error {
@@ -26,6 +27,38 @@ func (r *ExecutionEventRepo) Create(ctx context.Context, input models.ExecutionE
 	return nil
 }
 
+func (r *ExecutionEventRepo) BatchCreate(ctx context.Context, inputs []models.ExecutionEvent) error {
+	records := make([]interface{}, len(inputs))
+	for i := range inputs {
+		records[i] = &inputs[i]
+	}
+	timer := r.metrics.CreateDuration.Start()
+	err := insertBatch(r.db, records)
+	timer.Stop()
+	if err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *ExecutionEventRepo) ListByExecution(
+	ctx context.Context, executionID interfaces.Identifier) ([]models.ExecutionEvent, error) {
+	var events []models.ExecutionEvent
+	timer := r.metrics.ListDuration.Start()
+	tx := r.reader.db(ctx).Where(&models.ExecutionEvent{
+		ExecutionKey: models.ExecutionKey{
+			Project: executionID.Project,
+			Domain:  executionID.Domain,
+			Name:    executionID.Name,
+		},
+	}).Order(eventTimelineOrder).Find(&events)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return events, nil
+}
+
 // Returns an instance of ExecutionRepoInterface
 func NewExecutionEventRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ExecutionEventRepoInterface {
@@ -34,5 +67,6 @@ func NewExecutionEventRepo(
 		db:               db,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
+		reader:           newReadRouter(db, scope),
 	}
 }
@@ -7,6 +7,7 @@ import (
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
@@ -33,3 +34,55 @@ func TestCreateExecutionEvent(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, executionEventQuery.Triggered)
 }
+
+func TestBatchCreateExecutionEvents(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	executionEventQuery := GlobalMock.NewMock()
+	executionEventQuery.WithQuery(`INSERT INTO "execution_events" ("created_at","updated_at","deleted_at",` +
+		`"execution_project","execution_domain","execution_name","request_id","occurred_at","phase") VALUES ` +
+		`(?,?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?,?)`)
+	execEventRepo := NewExecutionEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	executionKey := models.ExecutionKey{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "1",
+	}
+	err := execEventRepo.BatchCreate(context.Background(), []models.ExecutionEvent{
+		{
+			RequestID:    "request id 1",
+			ExecutionKey: executionKey,
+			OccurredAt:   time.Now(),
+			Phase:        core.WorkflowExecution_RUNNING.String(),
+		},
+		{
+			RequestID:    "request id 2",
+			ExecutionKey: executionKey,
+			OccurredAt:   time.Now(),
+			Phase:        core.WorkflowExecution_SUCCEEDED.String(),
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, executionEventQuery.Triggered)
+}
+
+func TestListExecutionEventsByExecution(t *testing.T) {
+	execEventRepo := NewExecutionEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "execution_events"  WHERE "execution_events"."deleted_at" IS NULL AND ` +
+			`(("execution_events"."execution_project" = project) AND ("execution_events"."execution_domain" = domain) ` +
+			`AND ("execution_events"."execution_name" = 1)) ORDER BY occurred_at asc, id asc`).WithReply(
+		[]map[string]interface{}{
+			{"execution_project": "project", "execution_domain": "domain", "execution_name": "1", "phase": "RUNNING"},
+			{"execution_project": "project", "execution_domain": "domain", "execution_name": "1", "phase": "SUCCEEDED"},
+		})
+	events, err := execEventRepo.ListByExecution(context.Background(), interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "1",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "RUNNING", events[0].Phase)
+	assert.Equal(t, "SUCCEEDED", events[1].Phase)
+}
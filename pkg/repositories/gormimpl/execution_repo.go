@@ -18,6 +18,7 @@ import (
 // Implementation of ExecutionInterface.
 type ExecutionRepo struct {
 	db               *gorm.DB
+	reader           readRouter
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -35,7 +36,7 @@ func (r *ExecutionRepo) Create(ctx context.Context, input models.Execution) erro
 func (r *ExecutionRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
 	var execution models.Execution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Execution{
+	tx := r.reader.db(ctx).Where(&models.Execution{
 		ExecutionKey: models.ExecutionKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -58,12 +59,21 @@ func (r *ExecutionRepo) Get(ctx context.Context, input interfaces.Identifier) (m
 
 func (r *ExecutionRepo) Update(ctx context.Context, execution models.Execution) error {
 	timer := r.metrics.UpdateDuration.Start()
-	tx := r.db.Model(&execution).Updates(execution)
-	timer.Stop()
-	if err := tx.Error; err != nil {
-		return r.errorTransformer.ToFlyteAdminError(err)
-	}
-	return nil
+	defer timer.Stop()
+	return writeIfUnmodified(r.db, r.errorTransformer, "execution", &execution, &execution.StateVersion,
+		func(tx *gorm.DB) *gorm.DB {
+			return clearOffloadedClosure(tx.Model(&execution).Updates(execution), execution.ClosureReference)
+		}, nil)
+}
+
+func (r *ExecutionRepo) UpdateWithOutboxEvent(
+	ctx context.Context, execution models.Execution, event models.OutboxEvent) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	return writeIfUnmodified(r.db, r.errorTransformer, "execution", &execution, &execution.StateVersion,
+		func(tx *gorm.DB) *gorm.DB {
+			return clearOffloadedClosure(tx.Model(&execution).Updates(execution), execution.ClosureReference)
+		}, &event)
 }
 
 func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
@@ -73,7 +83,7 @@ func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceI
 		return interfaces.ExecutionCollectionOutput{}, err
 	}
 	var executions []models.Execution
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := r.reader.db(ctx).Limit(input.Limit).Offset(input.Offset)
 	// And add join condition as required by user-specified filters (which can potentially include join table attrs).
 	if ok := input.JoinTableEntities[common.LaunchPlan]; ok {
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.launch_plan_id = %s.id",
@@ -114,7 +124,7 @@ func (r *ExecutionRepo) Exists(ctx context.Context, input interfaces.Identifier)
 	var execution models.Execution
 	timer := r.metrics.ExistsDuration.Start()
 	// Only select the id field (uint) to check for existence.
-	tx := r.db.Select(ID).Where(&models.Execution{
+	tx := r.reader.db(ctx).Select(ID).Where(&models.Execution{
 		ExecutionKey: models.ExecutionKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -134,6 +144,7 @@ func NewExecutionRepo(
 	metrics := newMetrics(scope)
 	return &ExecutionRepo{
 		db:               db,
+		reader:           newReadRouter(db, scope),
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
@@ -13,10 +13,12 @@ import (
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/flyteorg/flyteadmin/pkg/common"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 var createdAt = time.Date(2018, time.February, 17, 00, 00, 00, 00, time.UTC).UTC()
@@ -44,11 +46,14 @@ func TestCreateExecution(t *testing.T) {
 func TestUpdateExecution(t *testing.T) {
 	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 	GlobalMock := mocket.Catcher.Reset()
+	stateVersionQuery := GlobalMock.NewMock().WithQuery(
+		`UPDATE "executions" SET "state_version" = ?  WHERE "executions"."deleted_at" IS NULL AND ` +
+			`((state_version = ?))`).WithRowsNum(1)
 	executionQuery := GlobalMock.NewMock()
 	executionQuery.WithQuery(`UPDATE "executions" SET "closure" = ?, "duration" = ?, "execution_created_at" = ?, ` +
 		`"execution_domain" = ?, "execution_name" = ?, "execution_project" = ?, "execution_updated_at" = ?, ` +
-		`"launch_plan_id" = ?, "phase" = ?, "spec" = ?, "started_at" = ?, "updated_at" = ?, "workflow_id" = ?  ` +
-		`WHERE "executions"."deleted_at" IS NULL`)
+		`"launch_plan_id" = ?, "phase" = ?, "spec" = ?, "started_at" = ?, "state_version" = ?, "updated_at" = ?, ` +
+		`"workflow_id" = ?  WHERE "executions"."deleted_at" IS NULL`)
 	err := executionRepo.Update(context.Background(),
 		models.Execution{
 			ExecutionKey: models.ExecutionKey{
@@ -67,9 +72,64 @@ func TestUpdateExecution(t *testing.T) {
 			Duration:           time.Hour,
 		})
 	assert.NoError(t, err)
+	assert.True(t, stateVersionQuery.Triggered)
 	assert.True(t, executionQuery.Triggered)
 }
 
+func TestUpdateExecution_ConcurrentUpdate(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	// The state version was incremented by another update since the execution was read, so no row matches.
+	GlobalMock.NewMock().WithQuery(`UPDATE "executions" SET "state_version" = ?`).WithRowsNum(0)
+	executionQuery := GlobalMock.NewMock().WithQuery(`UPDATE "executions" SET "phase" = ?`)
+	err := executionRepo.Update(context.Background(),
+		models.Execution{
+			BaseModel: models.BaseModel{
+				ID: 1,
+			},
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "1",
+			},
+			Phase:        core.WorkflowExecution_SUCCEEDED.String(),
+			StateVersion: 3,
+		})
+	assert.Equal(t, codes.Aborted, err.(adminErrors.FlyteAdminError).Code())
+	assert.False(t, executionQuery.Triggered)
+}
+
+func TestUpdateExecutionWithOffloadedClosure(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(`UPDATE "executions" SET "state_version" = ?`).WithRowsNum(1)
+	updateQuery := GlobalMock.NewMock().WithQuery(
+		`UPDATE "executions" SET "closure_reference" = ?, "execution_domain" = ?, "execution_name" = ?, ` +
+			`"execution_project" = ?, "id" = ?, "phase" = ?, "state_version" = ?, "updated_at" = ?  ` +
+			`WHERE "executions"."deleted_at" IS NULL`)
+	clearClosureQuery := GlobalMock.NewMock().WithQuery(
+		`UPDATE "executions" SET "closure" = ?  WHERE "executions"."deleted_at" IS NULL AND ` +
+			`"executions"."execution_project" = ? AND "executions"."execution_domain" = ? AND ` +
+			`"executions"."execution_name" = ?`)
+	err := executionRepo.Update(context.Background(),
+		models.Execution{
+			BaseModel: models.BaseModel{
+				ID: 1,
+			},
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "1",
+			},
+			Phase:            core.WorkflowExecution_SUCCEEDED.String(),
+			ClosureReference: "s3://bucket/closures/executions/project/domain/1",
+		})
+	assert.NoError(t, err)
+	assert.True(t, updateQuery.Triggered)
+	assert.True(t, clearClosureQuery.Triggered)
+}
+
 func getMockExecutionResponseFromDb(expected models.Execution) map[string]interface{} {
 	execution := make(map[string]interface{})
 	execution["id"] = expected.ID
@@ -23,6 +23,7 @@ type launchPlanMetrics struct {
 // Implementation of LaunchPlanRepoInterface.
 type LaunchPlanRepo struct {
 	db                *gorm.DB
+	reader            readRouter
 	errorTransformer  errors.ErrorTransformer
 	metrics           gormMetrics
 	launchPlanMetrics launchPlanMetrics
@@ -51,7 +52,7 @@ func (r *LaunchPlanRepo) Update(ctx context.Context, input models.LaunchPlan) er
 func (r *LaunchPlanRepo) Get(ctx context.Context, input interfaces.Identifier) (models.LaunchPlan, error) {
 	var launchPlan models.LaunchPlan
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.LaunchPlan{
+	tx := r.reader.db(ctx).Where(&models.LaunchPlan{
 		LaunchPlanKey: models.LaunchPlanKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -113,7 +114,7 @@ func (r *LaunchPlanRepo) List(ctx context.Context, input interfaces.ListResource
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 	var launchPlans []models.LaunchPlan
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := r.reader.db(ctx).Limit(input.Limit).Offset(input.Offset)
 
 	// Add join conditions
 	tx = tx.Joins("inner join workflows on launch_plans.workflow_id = workflows.id")
@@ -150,7 +151,7 @@ func (r *LaunchPlanRepo) ListLaunchPlanIdentifiers(ctx context.Context, input in
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
+	tx := r.reader.db(ctx).Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -189,6 +190,7 @@ func NewLaunchPlanRepo(
 
 	return &LaunchPlanRepo{
 		db:                db,
+		reader:            newReadRouter(db, scope),
 		errorTransformer:  errorTransformer,
 		metrics:           metrics,
 		launchPlanMetrics: launchPlanMetrics,
@@ -107,6 +107,7 @@ func getNamedEntityFilters(resourceType core.ResourceType, project string, domai
 // Implementation of NamedEntityRepoInterface.
 type NamedEntityRepo struct {
 	db               *gorm.DB
+	reader           readRouter
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -143,7 +144,7 @@ func (r *NamedEntityRepo) Get(ctx context.Context, input interfaces.GetNamedEnti
 		return models.NamedEntity{}, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "Cannot get NamedEntityMetadata for resource type: %v", input.ResourceType)
 	}
 
-	tx := r.db.Table(tableName).Joins(joinString)
+	tx := r.reader.db(ctx).Table(tableName).Joins(joinString)
 
 	// Apply filters
 	tx, err = applyScopedFilters(tx, filters, nil)
@@ -182,7 +183,7 @@ func (r *NamedEntityRepo) List(ctx context.Context, input interfaces.ListNamedEn
 			"Cannot list entity names for resource type: %v", input.ResourceType)
 	}
 
-	tx := getSubQueryJoin(r.db, tableName, input)
+	tx := getSubQueryJoin(r.reader.db(ctx), tableName, input)
 
 	// Apply filters
 	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
@@ -218,6 +219,7 @@ func NewNamedEntityRepo(
 
 	return &NamedEntityRepo{
 		db:               db,
+		reader:           newReadRouter(db, scope),
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
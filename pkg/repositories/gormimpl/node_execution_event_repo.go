@@ -14,6 +14,7 @@ type NodeExecutionEventRepo struct {
 	db               *gorm.DB
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
+	reader           readRouter
 }
 
 func (r *NodeExecutionEventRepo) Create(ctx context.Context, input models.NodeExecutionEvent) error {
@@ -26,6 +27,42 @@ func (r *NodeExecutionEventRepo) Create(ctx context.Context, input models.NodeEx
 	return nil
 }
 
+func (r *NodeExecutionEventRepo) BatchCreate(ctx context.Context, inputs []models.NodeExecutionEvent) error {
+	records := make([]interface{}, len(inputs))
+	for i := range inputs {
+		records[i] = &inputs[i]
+	}
+	timer := r.metrics.CreateDuration.Start()
+	err := insertBatch(r.db, records)
+	timer.Stop()
+	if err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *NodeExecutionEventRepo) ListByExecution(
+	ctx context.Context, executionID interfaces.Identifier, nodeID string) ([]models.NodeExecutionEvent, error) {
+	var events []models.NodeExecutionEvent
+	timer := r.metrics.ListDuration.Start()
+	// The node id is left out of the query when it's empty.
+	tx := r.reader.db(ctx).Where(&models.NodeExecutionEvent{
+		NodeExecutionKey: models.NodeExecutionKey{
+			ExecutionKey: models.ExecutionKey{
+				Project: executionID.Project,
+				Domain:  executionID.Domain,
+				Name:    executionID.Name,
+			},
+			NodeID: nodeID,
+		},
+	}).Order(eventTimelineOrder).Find(&events)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return events, nil
+}
+
 // Returns an instance of NodeExecutionRepoInterface
 func NewNodeExecutionEventRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.NodeExecutionEventRepoInterface {
@@ -34,5 +71,6 @@ func NewNodeExecutionEventRepo(
 		db:               db,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
+		reader:           newReadRouter(db, scope),
 	}
 }
@@ -6,6 +6,7 @@ import (
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
@@ -34,3 +35,73 @@ func TestCreateNodeExecutionEvent(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, nodeExecutionEventQuery.Triggered)
 }
+
+func TestBatchCreateNodeExecutionEvents(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	nodeExecutionEventQuery := GlobalMock.NewMock()
+	nodeExecutionEventQuery.WithQuery(`INSERT INTO "node_execution_events" ("created_at","updated_at",` +
+		`"deleted_at","execution_project","execution_domain","execution_name","node_id","request_id","occurred_at",` +
+		`"phase") VALUES (?,?,?,?,?,?,?,?,?,?)`)
+	nodeExecEventRepo := NewNodeExecutionEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := nodeExecEventRepo.BatchCreate(context.Background(), []models.NodeExecutionEvent{
+		{
+			NodeExecutionKey: models.NodeExecutionKey{
+				NodeID: "1",
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "1",
+				},
+			},
+			RequestID:  "xxyzz",
+			Phase:      nodePhase,
+			OccurredAt: nodeStartedAt,
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, nodeExecutionEventQuery.Triggered)
+
+	// Nothing is written for an empty batch.
+	GlobalMock = mocket.Catcher.Reset()
+	nodeExecutionEventQuery = GlobalMock.NewMock()
+	nodeExecutionEventQuery.WithQuery(`INSERT INTO "node_execution_events"`)
+	assert.NoError(t, nodeExecEventRepo.BatchCreate(context.Background(), nil))
+	assert.False(t, nodeExecutionEventQuery.Triggered)
+}
+
+func TestListNodeExecutionEventsByExecution(t *testing.T) {
+	nodeExecEventRepo := NewNodeExecutionEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "node_execution_events"  WHERE "node_execution_events"."deleted_at" IS NULL AND ` +
+			`(("node_execution_events"."execution_project" = project) AND ("node_execution_events"."execution_domain" ` +
+			`= domain) AND ("node_execution_events"."execution_name" = 1) AND ("node_execution_events"."node_id" = n0)) ` +
+			`ORDER BY occurred_at asc, id asc`).WithReply(
+		[]map[string]interface{}{
+			{"node_id": "n0", "phase": "QUEUED"},
+			{"node_id": "n0", "phase": "RUNNING"},
+		})
+	events, err := nodeExecEventRepo.ListByExecution(context.Background(), interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "1",
+	}, "n0")
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "QUEUED", events[0].Phase)
+	assert.Equal(t, "RUNNING", events[1].Phase)
+
+	// Events of all nodes are listed when no node is set.
+	GlobalMock = mocket.Catcher.Reset()
+	query := GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "node_execution_events"  WHERE "node_execution_events"."deleted_at" IS NULL AND ` +
+			`(("node_execution_events"."execution_project" = project) AND ("node_execution_events"."execution_domain" ` +
+			`= domain) AND ("node_execution_events"."execution_name" = 1)) ORDER BY occurred_at asc, id asc`)
+	_, err = nodeExecEventRepo.ListByExecution(context.Background(), interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "1",
+	}, "")
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
@@ -17,6 +17,7 @@ import (
 // Implementation of NodeExecutionInterface.
 type NodeExecutionRepo struct {
 	db               *gorm.DB
+	reader           readRouter
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -31,10 +32,19 @@ func (r *NodeExecutionRepo) Create(ctx context.Context, execution *models.NodeEx
 	return nil
 }
 
+func (r *NodeExecutionRepo) CreateWithOutboxEvent(
+	ctx context.Context, execution *models.NodeExecution, event models.OutboxEvent) error {
+	timer := r.metrics.CreateDuration.Start()
+	defer timer.Stop()
+	return writeWithOutboxEvent(r.db, r.errorTransformer, func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(&execution)
+	}, event)
+}
+
 func (r *NodeExecutionRepo) Get(ctx context.Context, input interfaces.NodeExecutionResource) (models.NodeExecution, error) {
 	var nodeExecution models.NodeExecution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.NodeExecution{
+	tx := r.reader.db(ctx).Where(&models.NodeExecution{
 		NodeExecutionKey: models.NodeExecutionKey{
 			NodeID: input.NodeExecutionIdentifier.NodeId,
 			ExecutionKey: models.ExecutionKey{
@@ -64,12 +74,21 @@ func (r *NodeExecutionRepo) Get(ctx context.Context, input interfaces.NodeExecut
 
 func (r *NodeExecutionRepo) Update(ctx context.Context, nodeExecution *models.NodeExecution) error {
 	timer := r.metrics.UpdateDuration.Start()
-	tx := r.db.Model(&nodeExecution).Updates(nodeExecution)
-	timer.Stop()
-	if err := tx.Error; err != nil {
-		return r.errorTransformer.ToFlyteAdminError(err)
-	}
-	return nil
+	defer timer.Stop()
+	return writeIfUnmodified(r.db, r.errorTransformer, "node execution", nodeExecution, &nodeExecution.StateVersion,
+		func(tx *gorm.DB) *gorm.DB {
+			return clearOffloadedClosure(tx.Model(nodeExecution).Updates(nodeExecution), nodeExecution.ClosureReference)
+		}, nil)
+}
+
+func (r *NodeExecutionRepo) UpdateWithOutboxEvent(
+	ctx context.Context, nodeExecution *models.NodeExecution, event models.OutboxEvent) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	return writeIfUnmodified(r.db, r.errorTransformer, "node execution", nodeExecution, &nodeExecution.StateVersion,
+		func(tx *gorm.DB) *gorm.DB {
+			return clearOffloadedClosure(tx.Model(nodeExecution).Updates(nodeExecution), nodeExecution.ClosureReference)
+		}, &event)
 }
 
 func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
@@ -79,7 +98,7 @@ func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 		return interfaces.NodeExecutionCollectionOutput{}, err
 	}
 	var nodeExecutions []models.NodeExecution
-	tx := r.db.Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecutions")
+	tx := r.reader.db(ctx).Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecutions")
 	// And add join condition (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// (this query isn't called for deletes).
 	tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.execution_project = %s.execution_project AND "+
@@ -115,7 +134,7 @@ func (r *NodeExecutionRepo) ListEvents(
 		return interfaces.NodeExecutionEventCollectionOutput{}, err
 	}
 	var nodeExecutionEvents []models.NodeExecutionEvent
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := r.reader.db(ctx).Limit(input.Limit).Offset(input.Offset)
 	// And add join condition (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// (this query isn't called for deletes).
 	tx = tx.Joins(innerJoinNodeExecToNodeEvents)
@@ -145,7 +164,7 @@ func (r *NodeExecutionRepo) ListEvents(
 func (r *NodeExecutionRepo) Exists(ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
 	var nodeExecution models.NodeExecution
 	timer := r.metrics.ExistsDuration.Start()
-	tx := r.db.Select(ID).Where(&models.NodeExecution{
+	tx := r.reader.db(ctx).Select(ID).Where(&models.NodeExecution{
 		NodeExecutionKey: models.NodeExecutionKey{
 			NodeID: input.NodeExecutionIdentifier.NodeId,
 			ExecutionKey: models.ExecutionKey{
@@ -169,6 +188,7 @@ func NewNodeExecutionRepo(
 	metrics := newMetrics(scope)
 	return &NodeExecutionRepo{
 		db:               db,
+		reader:           newReadRouter(db, scope),
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
@@ -12,10 +12,12 @@ import (
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/flyteorg/flyteadmin/pkg/common"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 var nodePhase = core.NodeExecution_RUNNING.String()
@@ -33,8 +35,8 @@ func TestCreateNodeExecution(t *testing.T) {
 	nodeExecutionQuery.WithQuery(`INSERT INTO "node_executions" ("id","created_at","updated_at","deleted_at",` +
 		`"execution_project","execution_domain","execution_name","node_id","phase","input_uri","closure","started_at",` +
 		`"node_execution_created_at","node_execution_updated_at","duration","node_execution_metadata","parent_id",` +
-		`"error_kind","error_code","cache_status","dynamic_workflow_remote_closure_reference") ` +
-		`VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+		`"error_kind","error_code","cache_status","dynamic_workflow_remote_closure_reference","closure_reference",` +
+		`"state_version") VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
 
 	parentID := uint(10)
 	nodeExecution := models.NodeExecution{
@@ -67,12 +69,17 @@ func TestCreateNodeExecution(t *testing.T) {
 func TestUpdateNodeExecution(t *testing.T) {
 	nodeExecutionRepo := NewNodeExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 	GlobalMock := mocket.Catcher.Reset()
+	stateVersionQuery := GlobalMock.NewMock().WithQuery(
+		`UPDATE "node_executions" SET "state_version" = ?  WHERE "node_executions"."deleted_at" IS NULL AND ` +
+			`"node_executions"."execution_project" = ? AND "node_executions"."execution_domain" = ? AND ` +
+			`"node_executions"."execution_name" = ? AND "node_executions"."node_id" = ? AND ((state_version = ?))`).
+		WithRowsNum(1)
 	// Only match on queries that append the name filter
 	nodeExecutionQuery := GlobalMock.NewMock()
 	nodeExecutionQuery.WithQuery(`UPDATE "node_executions" SET "closure" = ?, "duration" = ?, ` +
 		`"execution_domain" = ?, "execution_name" = ?, "execution_project" = ?, "id" = ?, "input_uri" = ?, ` +
 		`"node_execution_created_at" = ?, "node_execution_updated_at" = ?, "node_id" = ?, "phase" = ?, ` +
-		`"started_at" = ?, "updated_at" = ?  WHERE "node_executions"."deleted_at" IS NULL AND "node_executions".` +
+		`"started_at" = ?, "state_version" = ?, "updated_at" = ?  WHERE "node_executions"."deleted_at" IS NULL AND "node_executions".` +
 		`"execution_project" = ? AND "node_executions"."execution_domain" = ? AND "node_executions".` +
 		`"execution_name" = ? AND "node_executions"."node_id" = ?`)
 	err := nodeExecutionRepo.Update(context.Background(),
@@ -95,9 +102,36 @@ func TestUpdateNodeExecution(t *testing.T) {
 			NodeExecutionUpdatedAt: &nodePlanUpdatedAt,
 		})
 	assert.NoError(t, err)
+	assert.True(t, stateVersionQuery.Triggered)
 	assert.True(t, nodeExecutionQuery.Triggered)
 }
 
+func TestUpdateNodeExecution_ConcurrentUpdate(t *testing.T) {
+	nodeExecutionRepo := NewNodeExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "node_executions" SET "state_version" = ?`).WithRowsNum(0)
+	nodeExecutionQuery := GlobalMock.NewMock().WithQuery(`UPDATE "node_executions" SET "closure" = ?`)
+	nodeExecution := &models.NodeExecution{
+		BaseModel: models.BaseModel{ID: 1},
+		NodeExecutionKey: models.NodeExecutionKey{
+			NodeID: "1",
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "1",
+			},
+		},
+		Phase:        nodePhase,
+		Closure:      []byte("closure"),
+		StateVersion: 3,
+	}
+	err := nodeExecutionRepo.Update(context.Background(), nodeExecution)
+	assert.Equal(t, codes.Aborted, err.(adminErrors.FlyteAdminError).Code())
+	assert.False(t, nodeExecutionQuery.Triggered)
+	// Node executions are updated by pointer, so the version the failed update read at is kept for the caller.
+	assert.Equal(t, uint32(3), nodeExecution.StateVersion)
+}
+
 func getMockNodeExecutionResponseFromDb(expected models.NodeExecution) map[string]interface{} {
 	nodeExecution := make(map[string]interface{})
 	nodeExecution["execution_project"] = expected.ExecutionKey.Project
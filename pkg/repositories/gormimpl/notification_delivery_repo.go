@@ -0,0 +1,103 @@
+package gormimpl
+
+import (
+	"context"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+const createdAtAscending = "created_at asc"
+
+// Implementation of NotificationDeliveryRepoInterface.
+type NotificationDeliveryRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *NotificationDeliveryRepo) Create(ctx context.Context, input *models.NotificationDelivery) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationDeliveryRepo) Update(ctx context.Context, input models.NotificationDelivery) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Save(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationDeliveryRepo) Get(ctx context.Context, id uint) (models.NotificationDelivery, error) {
+	var delivery models.NotificationDelivery
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where(&models.NotificationDelivery{ID: id}).Take(&delivery)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.NotificationDelivery{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"notification delivery [%d] not found", id)
+	}
+	if tx.Error != nil {
+		return models.NotificationDelivery{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return delivery, nil
+}
+
+func (r *NotificationDeliveryRepo) GetQueuedByDigest(ctx context.Context, digest string) (
+	models.NotificationDelivery, error) {
+	var delivery models.NotificationDelivery
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where(&models.NotificationDelivery{
+		MessageDigest: digest,
+		Status:        models.NotificationDeliveryQueued,
+	}).Order(createdAtAscending).First(&delivery)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.NotificationDelivery{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"no queued notification delivery found for digest [%s]", digest)
+	}
+	if tx.Error != nil {
+		return models.NotificationDelivery{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return delivery, nil
+}
+
+func (r *NotificationDeliveryRepo) ListByExecution(ctx context.Context, executionID interfaces.Identifier) (
+	[]models.NotificationDelivery, error) {
+	var deliveries []models.NotificationDelivery
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Where(&models.NotificationDelivery{
+		ExecutionProject: executionID.Project,
+		ExecutionDomain:  executionID.Domain,
+		ExecutionName:    executionID.Name,
+	}).Order(createdAtAscending).Find(&deliveries)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return deliveries, nil
+}
+
+// Returns an instance of NotificationDeliveryRepoInterface
+func NewNotificationDeliveryRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.NotificationDeliveryRepoInterface {
+	metrics := newMetrics(scope)
+	return &NotificationDeliveryRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
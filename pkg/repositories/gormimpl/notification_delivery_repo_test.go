@@ -0,0 +1,118 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func getMockNotificationDeliveryResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"id":                1,
+		"execution_project": "project",
+		"execution_domain":  "domain",
+		"execution_name":    "name",
+		"channel":           "email",
+		"recipients":        "a@example.com",
+		"message_digest":    "digest",
+		"status":            models.NotificationDeliveryQueued,
+	}
+}
+
+func TestCreateNotificationDelivery(t *testing.T) {
+	deliveryRepo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "notification_deliveries" ("created_at","updated_at","deleted_at",` +
+		`"execution_project","execution_domain","execution_name","channel","recipients","message",` +
+		`"message_digest","status","attempts","error","last_attempt_at") VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+
+	err := deliveryRepo.Create(context.Background(), &models.NotificationDelivery{
+		ExecutionProject: "project",
+		ExecutionDomain:  "domain",
+		ExecutionName:    "name",
+		Channel:          "email",
+		Status:           models.NotificationDeliveryQueued,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestUpdateNotificationDelivery(t *testing.T) {
+	deliveryRepo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "notification_deliveries" SET`)
+
+	err := deliveryRepo.Update(context.Background(), models.NotificationDelivery{
+		ID:       1,
+		Status:   models.NotificationDeliveryFailed,
+		Attempts: 3,
+		Error:    "oops",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestGetNotificationDelivery(t *testing.T) {
+	deliveryRepo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "notification_deliveries"  WHERE ` +
+		`"notification_deliveries"."deleted_at" IS NULL AND (("notification_deliveries"."id" = 1)) LIMIT 1`).WithReply(
+		[]map[string]interface{}{getMockNotificationDeliveryResponse()})
+
+	delivery, err := deliveryRepo.Get(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), delivery.ID)
+	assert.Equal(t, "name", delivery.ExecutionName)
+	assert.Equal(t, "a@example.com", delivery.Recipients)
+}
+
+func TestGetNotificationDelivery_NotFound(t *testing.T) {
+	deliveryRepo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := deliveryRepo.Get(context.Background(), 1)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestGetQueuedNotificationDeliveryByDigest(t *testing.T) {
+	deliveryRepo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "notification_deliveries"  WHERE ` +
+		`"notification_deliveries"."deleted_at" IS NULL AND (("notification_deliveries"."message_digest" = digest) ` +
+		`AND ("notification_deliveries"."status" = QUEUED)) ORDER BY created_at asc,` +
+		`"notification_deliveries"."id" ASC LIMIT 1`).WithReply(
+		[]map[string]interface{}{getMockNotificationDeliveryResponse()})
+
+	delivery, err := deliveryRepo.GetQueuedByDigest(context.Background(), "digest")
+	assert.NoError(t, err)
+	assert.Equal(t, "digest", delivery.MessageDigest)
+}
+
+func TestListNotificationDeliveriesByExecution(t *testing.T) {
+	deliveryRepo := NewNotificationDeliveryRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "notification_deliveries"  WHERE ` +
+		`"notification_deliveries"."deleted_at" IS NULL AND (("notification_deliveries"."execution_project" = project) ` +
+		`AND ("notification_deliveries"."execution_domain" = domain) AND ` +
+		`("notification_deliveries"."execution_name" = name)) ORDER BY created_at asc`).WithReply(
+		[]map[string]interface{}{getMockNotificationDeliveryResponse(), getMockNotificationDeliveryResponse()})
+
+	deliveries, err := deliveryRepo.ListByExecution(context.Background(), interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 2)
+}
@@ -0,0 +1,113 @@
+package gormimpl
+
+import (
+	"context"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Implementation of NotificationSubscriptionRepoInterface.
+type NotificationSubscriptionRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *NotificationSubscriptionRepo) Create(ctx context.Context, input *models.NotificationSubscription) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationSubscriptionRepo) Update(ctx context.Context, input models.NotificationSubscription) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Save(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationSubscriptionRepo) Get(ctx context.Context, id uint) (models.NotificationSubscription, error) {
+	var subscription models.NotificationSubscription
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where(&models.NotificationSubscription{ID: id}).Take(&subscription)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.NotificationSubscription{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"notification subscription [%d] not found", id)
+	}
+	if tx.Error != nil {
+		return models.NotificationSubscription{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return subscription, nil
+}
+
+func (r *NotificationSubscriptionRepo) Delete(ctx context.Context, id uint) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.Where("id = ?", id).Delete(&models.NotificationSubscription{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "notification subscription [%d] not found", id)
+	}
+	return nil
+}
+
+func (r *NotificationSubscriptionRepo) List(ctx context.Context, scope interfaces.NotificationSubscriptionScope) (
+	[]models.NotificationSubscription, error) {
+	var subscriptions []models.NotificationSubscription
+	timer := r.metrics.ListDuration.Start()
+	// Empty struct fields are ignored by gorm, which leaves the workflow and launch plan unrestricted when unset.
+	tx := r.db.Where(&models.NotificationSubscription{
+		Project:    scope.Project,
+		Domain:     scope.Domain,
+		Workflow:   scope.Workflow,
+		LaunchPlan: scope.LaunchPlan,
+	}).Order(createdAtAscending).Find(&subscriptions)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return subscriptions, nil
+}
+
+func (r *NotificationSubscriptionRepo) ListMatching(ctx context.Context, scope interfaces.NotificationSubscriptionScope) (
+	[]models.NotificationSubscription, error) {
+	var subscriptions []models.NotificationSubscription
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Where(&models.NotificationSubscription{
+		Project: scope.Project,
+		Domain:  scope.Domain,
+	}).Where("workflow IN (?)", []string{"", scope.Workflow}).Where(
+		"launch_plan IN (?)", []string{"", scope.LaunchPlan}).Order(createdAtAscending).Find(&subscriptions)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return subscriptions, nil
+}
+
+// Returns an instance of NotificationSubscriptionRepoInterface
+func NewNotificationSubscriptionRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.NotificationSubscriptionRepoInterface {
+	metrics := newMetrics(scope)
+	return &NotificationSubscriptionRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
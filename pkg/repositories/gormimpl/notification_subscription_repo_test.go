@@ -0,0 +1,145 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func getMockNotificationSubscriptionResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         1,
+		"project":    "project",
+		"domain":     "domain",
+		"workflow":   "workflow",
+		"channel":    "email",
+		"recipient":  "a@example.com",
+		"phases":     "FAILED,TIMED_OUT",
+		"created_by": "user",
+	}
+}
+
+func TestCreateNotificationSubscription(t *testing.T) {
+	subscriptionRepo := NewNotificationSubscriptionRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "notification_subscriptions" ("created_at","updated_at","project","domain",` +
+		`"workflow","launch_plan","channel","recipient","phases","created_by") VALUES (?,?,?,?,?,?,?,?,?,?)`)
+
+	err := subscriptionRepo.Create(context.Background(), &models.NotificationSubscription{
+		Project:   "project",
+		Domain:    "domain",
+		Channel:   "email",
+		Recipient: "a@example.com",
+		Phases:    "FAILED",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestUpdateNotificationSubscription(t *testing.T) {
+	subscriptionRepo := NewNotificationSubscriptionRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "notification_subscriptions" SET`)
+
+	err := subscriptionRepo.Update(context.Background(), models.NotificationSubscription{
+		ID:     1,
+		Phases: "SUCCEEDED",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestGetNotificationSubscription(t *testing.T) {
+	subscriptionRepo := NewNotificationSubscriptionRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "notification_subscriptions"  WHERE ` +
+		`("notification_subscriptions"."id" = 1) LIMIT 1`).WithReply(
+		[]map[string]interface{}{getMockNotificationSubscriptionResponse()})
+
+	subscription, err := subscriptionRepo.Get(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), subscription.ID)
+	assert.Equal(t, "a@example.com", subscription.Recipient)
+	assert.Equal(t, "FAILED,TIMED_OUT", subscription.Phases)
+}
+
+func TestGetNotificationSubscription_NotFound(t *testing.T) {
+	subscriptionRepo := NewNotificationSubscriptionRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := subscriptionRepo.Get(context.Background(), 1)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteNotificationSubscription(t *testing.T) {
+	subscriptionRepo := NewNotificationSubscriptionRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "notification_subscriptions"  WHERE (id = ?)`).WithRowsNum(1)
+
+	assert.NoError(t, subscriptionRepo.Delete(context.Background(), 1))
+	assert.True(t, query.Triggered)
+}
+
+func TestDeleteNotificationSubscription_NotFound(t *testing.T) {
+	subscriptionRepo := NewNotificationSubscriptionRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`DELETE FROM "notification_subscriptions"`).WithRowsNum(0)
+
+	err := subscriptionRepo.Delete(context.Background(), 1)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestListNotificationSubscriptions(t *testing.T) {
+	subscriptionRepo := NewNotificationSubscriptionRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "notification_subscriptions"  WHERE ` +
+		`("notification_subscriptions"."project" = project) AND ("notification_subscriptions"."domain" = domain) ` +
+		`AND ("notification_subscriptions"."workflow" = workflow) ORDER BY created_at asc`).WithReply(
+		[]map[string]interface{}{getMockNotificationSubscriptionResponse()})
+
+	subscriptions, err := subscriptionRepo.List(context.Background(), interfaces.NotificationSubscriptionScope{
+		Project:  "project",
+		Domain:   "domain",
+		Workflow: "workflow",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, subscriptions, 1)
+	assert.Equal(t, "workflow", subscriptions[0].Workflow)
+}
+
+func TestListMatchingNotificationSubscriptions(t *testing.T) {
+	subscriptionRepo := NewNotificationSubscriptionRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "notification_subscriptions"  WHERE ` +
+		`("notification_subscriptions"."project" = project) AND ("notification_subscriptions"."domain" = domain) ` +
+		`AND (workflow IN (,workflow)) AND (launch_plan IN (,launch_plan)) ORDER BY created_at asc`).WithReply(
+		[]map[string]interface{}{getMockNotificationSubscriptionResponse()})
+
+	subscriptions, err := subscriptionRepo.ListMatching(context.Background(), interfaces.NotificationSubscriptionScope{
+		Project:    "project",
+		Domain:     "domain",
+		Workflow:   "workflow",
+		LaunchPlan: "launch_plan",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, subscriptions, 1)
+}
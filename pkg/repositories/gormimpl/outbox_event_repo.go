@@ -0,0 +1,147 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Implementation of OutboxEventRepoInterface.
+type OutboxEventRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *OutboxEventRepo) ListPending(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	var events []models.OutboxEvent
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Where("published_at IS NULL AND dead_lettered_at IS NULL").Order("id asc").Limit(limit).Find(&events)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return events, nil
+}
+
+func (r *OutboxEventRepo) MarkPublished(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.OutboxEvent{}).Where("id IN (?)", ids).Update("published_at", time.Now())
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OutboxEventRepo) RecordFailure(ctx context.Context, id uint, errorMessage string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.OutboxEvent{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"attempts":   gorm.Expr("attempts + 1"),
+		"last_error": errorMessage,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OutboxEventRepo) DeadLetter(ctx context.Context, id uint, errorMessage string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.OutboxEvent{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"attempts":         gorm.Expr("attempts + 1"),
+		"last_error":       errorMessage,
+		"dead_lettered_at": time.Now(),
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OutboxEventRepo) DeletePublished(ctx context.Context, before time.Time) (int64, error) {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.Where("published_at < ?", before).Delete(&models.OutboxEvent{})
+	timer.Stop()
+	if tx.Error != nil {
+		return 0, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tx.RowsAffected, nil
+}
+
+func (r *OutboxEventRepo) AcquireLease(ctx context.Context, holder string, duration time.Duration) (bool, error) {
+	now := time.Now()
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.OutboxRelayLease{ID: models.OutboxRelayLeaseID}).
+		Where("holder = ? OR expires_at < ?", holder, now).
+		Updates(map[string]interface{}{
+			"holder":     holder,
+			"expires_at": now.Add(duration),
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected > 0 {
+		return true, nil
+	}
+
+	// Either another holder's lease is unexpired or no lease was taken yet.
+	timer = r.metrics.CreateDuration.Start()
+	tx = r.db.Create(&models.OutboxRelayLease{
+		ID:        models.OutboxRelayLeaseID,
+		Holder:    holder,
+		ExpiresAt: now.Add(duration),
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		err := r.errorTransformer.ToFlyteAdminError(tx.Error)
+		if adminErr, ok := err.(flyteAdminErrors.FlyteAdminError); ok && adminErr.Code() == codes.AlreadyExists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Performs the write and inserts the outbox event in a single transaction, so that the event is relayed if and only if
+// the write is committed.
+func writeWithOutboxEvent(db *gorm.DB, errorTransformer errors.ErrorTransformer, write func(tx *gorm.DB) *gorm.DB,
+	event models.OutboxEvent) error {
+	tx := db.Begin()
+	if err := write(tx).Error; err != nil {
+		tx.Rollback()
+		return errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Create(&event).Error; err != nil {
+		tx.Rollback()
+		return errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+// Returns an instance of OutboxEventRepoInterface
+func NewOutboxEventRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.OutboxEventRepoInterface {
+	metrics := newMetrics(scope)
+	return &OutboxEventRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
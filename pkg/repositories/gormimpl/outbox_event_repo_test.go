@@ -0,0 +1,192 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var testOutboxEvent = models.OutboxEvent{
+	EventType:    "flyteidl.admin.WorkflowExecutionEventRequest",
+	PartitionKey: "project/domain/name",
+	Payload:      []byte{1, 2},
+}
+
+func TestListPendingOutboxEvents(t *testing.T) {
+	outboxRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "outbox_events"  WHERE (published_at IS NULL AND dead_lettered_at IS NULL) ORDER BY id asc ` +
+			`LIMIT 10`).WithReply(
+		[]map[string]interface{}{
+			{"id": 1, "event_type": testOutboxEvent.EventType, "partition_key": "project/domain/name"},
+			{"id": 2, "event_type": testOutboxEvent.EventType, "partition_key": "project/domain/name"},
+		})
+
+	events, err := outboxRepo.ListPending(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, uint(1), events[0].ID)
+	assert.Equal(t, "project/domain/name", events[1].PartitionKey)
+}
+
+func TestMarkOutboxEventsPublished(t *testing.T) {
+	outboxRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "outbox_events" SET "published_at" = ?  WHERE (id IN (?,?))`)
+
+	assert.NoError(t, outboxRepo.MarkPublished(context.Background(), []uint{1, 2}))
+	assert.True(t, query.Triggered)
+}
+
+func TestMarkOutboxEventsPublished_NoEvents(t *testing.T) {
+	outboxRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "outbox_events"`)
+
+	assert.NoError(t, outboxRepo.MarkPublished(context.Background(), nil))
+	assert.False(t, query.Triggered)
+}
+
+func TestRecordOutboxEventFailure(t *testing.T) {
+	outboxRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "outbox_events" SET "attempts" = attempts + 1, "last_error" = ?  WHERE (id = ?)`)
+
+	assert.NoError(t, outboxRepo.RecordFailure(context.Background(), 1, "topic unavailable"))
+	assert.True(t, query.Triggered)
+}
+
+func TestDeadLetterOutboxEvent(t *testing.T) {
+	outboxRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "outbox_events" SET "attempts" = attempts + 1, "dead_lettered_at" = ?, ` +
+		`"last_error" = ?  WHERE (id = ?)`)
+
+	assert.NoError(t, outboxRepo.DeadLetter(context.Background(), 1, "topic unavailable"))
+	assert.True(t, query.Triggered)
+}
+
+func TestDeletePublishedOutboxEvents(t *testing.T) {
+	outboxRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "outbox_events"  WHERE (published_at < ?)`).WithRowsNum(3)
+
+	deleted, err := outboxRepo.DeletePublished(context.Background(), time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	assert.True(t, query.Triggered)
+}
+
+func TestAcquireOutboxRelayLease(t *testing.T) {
+	outboxRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	t.Run("Renew the lease", func(t *testing.T) {
+		GlobalMock := mocket.Catcher.Reset()
+		updateQuery := GlobalMock.NewMock()
+		updateQuery.WithQuery(`UPDATE "outbox_relay_leases" SET "expires_at" = ?, "holder" = ?  ` +
+			`WHERE "outbox_relay_leases"."id" = ? AND ((holder = ? OR expires_at < ?))`).WithRowsNum(1)
+		insertQuery := GlobalMock.NewMock()
+		insertQuery.WithQuery(`INSERT INTO "outbox_relay_leases"`)
+
+		acquired, err := outboxRepo.AcquireLease(context.Background(), "replica", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		assert.True(t, updateQuery.Triggered)
+		assert.False(t, insertQuery.Triggered)
+	})
+	t.Run("Take the first lease", func(t *testing.T) {
+		GlobalMock := mocket.Catcher.Reset()
+		GlobalMock.NewMock().WithQuery(`UPDATE "outbox_relay_leases"`).WithRowsNum(0)
+		insertQuery := GlobalMock.NewMock()
+		insertQuery.WithQuery(`INSERT INTO "outbox_relay_leases" ("id","holder","expires_at") VALUES (?,?,?)`)
+
+		acquired, err := outboxRepo.AcquireLease(context.Background(), "replica", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		assert.True(t, insertQuery.Triggered)
+	})
+	t.Run("Lease held by another replica", func(t *testing.T) {
+		outboxRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewPostgresErrorTransformer(mockScope.NewTestScope()),
+			mockScope.NewTestScope())
+		GlobalMock := mocket.Catcher.Reset()
+		GlobalMock.NewMock().WithQuery(`UPDATE "outbox_relay_leases"`).WithRowsNum(0)
+		GlobalMock.NewMock().WithQuery(`INSERT INTO "outbox_relay_leases"`).WithError(&pq.Error{Code: "23505"})
+
+		acquired, err := outboxRepo.AcquireLease(context.Background(), "replica", time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+	})
+}
+
+func TestUpdateExecutionWithOutboxEvent(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "executions" SET "state_version" = ?`).WithRowsNum(1)
+	updateQuery := GlobalMock.NewMock()
+	updateQuery.WithQuery(`UPDATE "executions" SET "id" = ?, "phase" = ?, "state_version" = ?`)
+	insertQuery := GlobalMock.NewMock()
+	insertQuery.WithQuery(`INSERT INTO "outbox_events" ("created_at","event_type","partition_key","payload",` +
+		`"published_at","attempts","last_error","dead_lettered_at") VALUES (?,?,?,?,?,?,?,?)`)
+
+	err := executionRepo.(*ExecutionRepo).UpdateWithOutboxEvent(context.Background(), models.Execution{
+		BaseModel: models.BaseModel{ID: 1},
+		Phase:     "SUCCEEDED",
+	}, testOutboxEvent)
+	assert.NoError(t, err)
+	assert.True(t, updateQuery.Triggered)
+	assert.True(t, insertQuery.Triggered)
+}
+
+func TestUpdateExecutionWithOutboxEvent_OutboxError(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`INSERT INTO "outbox_events"`).WithExecException()
+
+	err := executionRepo.UpdateWithOutboxEvent(context.Background(), models.Execution{
+		BaseModel: models.BaseModel{ID: 1},
+		Phase:     "SUCCEEDED",
+	}, testOutboxEvent)
+	assert.Error(t, err)
+}
+
+func TestCreateNodeExecutionWithOutboxEvent(t *testing.T) {
+	nodeExecutionRepo := NewNodeExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	insertQuery := GlobalMock.NewMock()
+	insertQuery.WithQuery(`INSERT INTO "outbox_events"`)
+
+	err := nodeExecutionRepo.CreateWithOutboxEvent(context.Background(), &models.NodeExecution{
+		NodeExecutionKey: models.NodeExecutionKey{NodeID: "node"},
+		Phase:            "RUNNING",
+	}, testOutboxEvent)
+	assert.NoError(t, err)
+	assert.True(t, insertQuery.Triggered)
+}
+
+func TestUpdateTaskExecutionWithOutboxEvent(t *testing.T) {
+	taskExecutionRepo := NewTaskExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "task_executions" SET "state_version" = ?`).WithRowsNum(1)
+	insertQuery := GlobalMock.NewMock()
+	insertQuery.WithQuery(`INSERT INTO "outbox_events"`)
+
+	err := taskExecutionRepo.UpdateWithOutboxEvent(context.Background(), models.TaskExecution{
+		BaseModel: models.BaseModel{ID: 1},
+		Phase:     "RUNNING",
+	}, testOutboxEvent)
+	assert.NoError(t, err)
+	assert.True(t, insertQuery.Triggered)
+}
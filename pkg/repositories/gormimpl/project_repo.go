@@ -18,6 +18,7 @@ import (
 
 type ProjectRepo struct {
 	db               *gorm.DB
+	reader           readRouter
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -35,7 +36,7 @@ func (r *ProjectRepo) Create(ctx context.Context, project models.Project) error
 func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project, error) {
 	var project models.Project
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Project{
+	tx := r.reader.db(ctx).Where(&models.Project{
 		Identifier: projectID,
 	}).Take(&project)
 	timer.Stop()
@@ -51,7 +52,7 @@ func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project
 func (r *ProjectRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error) {
 	var projects []models.Project
 
-	tx := r.db.Offset(input.Offset)
+	tx := r.reader.db(ctx).Offset(input.Offset)
 	if input.Limit != 0 {
 		tx = tx.Limit(input.Limit)
 	}
@@ -88,6 +89,7 @@ func NewProjectRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
 	metrics := newMetrics(scope)
 	return &ProjectRepo{
 		db:               db,
+		reader:           newReadRouter(db, scope),
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
@@ -0,0 +1,56 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The gorm setting under which the read replica of a database connection is kept.
+const readReplicaSetting = "flyteadmin:read_replica"
+
+// Returns a connection to the database which, when used to create repositories, routes their read queries to the
+// replica. Writes, and reads for contexts which require reading from the primary, are issued on the primary.
+func WithReadReplica(db *gorm.DB, replica *gorm.DB) *gorm.DB {
+	return db.Set(readReplicaSetting, replica)
+}
+
+type readRouterMetrics struct {
+	PrimaryReads prometheus.Counter
+	ReplicaReads prometheus.Counter
+}
+
+// Selects the database read queries are issued on.
+type readRouter struct {
+	primary *gorm.DB
+	replica *gorm.DB
+	metrics readRouterMetrics
+}
+
+// Returns the database to issue a read query on for the context.
+func (r *readRouter) db(ctx context.Context) *gorm.DB {
+	if r.replica == nil || interfaces.ReadFromPrimary(ctx) {
+		r.metrics.PrimaryReads.Inc()
+		return r.primary
+	}
+	r.metrics.ReplicaReads.Inc()
+	return r.replica
+}
+
+func newReadRouter(db *gorm.DB, scope promutils.Scope) readRouter {
+	var replica *gorm.DB
+	if value, ok := db.Get(readReplicaSetting); ok {
+		replica = value.(*gorm.DB)
+	}
+	return readRouter{
+		primary: db,
+		replica: replica,
+		metrics: readRouterMetrics{
+			PrimaryReads: scope.MustNewCounter("primary_reads", "count of read queries issued on the primary database"),
+			ReplicaReads: scope.MustNewCounter("replica_reads", "count of read queries issued on the read replica"),
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadRouter(t *testing.T) {
+	primary := GetDbForTest(t)
+	replica := GetDbForTest(t)
+	router := newReadRouter(WithReadReplica(primary, replica), promutils.NewTestScope())
+
+	assert.Same(t, replica, router.db(context.Background()))
+	assert.NotSame(t, replica, router.db(interfaces.WithReadFromPrimary(context.Background())))
+}
+
+func TestReadRouter_NoReplica(t *testing.T) {
+	primary := GetDbForTest(t)
+	router := newReadRouter(primary, promutils.NewTestScope())
+
+	assert.Same(t, primary, router.db(context.Background()))
+}
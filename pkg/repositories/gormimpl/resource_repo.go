@@ -16,6 +16,7 @@ import (
 
 type ResourceRepo struct {
 	db               *gorm.DB
+	reader           readRouter
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -104,7 +105,7 @@ func (r *ResourceRepo) Get(ctx context.Context, ID interfaces.ResourceID) (model
 		launchPlan = append(launchPlan, ID.LaunchPlan)
 	}
 
-	tx := r.db.Where(txWhereClause, ID.ResourceType, ID.Domain, project, workflow, launchPlan)
+	tx := r.reader.db(ctx).Where(txWhereClause, ID.ResourceType, ID.Domain, project, workflow, launchPlan)
 	tx.Order(priorityDescending).First(&resources)
 	timer.Stop()
 
@@ -124,7 +125,7 @@ func (r *ResourceRepo) GetRaw(ctx context.Context, ID interfaces.ResourceID) (mo
 	}
 	var model models.Resource
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Resource{
+	tx := r.reader.db(ctx).Where(&models.Resource{
 		Project:      ID.Project,
 		Domain:       ID.Domain,
 		Workflow:     ID.Workflow,
@@ -146,7 +147,7 @@ func (r *ResourceRepo) ListAll(ctx context.Context, resourceType string) ([]mode
 	var resources []models.Resource
 	timer := r.metrics.ListDuration.Start()
 
-	tx := r.db.Where(&models.Resource{ResourceType: resourceType}).Order(priorityDescending).Find(&resources)
+	tx := r.reader.db(ctx).Where(&models.Resource{ResourceType: resourceType}).Order(priorityDescending).Find(&resources)
 	timer.Stop()
 
 	if tx.Error != nil {
@@ -180,6 +181,7 @@ func NewResourceRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
 	metrics := newMetrics(scope)
 	return &ResourceRepo{
 		db:               db,
+		reader:           newReadRouter(db, scope),
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
@@ -0,0 +1,61 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of RevokedTokenRepoInterface.
+type RevokedTokenRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *RevokedTokenRepo) RevokeToken(ctx context.Context, id string, expiresAt time.Time) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Where(&models.RevokedToken{ID: id}).Attrs(models.RevokedToken{ExpiresAt: expiresAt}).
+		FirstOrCreate(&models.RevokedToken{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+
+	// The tokens of expired entries can't be used anymore, so they are pruned along the way.
+	timer = r.metrics.DeleteDuration.Start()
+	tx = r.db.Where("expires_at < ?", time.Now()).Delete(&models.RevokedToken{})
+	timer.Stop()
+	if tx.Error != nil {
+		logger.Warningf(ctx, "Failed to prune expired revoked tokens with err: %v", tx.Error)
+	}
+	return nil
+}
+
+func (r *RevokedTokenRepo) IsTokenRevoked(ctx context.Context, ids ...string) (bool, error) {
+	var count int
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Model(&models.RevokedToken{}).Where("id IN (?)", ids).Count(&count)
+	timer.Stop()
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return count > 0, nil
+}
+
+// Returns an instance of RevokedTokenRepoInterface
+func NewRevokedTokenRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.RevokedTokenRepoInterface {
+	metrics := newMetrics(scope)
+	return &RevokedTokenRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
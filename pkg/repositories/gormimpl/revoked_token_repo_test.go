@@ -0,0 +1,58 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokeToken(t *testing.T) {
+	revokedTokenRepo := NewRevokedTokenRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	insert := GlobalMock.NewMock()
+	insert.WithQuery(`INSERT INTO "revoked_tokens" ("id","created_at","expires_at") VALUES (?,?,?)`)
+	prune := GlobalMock.NewMock()
+	prune.WithQuery(`DELETE FROM "revoked_tokens"  WHERE (expires_at < ?)`)
+
+	err := revokedTokenRepo.RevokeToken(context.Background(), "jti", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.True(t, insert.Triggered)
+	assert.True(t, prune.Triggered)
+}
+
+func TestRevokeToken_AlreadyRevoked(t *testing.T) {
+	revokedTokenRepo := NewRevokedTokenRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "revoked_tokens"  WHERE ("revoked_tokens"."id" = jti) ` +
+		`ORDER BY "revoked_tokens"."id" ASC LIMIT 1`).WithReply(
+		[]map[string]interface{}{{"id": "jti", "expires_at": time.Now().Add(time.Hour)}})
+	insert := GlobalMock.NewMock()
+	insert.WithQuery(`INSERT INTO "revoked_tokens"`)
+
+	err := revokedTokenRepo.RevokeToken(context.Background(), "jti", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.False(t, insert.Triggered)
+}
+
+func TestIsTokenRevoked(t *testing.T) {
+	revokedTokenRepo := NewRevokedTokenRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT count(*) FROM "revoked_tokens"  WHERE (id IN (jti,grant))`).WithReply(
+		[]map[string]interface{}{{"count": 1}})
+
+	revoked, err := revokedTokenRepo.IsTokenRevoked(context.Background(), "jti", "grant")
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+
+	GlobalMock = mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT count(*) FROM "revoked_tokens"`).WithReply(
+		[]map[string]interface{}{{"count": 0}})
+	revoked, err = revokedTokenRepo.IsTokenRevoked(context.Background(), "jti", "grant")
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+}
@@ -0,0 +1,108 @@
+package gormimpl
+
+import (
+	"context"
+	"strings"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Implementation of RoleBindingRepoInterface.
+type RoleBindingRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *RoleBindingRepo) Create(ctx context.Context, input *models.RoleBinding) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *RoleBindingRepo) Get(ctx context.Context, id uint) (models.RoleBinding, error) {
+	var binding models.RoleBinding
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where(&models.RoleBinding{ID: id}).Take(&binding)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.RoleBinding{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"role binding [%d] not found", id)
+	}
+	if tx.Error != nil {
+		return models.RoleBinding{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return binding, nil
+}
+
+func (r *RoleBindingRepo) Delete(ctx context.Context, id uint) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.Where("id = ?", id).Delete(&models.RoleBinding{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "role binding [%d] not found", id)
+	}
+	return nil
+}
+
+func (r *RoleBindingRepo) List(ctx context.Context, project, domain string) ([]models.RoleBinding, error) {
+	var bindings []models.RoleBinding
+	timer := r.metrics.ListDuration.Start()
+	// Empty struct fields are ignored by gorm, which leaves the project and domain unrestricted when unset.
+	tx := r.db.Where(&models.RoleBinding{
+		Project: project,
+		Domain:  domain,
+	}).Order(createdAtAscending).Find(&bindings)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return bindings, nil
+}
+
+func (r *RoleBindingRepo) ListMatching(ctx context.Context, subjects []interfaces.RoleBindingSubject,
+	project, domain string) ([]models.RoleBinding, error) {
+	if len(subjects) == 0 {
+		return []models.RoleBinding{}, nil
+	}
+	subjectConditions := make([]string, len(subjects))
+	subjectArgs := make([]interface{}, 0, 2*len(subjects))
+	for idx, subject := range subjects {
+		subjectConditions[idx] = "(subject_type = ? AND subject = ?)"
+		subjectArgs = append(subjectArgs, subject.Type, subject.Name)
+	}
+	var bindings []models.RoleBinding
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.Where(strings.Join(subjectConditions, " OR "), subjectArgs...).Where(
+		"project IN (?)", []string{"", project}).Where(
+		"domain IN (?)", []string{"", domain}).Order(createdAtAscending).Find(&bindings)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return bindings, nil
+}
+
+// Returns an instance of RoleBindingRepoInterface
+func NewRoleBindingRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.RoleBindingRepoInterface {
+	metrics := newMetrics(scope)
+	return &RoleBindingRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
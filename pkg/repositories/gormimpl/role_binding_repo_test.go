@@ -0,0 +1,122 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func getMockRoleBindingResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"id":           1,
+		"subject_type": "user",
+		"subject":      "user@example.com",
+		"project":      "project",
+		"domain":       "domain",
+		"role":         "contributor",
+		"created_by":   "admin@example.com",
+	}
+}
+
+func TestCreateRoleBinding(t *testing.T) {
+	roleBindingRepo := NewRoleBindingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "role_bindings" ("created_at","updated_at","subject_type","subject","project",` +
+		`"domain","role","created_by") VALUES (?,?,?,?,?,?,?,?)`)
+
+	err := roleBindingRepo.Create(context.Background(), &models.RoleBinding{
+		SubjectType: "user",
+		Subject:     "user@example.com",
+		Project:     "project",
+		Role:        "viewer",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestGetRoleBinding(t *testing.T) {
+	roleBindingRepo := NewRoleBindingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "role_bindings"  WHERE ("role_bindings"."id" = 1) LIMIT 1`).WithReply(
+		[]map[string]interface{}{getMockRoleBindingResponse()})
+
+	binding, err := roleBindingRepo.Get(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", binding.Subject)
+	assert.Equal(t, "contributor", binding.Role)
+}
+
+func TestGetRoleBinding_NotFound(t *testing.T) {
+	roleBindingRepo := NewRoleBindingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := roleBindingRepo.Get(context.Background(), 1)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteRoleBinding(t *testing.T) {
+	roleBindingRepo := NewRoleBindingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "role_bindings"  WHERE (id = ?)`).WithRowsNum(1)
+
+	assert.NoError(t, roleBindingRepo.Delete(context.Background(), 1))
+	assert.True(t, query.Triggered)
+}
+
+func TestDeleteRoleBinding_NotFound(t *testing.T) {
+	roleBindingRepo := NewRoleBindingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	err := roleBindingRepo.Delete(context.Background(), 1)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestListRoleBindings(t *testing.T) {
+	roleBindingRepo := NewRoleBindingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "role_bindings"  WHERE ("role_bindings"."project" = project) ` +
+		`ORDER BY created_at asc`).WithReply([]map[string]interface{}{getMockRoleBindingResponse()})
+
+	bindings, err := roleBindingRepo.List(context.Background(), "project", "")
+	assert.NoError(t, err)
+	assert.Len(t, bindings, 1)
+	assert.Equal(t, "domain", bindings[0].Domain)
+}
+
+func TestListMatchingRoleBindings(t *testing.T) {
+	roleBindingRepo := NewRoleBindingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "role_bindings"  WHERE ((subject_type = user AND subject = ` +
+		`user@example.com) OR (subject_type = group AND subject = ml-platform)) AND (project IN (,project)) AND ` +
+		`(domain IN (,domain)) ORDER BY created_at asc`).WithReply(
+		[]map[string]interface{}{getMockRoleBindingResponse()})
+
+	bindings, err := roleBindingRepo.ListMatching(context.Background(), []interfaces.RoleBindingSubject{
+		{Type: "user", Name: "user@example.com"},
+		{Type: "group", Name: "ml-platform"},
+	}, "project", "domain")
+	assert.NoError(t, err)
+	assert.Len(t, bindings, 1)
+}
+
+func TestListMatchingRoleBindings_NoSubjects(t *testing.T) {
+	roleBindingRepo := NewRoleBindingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "role_bindings"`)
+
+	bindings, err := roleBindingRepo.ListMatching(context.Background(), nil, "project", "domain")
+	assert.NoError(t, err)
+	assert.Empty(t, bindings)
+	assert.False(t, query.Triggered)
+}
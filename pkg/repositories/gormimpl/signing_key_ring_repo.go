@@ -0,0 +1,80 @@
+package gormimpl
+
+import (
+	"context"
+	"fmt"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Implementation of SigningKeyRingRepoInterface.
+type SigningKeyRingRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *SigningKeyRingRepo) GetSigningKeys(_ context.Context) (string, uint32, error) {
+	var keyRing models.SigningKeyRing
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where(&models.SigningKeyRing{ID: models.SigningKeyRingID}).Take(&keyRing)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return "", 0, nil
+	}
+	if tx.Error != nil {
+		return "", 0, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return string(keyRing.KeyRing), keyRing.StateVersion, nil
+}
+
+func (r *SigningKeyRingRepo) SetSigningKeys(_ context.Context, keyRing string, version uint32) (bool, error) {
+	if version == 0 {
+		timer := r.metrics.CreateDuration.Start()
+		tx := r.db.Create(&models.SigningKeyRing{
+			ID:           models.SigningKeyRingID,
+			KeyRing:      []byte(keyRing),
+			StateVersion: 1,
+		})
+		timer.Stop()
+		if tx.Error != nil {
+			err := r.errorTransformer.ToFlyteAdminError(tx.Error)
+			if adminErr, ok := err.(flyteAdminErrors.FlyteAdminError); ok && adminErr.Code() == codes.AlreadyExists {
+				// Another replica stored the first key ring.
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.SigningKeyRing{ID: models.SigningKeyRingID}).
+		Where(fmt.Sprintf("%s = ?", stateVersionColumn), version).
+		Updates(map[string]interface{}{
+			"key_ring":         []byte(keyRing),
+			stateVersionColumn: version + 1,
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// Returns an instance of SigningKeyRingRepoInterface
+func NewSigningKeyRingRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.SigningKeyRingRepoInterface {
+	metrics := newMetrics(scope)
+	return &SigningKeyRingRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,70 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSigningKeys(t *testing.T) {
+	signingKeyRingRepo := NewSigningKeyRingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "signing_key_rings"  WHERE ("signing_key_rings"."id" = 1) LIMIT 1`).
+		WithReply([]map[string]interface{}{{"id": 1, "key_ring": []byte("encrypted"), "state_version": 3}})
+
+	keyRing, version, err := signingKeyRingRepo.GetSigningKeys(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "encrypted", keyRing)
+	assert.Equal(t, uint32(3), version)
+}
+
+func TestGetSigningKeys_NotFound(t *testing.T) {
+	signingKeyRingRepo := NewSigningKeyRingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	keyRing, version, err := signingKeyRingRepo.GetSigningKeys(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, keyRing)
+	assert.Equal(t, uint32(0), version)
+}
+
+func TestSetSigningKeys(t *testing.T) {
+	signingKeyRingRepo := NewSigningKeyRingRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	t.Run("Create the key ring", func(t *testing.T) {
+		GlobalMock := mocket.Catcher.Reset()
+		insert := GlobalMock.NewMock()
+		insert.WithQuery(`INSERT INTO "signing_key_rings" ("id","created_at","updated_at","key_ring","state_version") ` +
+			`VALUES (?,?,?,?,?)`)
+
+		stored, err := signingKeyRingRepo.SetSigningKeys(context.Background(), "encrypted", 0)
+		assert.NoError(t, err)
+		assert.True(t, stored)
+		assert.True(t, insert.Triggered)
+	})
+
+	t.Run("Update the key ring", func(t *testing.T) {
+		GlobalMock := mocket.Catcher.Reset()
+		update := GlobalMock.NewMock()
+		update.WithQuery(`UPDATE "signing_key_rings" SET "key_ring" = ?, "state_version" = ?, ` +
+			`"updated_at" = ?  WHERE "signing_key_rings"."id" = ? AND ((state_version = ?))`).WithRowsNum(1)
+
+		stored, err := signingKeyRingRepo.SetSigningKeys(context.Background(), "encrypted", 3)
+		assert.NoError(t, err)
+		assert.True(t, stored)
+		assert.True(t, update.Triggered)
+	})
+
+	t.Run("Key ring updated concurrently", func(t *testing.T) {
+		GlobalMock := mocket.Catcher.Reset()
+		GlobalMock.NewMock().WithQuery(`UPDATE "signing_key_rings"`).WithRowsNum(0)
+
+		stored, err := signingKeyRingRepo.SetSigningKeys(context.Background(), "encrypted", 3)
+		assert.NoError(t, err)
+		assert.False(t, stored)
+	})
+}
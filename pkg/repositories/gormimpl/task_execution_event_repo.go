@@ -0,0 +1,78 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+type TaskExecutionEventRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+	reader           readRouter
+}
+
+func (r *TaskExecutionEventRepo) Create(ctx context.Context, input models.TaskExecutionEvent) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *TaskExecutionEventRepo) BatchCreate(ctx context.Context, inputs []models.TaskExecutionEvent) error {
+	records := make([]interface{}, len(inputs))
+	for i := range inputs {
+		records[i] = &inputs[i]
+	}
+	timer := r.metrics.CreateDuration.Start()
+	err := insertBatch(r.db, records)
+	timer.Stop()
+	if err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *TaskExecutionEventRepo) ListByExecution(
+	ctx context.Context, executionID interfaces.Identifier, nodeID string) ([]models.TaskExecutionEvent, error) {
+	var events []models.TaskExecutionEvent
+	timer := r.metrics.ListDuration.Start()
+	// The node id is left out of the query when it's empty.
+	tx := r.reader.db(ctx).Where(&models.TaskExecutionEvent{
+		TaskExecutionKey: models.TaskExecutionKey{
+			NodeExecutionKey: models.NodeExecutionKey{
+				ExecutionKey: models.ExecutionKey{
+					Project: executionID.Project,
+					Domain:  executionID.Domain,
+					Name:    executionID.Name,
+				},
+				NodeID: nodeID,
+			},
+		},
+	}).Order(eventTimelineOrder).Find(&events)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return events, nil
+}
+
+// Returns an instance of TaskExecutionEventRepoInterface
+func NewTaskExecutionEventRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.TaskExecutionEventRepoInterface {
+	metrics := newMetrics(scope)
+	return &TaskExecutionEventRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+		reader:           newReadRouter(db, scope),
+	}
+}
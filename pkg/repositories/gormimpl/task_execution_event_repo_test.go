@@ -0,0 +1,102 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestTaskExecutionKey() models.TaskExecutionKey {
+	retryAttempt := uint32(1)
+	return models.TaskExecutionKey{
+		TaskKey: models.TaskKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "task",
+			Version: "version",
+		},
+		NodeExecutionKey: models.NodeExecutionKey{
+			NodeID: "n0",
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "1",
+			},
+		},
+		RetryAttempt: &retryAttempt,
+	}
+}
+
+func TestCreateTaskExecutionEvent(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	taskExecutionEventQuery := GlobalMock.NewMock()
+	taskExecutionEventQuery.WithQuery(`INSERT INTO "task_execution_events" ("created_at","updated_at",` +
+		`"deleted_at","project","domain","name","version","execution_project","execution_domain","execution_name",` +
+		`"node_id","retry_attempt","request_id","occurred_at","phase","phase_version") VALUES ` +
+		`(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+	taskExecEventRepo := NewTaskExecutionEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := taskExecEventRepo.Create(context.Background(), models.TaskExecutionEvent{
+		TaskExecutionKey: getTestTaskExecutionKey(),
+		RequestID:        "xxyzz",
+		Phase:            "RUNNING",
+		PhaseVersion:     1,
+		OccurredAt:       time.Now(),
+	})
+	assert.NoError(t, err)
+	assert.True(t, taskExecutionEventQuery.Triggered)
+}
+
+func TestBatchCreateTaskExecutionEvents(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	taskExecutionEventQuery := GlobalMock.NewMock()
+	taskExecutionEventQuery.WithQuery(`INSERT INTO "task_execution_events" ("created_at","updated_at",` +
+		`"deleted_at","project","domain","name","version","execution_project","execution_domain","execution_name",` +
+		`"node_id","retry_attempt","request_id","occurred_at","phase","phase_version") VALUES ` +
+		`(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+	taskExecEventRepo := NewTaskExecutionEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := taskExecEventRepo.BatchCreate(context.Background(), []models.TaskExecutionEvent{
+		{
+			TaskExecutionKey: getTestTaskExecutionKey(),
+			Phase:            "RUNNING",
+			OccurredAt:       time.Now(),
+		},
+		{
+			TaskExecutionKey: getTestTaskExecutionKey(),
+			Phase:            "RUNNING",
+			PhaseVersion:     1,
+			OccurredAt:       time.Now(),
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, taskExecutionEventQuery.Triggered)
+}
+
+func TestListTaskExecutionEventsByExecution(t *testing.T) {
+	taskExecEventRepo := NewTaskExecutionEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "task_execution_events"  WHERE "task_execution_events"."deleted_at" IS NULL AND ` +
+			`(("task_execution_events"."execution_project" = project) AND ("task_execution_events"."execution_domain" ` +
+			`= domain) AND ("task_execution_events"."execution_name" = 1) AND ("task_execution_events"."node_id" = n0)) ` +
+			`ORDER BY occurred_at asc, id asc`).WithReply(
+		[]map[string]interface{}{
+			{"node_id": "n0", "retry_attempt": 0, "phase": "RUNNING", "phase_version": 0},
+			{"node_id": "n0", "retry_attempt": 0, "phase": "RUNNING", "phase_version": 1},
+		})
+	events, err := taskExecEventRepo.ListByExecution(context.Background(), interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "1",
+	}, "n0")
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, uint32(0), events[0].PhaseVersion)
+	assert.Equal(t, uint32(1), events[1].PhaseVersion)
+}
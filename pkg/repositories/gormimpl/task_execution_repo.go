@@ -16,6 +16,7 @@ import (
 // Implementation of TaskExecutionInterface.
 type TaskExecutionRepo struct {
 	db               *gorm.DB
+	reader           readRouter
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -30,10 +31,19 @@ func (r *TaskExecutionRepo) Create(ctx context.Context, input models.TaskExecuti
 	return nil
 }
 
+func (r *TaskExecutionRepo) CreateWithOutboxEvent(
+	ctx context.Context, input models.TaskExecution, event models.OutboxEvent) error {
+	timer := r.metrics.CreateDuration.Start()
+	defer timer.Stop()
+	return writeWithOutboxEvent(r.db, r.errorTransformer, func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(&input)
+	}, event)
+}
+
 func (r *TaskExecutionRepo) Get(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
 	var taskExecution models.TaskExecution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.TaskExecution{
+	tx := r.reader.db(ctx).Where(&models.TaskExecution{
 		TaskExecutionKey: models.TaskExecutionKey{
 			TaskKey: models.TaskKey{
 				Project: input.TaskExecutionID.TaskId.Project,
@@ -80,13 +90,21 @@ func (r *TaskExecutionRepo) Get(ctx context.Context, input interfaces.GetTaskExe
 
 func (r *TaskExecutionRepo) Update(ctx context.Context, execution models.TaskExecution) error {
 	timer := r.metrics.UpdateDuration.Start()
-	tx := r.db.Save(&execution)
-	timer.Stop()
+	defer timer.Stop()
+	return writeIfUnmodified(r.db, r.errorTransformer, "task execution", &execution, &execution.StateVersion,
+		func(tx *gorm.DB) *gorm.DB {
+			return tx.Save(&execution)
+		}, nil)
+}
 
-	if err := tx.Error; err != nil {
-		return r.errorTransformer.ToFlyteAdminError(err)
-	}
-	return nil
+func (r *TaskExecutionRepo) UpdateWithOutboxEvent(
+	ctx context.Context, execution models.TaskExecution, event models.OutboxEvent) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	return writeIfUnmodified(r.db, r.errorTransformer, "task execution", &execution, &execution.StateVersion,
+		func(tx *gorm.DB) *gorm.DB {
+			return tx.Save(&execution)
+		}, &event)
 }
 
 func (r *TaskExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
@@ -95,7 +113,7 @@ func (r *TaskExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 	}
 
 	var taskExecutions []models.TaskExecution
-	tx := r.db.Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecution")
+	tx := r.reader.db(ctx).Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecution")
 
 	// And add three join conditions (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// We are joining on task -> taskExec->NodeExec -> Exec.
@@ -134,6 +152,7 @@ func NewTaskExecutionRepo(
 	metrics := newMetrics(scope)
 	return &TaskExecutionRepo{
 		db:               db,
+		reader:           newReadRouter(db, scope),
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
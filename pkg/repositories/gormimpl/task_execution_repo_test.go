@@ -84,11 +84,13 @@ func TestUpdateTaskExecution(t *testing.T) {
 	GlobalMock := mocket.Catcher.Reset()
 	GlobalMock.Logging = true
 
+	GlobalMock.NewMock().WithQuery(`UPDATE "task_executions" SET "state_version" = ?`).WithRowsNum(1)
 	taskExecutionQuery := GlobalMock.NewMock()
 	taskExecutionQuery.WithQuery(`INSERT INTO "task_executions" ("created_at","updated_at","deleted_at",` +
 		`"project","domain","name","version","execution_project","execution_domain","execution_name","node_id",` +
 		`"retry_attempt","phase","phase_version","input_uri","closure","started_at","task_execution_created_at",` +
-		`"task_execution_updated_at","duration") VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+		`"task_execution_updated_at","duration","closure_reference","state_version") ` +
+		`VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
 	err := taskExecutionRepo.Update(context.Background(), testTaskExecution)
 	assert.NoError(t, err)
 	assert.True(t, taskExecutionQuery.Triggered)
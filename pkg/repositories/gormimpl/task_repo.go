@@ -16,6 +16,7 @@ import (
 // Implementation of TaskRepoInterface.
 type TaskRepo struct {
 	db               *gorm.DB
+	reader           readRouter
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -33,7 +34,7 @@ func (r *TaskRepo) Create(ctx context.Context, input models.Task) error {
 func (r *TaskRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Task, error) {
 	var task models.Task
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Task{
+	tx := r.reader.db(ctx).Where(&models.Task{
 		TaskKey: models.TaskKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -63,7 +64,7 @@ func (r *TaskRepo) List(
 		return interfaces.TaskCollectionOutput{}, err
 	}
 	var tasks []models.Task
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := r.reader.db(ctx).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -94,7 +95,7 @@ func (r *TaskRepo) ListTaskIdentifiers(ctx context.Context, input interfaces.Lis
 		return interfaces.TaskCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.Task{}).Limit(input.Limit).Offset(input.Offset)
+	tx := r.reader.db(ctx).Model(models.Task{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -129,6 +130,7 @@ func NewTaskRepo(
 	metrics := newMetrics(scope)
 	return &TaskRepo{
 		db:               db,
+		reader:           newReadRouter(db, scope),
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
@@ -16,6 +16,7 @@ const workflowTableName = "workflows"
 // Implementation of WorkflowRepoInterface.
 type WorkflowRepo struct {
 	db               *gorm.DB
+	reader           readRouter
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -33,7 +34,7 @@ func (r *WorkflowRepo) Create(ctx context.Context, input models.Workflow) error
 func (r *WorkflowRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Workflow, error) {
 	var workflow models.Workflow
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Workflow{
+	tx := r.reader.db(ctx).Where(&models.Workflow{
 		WorkflowKey: models.WorkflowKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -63,7 +64,7 @@ func (r *WorkflowRepo) List(
 		return interfaces.WorkflowCollectionOutput{}, err
 	}
 	var workflows []models.Workflow
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := r.reader.db(ctx).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -93,7 +94,7 @@ func (r *WorkflowRepo) ListIdentifiers(ctx context.Context, input interfaces.Lis
 		return interfaces.WorkflowCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.Workflow{}).Limit(input.Limit).Offset(input.Offset)
+	tx := r.reader.db(ctx).Model(models.Workflow{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -126,6 +127,7 @@ func NewWorkflowRepo(
 	metrics := newMetrics(scope)
 	return &WorkflowRepo{
 		db:               db,
+		reader:           newReadRouter(db, scope),
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
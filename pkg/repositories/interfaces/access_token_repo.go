@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Defines the interface for interacting with access token models.
+type AccessTokenRepoInterface interface {
+	// Inserts an access token into the database store. The ID of the input is populated on success.
+	Create(ctx context.Context, input *models.AccessToken) error
+	// Returns a matching access token if it exists.
+	Get(ctx context.Context, id uint) (models.AccessToken, error)
+	// Returns the access token with the hash if it exists.
+	GetByHash(ctx context.Context, tokenHash string) (models.AccessToken, error)
+	// Returns the access tokens created by an owner, most recent first.
+	List(ctx context.Context, owner string) ([]models.AccessToken, error)
+	// Marks an access token revoked.
+	Revoke(ctx context.Context, id uint, revokedAt time.Time) error
+}
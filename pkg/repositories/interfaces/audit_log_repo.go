@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Defines the interface for interacting with the audit log of admin service requests.
+type AuditLogRepoInterface interface {
+	// Inserts an audit entry into the database store. The ID of the input is populated on success.
+	Create(ctx context.Context, input *models.AuditLog) error
+	// Returns the audit entries matching the filters of the input, most recent first.
+	List(ctx context.Context, input ListResourceInput) ([]models.AuditLog, error)
+}
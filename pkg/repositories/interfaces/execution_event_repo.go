@@ -11,4 +11,8 @@ import (
 type ExecutionEventRepoInterface interface {
 	// Inserts a workflow execution event into the database store.
 	Create(ctx context.Context, input models.ExecutionEvent) error
+	// Inserts workflow execution events into the database store, all at once.
+	BatchCreate(ctx context.Context, inputs []models.ExecutionEvent) error
+	// Returns the events of a workflow execution in the order in which they occurred.
+	ListByExecution(ctx context.Context, executionID Identifier) ([]models.ExecutionEvent, error)
 }
@@ -10,8 +10,11 @@ import (
 type ExecutionRepoInterface interface {
 	// Inserts a workflow execution model into the database store.
 	Create(ctx context.Context, input models.Execution) error
-	// This updates only an existing execution model with all non-empty fields in the input.
+	// This updates only an existing execution model with all non-empty fields in the input. Fails with a concurrent
+	// update error when the execution was updated since the input was read.
 	Update(ctx context.Context, execution models.Execution) error
+	// Updates an existing execution model and inserts the outbox event describing the update in a single transaction.
+	UpdateWithOutboxEvent(ctx context.Context, execution models.Execution, event models.OutboxEvent) error
 	// Returns a matching execution if it exists.
 	Get(ctx context.Context, input Identifier) (models.Execution, error)
 	// Returns executions matching query parameters. A limit must be provided for the results page size.
@@ -11,4 +11,9 @@ import (
 type NodeExecutionEventRepoInterface interface {
 	// Inserts a node execution event into the database store.
 	Create(ctx context.Context, input models.NodeExecutionEvent) error
+	// Inserts node execution events into the database store, all at once.
+	BatchCreate(ctx context.Context, inputs []models.NodeExecutionEvent) error
+	// Returns the node execution events of a workflow execution in the order in which they occurred. When nodeID is
+	// set only the events of that node are returned.
+	ListByExecution(ctx context.Context, executionID Identifier, nodeID string) ([]models.NodeExecutionEvent, error)
 }
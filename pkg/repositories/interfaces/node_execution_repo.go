@@ -11,8 +11,12 @@ import (
 type NodeExecutionRepoInterface interface {
 	// Inserts a new node execution model and the first event that triggers it into the database store.
 	Create(ctx context.Context, execution *models.NodeExecution) error
-	// Updates an existing node execution in the database store with all non-empty fields in the input.
+	// Updates an existing node execution in the database store with all non-empty fields in the input. Fails with a
+	// concurrent update error when the node execution was updated since the input was read.
 	Update(ctx context.Context, execution *models.NodeExecution) error
+	// Variants of Create and Update which insert the outbox event describing the write in the same transaction.
+	CreateWithOutboxEvent(ctx context.Context, execution *models.NodeExecution, event models.OutboxEvent) error
+	UpdateWithOutboxEvent(ctx context.Context, execution *models.NodeExecution, event models.OutboxEvent) error
 	// Returns a matching execution if it exists.
 	Get(ctx context.Context, input NodeExecutionResource) (models.NodeExecution, error)
 	// Returns node executions matching query parameters. A limit must be provided for the results page size.
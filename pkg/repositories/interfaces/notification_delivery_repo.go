@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Defines the interface for interacting with notification delivery models.
+type NotificationDeliveryRepoInterface interface {
+	// Inserts a notification delivery into the database store. The ID of the input is populated on success.
+	Create(ctx context.Context, input *models.NotificationDelivery) error
+	// Overwrites an existing notification delivery.
+	Update(ctx context.Context, input models.NotificationDelivery) error
+	// Returns a matching notification delivery if it exists.
+	Get(ctx context.Context, id uint) (models.NotificationDelivery, error)
+	// Returns the oldest queued notification delivery whose message matches the digest, if it exists.
+	GetQueuedByDigest(ctx context.Context, digest string) (models.NotificationDelivery, error)
+	// Returns all notification deliveries recorded for a workflow execution, oldest first.
+	ListByExecution(ctx context.Context, executionID Identifier) ([]models.NotificationDelivery, error)
+}
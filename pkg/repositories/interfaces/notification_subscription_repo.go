@@ -0,0 +1,35 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Identifies the executions a notification subscription applies to.
+type NotificationSubscriptionScope struct {
+	Project string
+	Domain  string
+	// Optional, empty when the scope spans all workflows.
+	Workflow string
+	// Optional, empty when the scope spans all launch plans.
+	LaunchPlan string
+}
+
+// Defines the interface for interacting with notification subscription models.
+type NotificationSubscriptionRepoInterface interface {
+	// Inserts a notification subscription into the database store. The ID of the input is populated on success.
+	Create(ctx context.Context, input *models.NotificationSubscription) error
+	// Overwrites an existing notification subscription.
+	Update(ctx context.Context, input models.NotificationSubscription) error
+	// Returns a matching notification subscription if it exists.
+	Get(ctx context.Context, id uint) (models.NotificationSubscription, error)
+	// Removes a notification subscription.
+	Delete(ctx context.Context, id uint) error
+	// Returns the notification subscriptions of a project and domain, restricted to the workflow and launch plan of
+	// the scope when they're set.
+	List(ctx context.Context, scope NotificationSubscriptionScope) ([]models.NotificationSubscription, error)
+	// Returns the notification subscriptions which apply to executions of the scope's workflow and launch plan:
+	// those for the scope itself and those for all workflows and/or all launch plans of its project and domain.
+	ListMatching(ctx context.Context, scope NotificationSubscriptionScope) ([]models.NotificationSubscription, error)
+}
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Defines the interface for relaying outbox events. Outbox events are inserted alongside the execution, node execution
+// and task execution writes they belong to.
+type OutboxEventRepoInterface interface {
+	// Returns up to limit events which are neither published nor dead-lettered, oldest first.
+	ListPending(ctx context.Context, limit int) ([]models.OutboxEvent, error)
+	// Marks the events with the given ids as published.
+	MarkPublished(ctx context.Context, ids []uint) error
+	// Records a failed attempt to publish an event.
+	RecordFailure(ctx context.Context, id uint, errorMessage string) error
+	// Records a failed attempt to publish an event and stops relaying it.
+	DeadLetter(ctx context.Context, id uint, errorMessage string) error
+	// Deletes the events published before the given time and returns how many were deleted.
+	DeletePublished(ctx context.Context, before time.Time) (int64, error)
+	// Acquires or renews the relay lease for the holder, returning false while another holder's lease is unexpired.
+	AcquireLease(ctx context.Context, holder string, duration time.Duration) (bool, error)
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import "context"
+
+type readFromPrimaryKey struct{}
+
+// Returns a context whose repository reads are served by the primary database rather than a read replica. Flows
+// which must observe their own, or other recent, writes use it to avoid replication lag.
+func WithReadFromPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readFromPrimaryKey{}, true)
+}
+
+// Returns whether repository reads for the context must be served by the primary database.
+func ReadFromPrimary(ctx context.Context) bool {
+	readFromPrimary, _ := ctx.Value(readFromPrimaryKey{}).(bool)
+	return readFromPrimary
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Defines the interface for interacting with the revoked tokens of the authorization server.
+type RevokedTokenRepoInterface interface {
+	// Revokes the token or grant of the ID until it expires. Revoking a token twice isn't an error.
+	RevokeToken(ctx context.Context, id string, expiresAt time.Time) error
+	// Returns whether any of the tokens or grants of the IDs is revoked.
+	IsTokenRevoked(ctx context.Context, ids ...string) (bool, error)
+}
@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Identifies a user, app or group roles are bound to.
+type RoleBindingSubject struct {
+	Type string
+	Name string
+}
+
+// Defines the interface for interacting with role binding models.
+type RoleBindingRepoInterface interface {
+	// Inserts a role binding into the database store. The ID of the input is populated on success.
+	Create(ctx context.Context, input *models.RoleBinding) error
+	// Returns a matching role binding if it exists.
+	Get(ctx context.Context, id uint) (models.RoleBinding, error)
+	// Removes a role binding.
+	Delete(ctx context.Context, id uint) error
+	// Returns the role bindings of a project and domain. All role bindings of the project are returned when the domain
+	// is empty, and all role bindings when the project is empty as well.
+	List(ctx context.Context, project, domain string) ([]models.RoleBinding, error)
+	// Returns the role bindings of the subjects which apply to a project and domain: those bound in the project and
+	// domain, in all domains of the project and in all projects.
+	ListMatching(ctx context.Context, subjects []RoleBindingSubject, project, domain string) (
+		[]models.RoleBinding, error)
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// Defines the interface for interacting with the token signing key ring shared by the replicas of the self-hosted
+// authorization server.
+type SigningKeyRingRepoInterface interface {
+	// Returns the key ring and its version, which is 0 when no key ring was stored yet.
+	GetSigningKeys(ctx context.Context) (string, uint32, error)
+	// Stores the key ring read at the version, 0 to store the first one. Returns false, rather than an error, when
+	// the key ring was stored again since it was read.
+	SetSigningKeys(ctx context.Context, keyRing string, version uint32) (bool, error)
+}
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=TaskExecutionEventRepoInterface -output=../mocks -case=underscore
+
+type TaskExecutionEventRepoInterface interface {
+	// Inserts a task execution event into the database store.
+	Create(ctx context.Context, input models.TaskExecutionEvent) error
+	// Inserts task execution events into the database store, all at once.
+	BatchCreate(ctx context.Context, inputs []models.TaskExecutionEvent) error
+	// Returns the task execution events of a workflow execution in the order in which they occurred. When nodeID is
+	// set only the events of the tasks executed by that node are returned.
+	ListByExecution(ctx context.Context, executionID Identifier, nodeID string) ([]models.TaskExecutionEvent, error)
+}
@@ -11,8 +11,12 @@ import (
 type TaskExecutionRepoInterface interface {
 	// Inserts a task execution model into the database store.
 	Create(ctx context.Context, input models.TaskExecution) error
-	// Updates an existing task execution in the database store with all non-empty fields in the input.
+	// Updates an existing task execution in the database store with all non-empty fields in the input. Fails with a
+	// concurrent update error when the task execution was updated since the input was read.
 	Update(ctx context.Context, execution models.TaskExecution) error
+	// Variants of Create and Update which insert the outbox event describing the write in the same transaction.
+	CreateWithOutboxEvent(ctx context.Context, input models.TaskExecution, event models.OutboxEvent) error
+	UpdateWithOutboxEvent(ctx context.Context, execution models.TaskExecution, event models.OutboxEvent) error
 	// Returns a matching execution if it exists.
 	Get(ctx context.Context, input GetTaskExecutionInput) (models.TaskExecution, error)
 	// Returns task executions matching query parameters. A limit must be provided for the results page size.
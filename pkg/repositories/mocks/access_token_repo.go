@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type CreateAccessTokenFunction func(ctx context.Context, input *models.AccessToken) error
+type GetAccessTokenFunction func(ctx context.Context, id uint) (models.AccessToken, error)
+type GetAccessTokenByHashFunction func(ctx context.Context, tokenHash string) (models.AccessToken, error)
+type ListAccessTokensFunction func(ctx context.Context, owner string) ([]models.AccessToken, error)
+type RevokeAccessTokenFunction func(ctx context.Context, id uint, revokedAt time.Time) error
+
+type MockAccessTokenRepo struct {
+	CreateFunction    CreateAccessTokenFunction
+	GetFunction       GetAccessTokenFunction
+	GetByHashFunction GetAccessTokenByHashFunction
+	ListFunction      ListAccessTokensFunction
+	RevokeFunction    RevokeAccessTokenFunction
+}
+
+func (r *MockAccessTokenRepo) Create(ctx context.Context, input *models.AccessToken) error {
+	if r.CreateFunction != nil {
+		return r.CreateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockAccessTokenRepo) Get(ctx context.Context, id uint) (models.AccessToken, error) {
+	if r.GetFunction != nil {
+		return r.GetFunction(ctx, id)
+	}
+	return models.AccessToken{}, nil
+}
+
+func (r *MockAccessTokenRepo) GetByHash(ctx context.Context, tokenHash string) (models.AccessToken, error) {
+	if r.GetByHashFunction != nil {
+		return r.GetByHashFunction(ctx, tokenHash)
+	}
+	return models.AccessToken{}, nil
+}
+
+func (r *MockAccessTokenRepo) List(ctx context.Context, owner string) ([]models.AccessToken, error) {
+	if r.ListFunction != nil {
+		return r.ListFunction(ctx, owner)
+	}
+	return []models.AccessToken{}, nil
+}
+
+func (r *MockAccessTokenRepo) Revoke(ctx context.Context, id uint, revokedAt time.Time) error {
+	if r.RevokeFunction != nil {
+		return r.RevokeFunction(ctx, id, revokedAt)
+	}
+	return nil
+}
+
+func NewMockAccessTokenRepo() interfaces.AccessTokenRepoInterface {
+	return &MockAccessTokenRepo{}
+}
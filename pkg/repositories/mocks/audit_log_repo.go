@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type CreateAuditLogFunction func(ctx context.Context, input *models.AuditLog) error
+type ListAuditLogsFunction func(ctx context.Context, input interfaces.ListResourceInput) ([]models.AuditLog, error)
+
+type MockAuditLogRepo struct {
+	CreateFunction CreateAuditLogFunction
+	ListFunction   ListAuditLogsFunction
+}
+
+func (r *MockAuditLogRepo) Create(ctx context.Context, input *models.AuditLog) error {
+	if r.CreateFunction != nil {
+		return r.CreateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockAuditLogRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
+	[]models.AuditLog, error) {
+	if r.ListFunction != nil {
+		return r.ListFunction(ctx, input)
+	}
+	return nil, nil
+}
+
+func NewMockAuditLogRepo() interfaces.AuditLogRepoInterface {
+	return &MockAuditLogRepo{}
+}
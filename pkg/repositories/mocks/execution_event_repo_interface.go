@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	interfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -15,6 +16,38 @@ type ExecutionEventRepoInterface struct {
 	mock.Mock
 }
 
+type ExecutionEventRepoInterface_BatchCreate struct {
+	*mock.Call
+}
+
+func (_m ExecutionEventRepoInterface_BatchCreate) Return(_a0 error) *ExecutionEventRepoInterface_BatchCreate {
+	return &ExecutionEventRepoInterface_BatchCreate{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutionEventRepoInterface) OnBatchCreate(ctx context.Context, inputs []models.ExecutionEvent) *ExecutionEventRepoInterface_BatchCreate {
+	c := _m.On("BatchCreate", ctx, inputs)
+	return &ExecutionEventRepoInterface_BatchCreate{Call: c}
+}
+
+func (_m *ExecutionEventRepoInterface) OnBatchCreateMatch(matchers ...interface{}) *ExecutionEventRepoInterface_BatchCreate {
+	c := _m.On("BatchCreate", matchers...)
+	return &ExecutionEventRepoInterface_BatchCreate{Call: c}
+}
+
+// BatchCreate provides a mock function with given fields: ctx, inputs
+func (_m *ExecutionEventRepoInterface) BatchCreate(ctx context.Context, inputs []models.ExecutionEvent) error {
+	ret := _m.Called(ctx, inputs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.ExecutionEvent) error); ok {
+		r0 = rf(ctx, inputs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type ExecutionEventRepoInterface_Create struct {
 	*mock.Call
 }
@@ -46,3 +79,44 @@ func (_m *ExecutionEventRepoInterface) Create(ctx context.Context, input models.
 
 	return r0
 }
+
+type ExecutionEventRepoInterface_ListByExecution struct {
+	*mock.Call
+}
+
+func (_m ExecutionEventRepoInterface_ListByExecution) Return(_a0 []models.ExecutionEvent, _a1 error) *ExecutionEventRepoInterface_ListByExecution {
+	return &ExecutionEventRepoInterface_ListByExecution{Call: _m.Call.Return(_a0, _a1)}
+}
+
+func (_m *ExecutionEventRepoInterface) OnListByExecution(ctx context.Context, executionID interfaces.Identifier) *ExecutionEventRepoInterface_ListByExecution {
+	c := _m.On("ListByExecution", ctx, executionID)
+	return &ExecutionEventRepoInterface_ListByExecution{Call: c}
+}
+
+func (_m *ExecutionEventRepoInterface) OnListByExecutionMatch(matchers ...interface{}) *ExecutionEventRepoInterface_ListByExecution {
+	c := _m.On("ListByExecution", matchers...)
+	return &ExecutionEventRepoInterface_ListByExecution{Call: c}
+}
+
+// ListByExecution provides a mock function with given fields: ctx, executionID
+func (_m *ExecutionEventRepoInterface) ListByExecution(ctx context.Context, executionID interfaces.Identifier) ([]models.ExecutionEvent, error) {
+	ret := _m.Called(ctx, executionID)
+
+	var r0 []models.ExecutionEvent
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Identifier) []models.ExecutionEvent); ok {
+		r0 = rf(ctx, executionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ExecutionEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Identifier) error); ok {
+		r1 = rf(ctx, executionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
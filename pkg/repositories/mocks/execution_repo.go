@@ -19,6 +19,8 @@ type MockExecutionRepo struct {
 	getFunction    GetExecutionFunc
 	listFunction   ListExecutionFunc
 	ExistsFunction func(ctx context.Context, input interfaces.Identifier) (bool, error)
+	// When unset, UpdateWithOutboxEvent falls back to the update callback.
+	UpdateWithOutboxEventFunction func(ctx context.Context, execution models.Execution, event models.OutboxEvent) error
 }
 
 func (r *MockExecutionRepo) Create(ctx context.Context, input models.Execution) error {
@@ -43,6 +45,14 @@ func (r *MockExecutionRepo) Update(ctx context.Context, execution models.Executi
 	return nil
 }
 
+func (r *MockExecutionRepo) UpdateWithOutboxEvent(
+	ctx context.Context, execution models.Execution, event models.OutboxEvent) error {
+	if r.UpdateWithOutboxEventFunction != nil {
+		return r.UpdateWithOutboxEventFunction(ctx, execution, event)
+	}
+	return r.Update(ctx, execution)
+}
+
 func (r *MockExecutionRepo) SetUpdateExecutionCallback(updateExecutionFunc UpdateExecutionFunc) {
 	r.updateFunction = updateExecutionFunc
 }
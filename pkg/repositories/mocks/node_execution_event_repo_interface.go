@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	interfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -15,6 +16,38 @@ type NodeExecutionEventRepoInterface struct {
 	mock.Mock
 }
 
+type NodeExecutionEventRepoInterface_BatchCreate struct {
+	*mock.Call
+}
+
+func (_m NodeExecutionEventRepoInterface_BatchCreate) Return(_a0 error) *NodeExecutionEventRepoInterface_BatchCreate {
+	return &NodeExecutionEventRepoInterface_BatchCreate{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *NodeExecutionEventRepoInterface) OnBatchCreate(ctx context.Context, inputs []models.NodeExecutionEvent) *NodeExecutionEventRepoInterface_BatchCreate {
+	c := _m.On("BatchCreate", ctx, inputs)
+	return &NodeExecutionEventRepoInterface_BatchCreate{Call: c}
+}
+
+func (_m *NodeExecutionEventRepoInterface) OnBatchCreateMatch(matchers ...interface{}) *NodeExecutionEventRepoInterface_BatchCreate {
+	c := _m.On("BatchCreate", matchers...)
+	return &NodeExecutionEventRepoInterface_BatchCreate{Call: c}
+}
+
+// BatchCreate provides a mock function with given fields: ctx, inputs
+func (_m *NodeExecutionEventRepoInterface) BatchCreate(ctx context.Context, inputs []models.NodeExecutionEvent) error {
+	ret := _m.Called(ctx, inputs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.NodeExecutionEvent) error); ok {
+		r0 = rf(ctx, inputs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type NodeExecutionEventRepoInterface_Create struct {
 	*mock.Call
 }
@@ -46,3 +79,44 @@ func (_m *NodeExecutionEventRepoInterface) Create(ctx context.Context, input mod
 
 	return r0
 }
+
+type NodeExecutionEventRepoInterface_ListByExecution struct {
+	*mock.Call
+}
+
+func (_m NodeExecutionEventRepoInterface_ListByExecution) Return(_a0 []models.NodeExecutionEvent, _a1 error) *NodeExecutionEventRepoInterface_ListByExecution {
+	return &NodeExecutionEventRepoInterface_ListByExecution{Call: _m.Call.Return(_a0, _a1)}
+}
+
+func (_m *NodeExecutionEventRepoInterface) OnListByExecution(ctx context.Context, executionID interfaces.Identifier, nodeID string) *NodeExecutionEventRepoInterface_ListByExecution {
+	c := _m.On("ListByExecution", ctx, executionID, nodeID)
+	return &NodeExecutionEventRepoInterface_ListByExecution{Call: c}
+}
+
+func (_m *NodeExecutionEventRepoInterface) OnListByExecutionMatch(matchers ...interface{}) *NodeExecutionEventRepoInterface_ListByExecution {
+	c := _m.On("ListByExecution", matchers...)
+	return &NodeExecutionEventRepoInterface_ListByExecution{Call: c}
+}
+
+// ListByExecution provides a mock function with given fields: ctx, executionID, nodeID
+func (_m *NodeExecutionEventRepoInterface) ListByExecution(ctx context.Context, executionID interfaces.Identifier, nodeID string) ([]models.NodeExecutionEvent, error) {
+	ret := _m.Called(ctx, executionID, nodeID)
+
+	var r0 []models.NodeExecutionEvent
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Identifier, string) []models.NodeExecutionEvent); ok {
+		r0 = rf(ctx, executionID, nodeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NodeExecutionEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Identifier, string) error); ok {
+		r1 = rf(ctx, executionID, nodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -22,6 +22,9 @@ type MockNodeExecutionRepo struct {
 	listFunction      ListNodeExecutionFunc
 	listEventFunction ListNodeExecutionEventFunc
 	ExistsFunction    func(ctx context.Context, input interfaces.NodeExecutionResource) (bool, error)
+	// When unset, the outbox variants fall back to the create and update callbacks.
+	CreateWithOutboxEventFunction func(ctx context.Context, input *models.NodeExecution, event models.OutboxEvent) error
+	UpdateWithOutboxEventFunction func(ctx context.Context, input *models.NodeExecution, event models.OutboxEvent) error
 }
 
 func (r *MockNodeExecutionRepo) Create(ctx context.Context, input *models.NodeExecution) error {
@@ -46,6 +49,22 @@ func (r *MockNodeExecutionRepo) SetUpdateCallback(updateFunction UpdateNodeExecu
 	r.updateFunction = updateFunction
 }
 
+func (r *MockNodeExecutionRepo) CreateWithOutboxEvent(
+	ctx context.Context, input *models.NodeExecution, event models.OutboxEvent) error {
+	if r.CreateWithOutboxEventFunction != nil {
+		return r.CreateWithOutboxEventFunction(ctx, input, event)
+	}
+	return r.Create(ctx, input)
+}
+
+func (r *MockNodeExecutionRepo) UpdateWithOutboxEvent(
+	ctx context.Context, nodeExecution *models.NodeExecution, event models.OutboxEvent) error {
+	if r.UpdateWithOutboxEventFunction != nil {
+		return r.UpdateWithOutboxEventFunction(ctx, nodeExecution, event)
+	}
+	return r.Update(ctx, nodeExecution)
+}
+
 func (r *MockNodeExecutionRepo) Get(ctx context.Context, input interfaces.NodeExecutionResource) (models.NodeExecution, error) {
 	if r.getFunction != nil {
 		return r.getFunction(ctx, input)
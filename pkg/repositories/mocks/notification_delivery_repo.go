@@ -0,0 +1,65 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type CreateNotificationDeliveryFunction func(ctx context.Context, input *models.NotificationDelivery) error
+type UpdateNotificationDeliveryFunction func(ctx context.Context, input models.NotificationDelivery) error
+type GetNotificationDeliveryFunction func(ctx context.Context, id uint) (models.NotificationDelivery, error)
+type GetQueuedNotificationDeliveryFunction func(ctx context.Context, digest string) (
+	models.NotificationDelivery, error)
+type ListNotificationDeliveriesFunction func(ctx context.Context, executionID interfaces.Identifier) (
+	[]models.NotificationDelivery, error)
+
+type MockNotificationDeliveryRepo struct {
+	CreateFunction            CreateNotificationDeliveryFunction
+	UpdateFunction            UpdateNotificationDeliveryFunction
+	GetFunction               GetNotificationDeliveryFunction
+	GetQueuedByDigestFunction GetQueuedNotificationDeliveryFunction
+	ListByExecutionFunction   ListNotificationDeliveriesFunction
+}
+
+func (r *MockNotificationDeliveryRepo) Create(ctx context.Context, input *models.NotificationDelivery) error {
+	if r.CreateFunction != nil {
+		return r.CreateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockNotificationDeliveryRepo) Update(ctx context.Context, input models.NotificationDelivery) error {
+	if r.UpdateFunction != nil {
+		return r.UpdateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockNotificationDeliveryRepo) Get(ctx context.Context, id uint) (models.NotificationDelivery, error) {
+	if r.GetFunction != nil {
+		return r.GetFunction(ctx, id)
+	}
+	return models.NotificationDelivery{}, nil
+}
+
+func (r *MockNotificationDeliveryRepo) GetQueuedByDigest(ctx context.Context, digest string) (
+	models.NotificationDelivery, error) {
+	if r.GetQueuedByDigestFunction != nil {
+		return r.GetQueuedByDigestFunction(ctx, digest)
+	}
+	return models.NotificationDelivery{}, nil
+}
+
+func (r *MockNotificationDeliveryRepo) ListByExecution(ctx context.Context, executionID interfaces.Identifier) (
+	[]models.NotificationDelivery, error) {
+	if r.ListByExecutionFunction != nil {
+		return r.ListByExecutionFunction(ctx, executionID)
+	}
+	return []models.NotificationDelivery{}, nil
+}
+
+func NewMockNotificationDeliveryRepo() interfaces.NotificationDeliveryRepoInterface {
+	return &MockNotificationDeliveryRepo{}
+}
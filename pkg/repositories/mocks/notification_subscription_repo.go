@@ -0,0 +1,72 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type CreateNotificationSubscriptionFunction func(ctx context.Context, input *models.NotificationSubscription) error
+type UpdateNotificationSubscriptionFunction func(ctx context.Context, input models.NotificationSubscription) error
+type GetNotificationSubscriptionFunction func(ctx context.Context, id uint) (models.NotificationSubscription, error)
+type DeleteNotificationSubscriptionFunction func(ctx context.Context, id uint) error
+type ListNotificationSubscriptionsFunction func(ctx context.Context, scope interfaces.NotificationSubscriptionScope) (
+	[]models.NotificationSubscription, error)
+
+type MockNotificationSubscriptionRepo struct {
+	CreateFunction       CreateNotificationSubscriptionFunction
+	UpdateFunction       UpdateNotificationSubscriptionFunction
+	GetFunction          GetNotificationSubscriptionFunction
+	DeleteFunction       DeleteNotificationSubscriptionFunction
+	ListFunction         ListNotificationSubscriptionsFunction
+	ListMatchingFunction ListNotificationSubscriptionsFunction
+}
+
+func (r *MockNotificationSubscriptionRepo) Create(ctx context.Context, input *models.NotificationSubscription) error {
+	if r.CreateFunction != nil {
+		return r.CreateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockNotificationSubscriptionRepo) Update(ctx context.Context, input models.NotificationSubscription) error {
+	if r.UpdateFunction != nil {
+		return r.UpdateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockNotificationSubscriptionRepo) Get(ctx context.Context, id uint) (models.NotificationSubscription, error) {
+	if r.GetFunction != nil {
+		return r.GetFunction(ctx, id)
+	}
+	return models.NotificationSubscription{}, nil
+}
+
+func (r *MockNotificationSubscriptionRepo) Delete(ctx context.Context, id uint) error {
+	if r.DeleteFunction != nil {
+		return r.DeleteFunction(ctx, id)
+	}
+	return nil
+}
+
+func (r *MockNotificationSubscriptionRepo) List(ctx context.Context, scope interfaces.NotificationSubscriptionScope) (
+	[]models.NotificationSubscription, error) {
+	if r.ListFunction != nil {
+		return r.ListFunction(ctx, scope)
+	}
+	return []models.NotificationSubscription{}, nil
+}
+
+func (r *MockNotificationSubscriptionRepo) ListMatching(
+	ctx context.Context, scope interfaces.NotificationSubscriptionScope) ([]models.NotificationSubscription, error) {
+	if r.ListMatchingFunction != nil {
+		return r.ListMatchingFunction(ctx, scope)
+	}
+	return []models.NotificationSubscription{}, nil
+}
+
+func NewMockNotificationSubscriptionRepo() interfaces.NotificationSubscriptionRepoInterface {
+	return &MockNotificationSubscriptionRepo{}
+}
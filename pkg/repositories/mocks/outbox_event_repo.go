@@ -0,0 +1,65 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type MockOutboxEventRepo struct {
+	ListPendingFunction     func(ctx context.Context, limit int) ([]models.OutboxEvent, error)
+	MarkPublishedFunction   func(ctx context.Context, ids []uint) error
+	RecordFailureFunction   func(ctx context.Context, id uint, errorMessage string) error
+	DeadLetterFunction      func(ctx context.Context, id uint, errorMessage string) error
+	DeletePublishedFunction func(ctx context.Context, before time.Time) (int64, error)
+	AcquireLeaseFunction    func(ctx context.Context, holder string, duration time.Duration) (bool, error)
+}
+
+func (r *MockOutboxEventRepo) ListPending(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	if r.ListPendingFunction != nil {
+		return r.ListPendingFunction(ctx, limit)
+	}
+	return nil, nil
+}
+
+func (r *MockOutboxEventRepo) MarkPublished(ctx context.Context, ids []uint) error {
+	if r.MarkPublishedFunction != nil {
+		return r.MarkPublishedFunction(ctx, ids)
+	}
+	return nil
+}
+
+func (r *MockOutboxEventRepo) RecordFailure(ctx context.Context, id uint, errorMessage string) error {
+	if r.RecordFailureFunction != nil {
+		return r.RecordFailureFunction(ctx, id, errorMessage)
+	}
+	return nil
+}
+
+func (r *MockOutboxEventRepo) DeadLetter(ctx context.Context, id uint, errorMessage string) error {
+	if r.DeadLetterFunction != nil {
+		return r.DeadLetterFunction(ctx, id, errorMessage)
+	}
+	return nil
+}
+
+func (r *MockOutboxEventRepo) DeletePublished(ctx context.Context, before time.Time) (int64, error) {
+	if r.DeletePublishedFunction != nil {
+		return r.DeletePublishedFunction(ctx, before)
+	}
+	return 0, nil
+}
+
+// Acquires the lease unless overridden.
+func (r *MockOutboxEventRepo) AcquireLease(ctx context.Context, holder string, duration time.Duration) (bool, error) {
+	if r.AcquireLeaseFunction != nil {
+		return r.AcquireLeaseFunction(ctx, holder, duration)
+	}
+	return true, nil
+}
+
+func NewMockOutboxEventRepo() interfaces.OutboxEventRepoInterface {
+	return &MockOutboxEventRepo{}
+}
@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	sIface "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
@@ -18,9 +20,23 @@ type MockRepository struct {
 	projectRepo                   interfaces.ProjectRepoInterface
 	resourceRepo                  interfaces.ResourceRepoInterface
 	taskExecutionRepo             interfaces.TaskExecutionRepoInterface
+	TaskExecutionEventRepoIface   interfaces.TaskExecutionEventRepoInterface
 	namedEntityRepo               interfaces.NamedEntityRepoInterface
+	notificationDeliveryRepo      interfaces.NotificationDeliveryRepoInterface
+	notificationSubscriptionRepo  interfaces.NotificationSubscriptionRepoInterface
+	outboxEventRepo               interfaces.OutboxEventRepoInterface
+	roleBindingRepo               interfaces.RoleBindingRepoInterface
+	accessTokenRepo               interfaces.AccessTokenRepoInterface
+	revokedTokenRepo              interfaces.RevokedTokenRepoInterface
+	auditLogRepo                  interfaces.AuditLogRepoInterface
+	signingKeyRingRepo            interfaces.SigningKeyRingRepoInterface
 	schedulableEntityRepo         sIface.SchedulableEntityRepoInterface
 	schedulableEntitySnapshotRepo sIface.ScheduleEntitiesSnapShotRepoInterface
+	ReadinessError                error
+}
+
+func (r *MockRepository) CheckReadiness(ctx context.Context) error {
+	return r.ReadinessError
 }
 
 func (r *MockRepository) SchedulableEntityRepo() sIface.SchedulableEntityRepoInterface {
@@ -71,10 +87,46 @@ func (r *MockRepository) TaskExecutionRepo() interfaces.TaskExecutionRepoInterfa
 	return r.taskExecutionRepo
 }
 
+func (r *MockRepository) TaskExecutionEventRepo() interfaces.TaskExecutionEventRepoInterface {
+	return r.TaskExecutionEventRepoIface
+}
+
 func (r *MockRepository) NamedEntityRepo() interfaces.NamedEntityRepoInterface {
 	return r.namedEntityRepo
 }
 
+func (r *MockRepository) NotificationDeliveryRepo() interfaces.NotificationDeliveryRepoInterface {
+	return r.notificationDeliveryRepo
+}
+
+func (r *MockRepository) NotificationSubscriptionRepo() interfaces.NotificationSubscriptionRepoInterface {
+	return r.notificationSubscriptionRepo
+}
+
+func (r *MockRepository) OutboxEventRepo() interfaces.OutboxEventRepoInterface {
+	return r.outboxEventRepo
+}
+
+func (r *MockRepository) RoleBindingRepo() interfaces.RoleBindingRepoInterface {
+	return r.roleBindingRepo
+}
+
+func (r *MockRepository) AccessTokenRepo() interfaces.AccessTokenRepoInterface {
+	return r.accessTokenRepo
+}
+
+func (r *MockRepository) RevokedTokenRepo() interfaces.RevokedTokenRepoInterface {
+	return r.revokedTokenRepo
+}
+
+func (r *MockRepository) AuditLogRepo() interfaces.AuditLogRepoInterface {
+	return r.auditLogRepo
+}
+
+func (r *MockRepository) SigningKeyRingRepo() interfaces.SigningKeyRingRepoInterface {
+	return r.signingKeyRingRepo
+}
+
 func NewMockRepository() repositories.RepositoryInterface {
 	return &MockRepository{
 		taskRepo:                      NewMockTaskRepo(),
@@ -86,8 +138,17 @@ func NewMockRepository() repositories.RepositoryInterface {
 		resourceRepo:                  NewMockResourceRepo(),
 		taskExecutionRepo:             NewMockTaskExecutionRepo(),
 		namedEntityRepo:               NewMockNamedEntityRepo(),
+		notificationDeliveryRepo:      NewMockNotificationDeliveryRepo(),
+		notificationSubscriptionRepo:  NewMockNotificationSubscriptionRepo(),
+		outboxEventRepo:               NewMockOutboxEventRepo(),
+		roleBindingRepo:               NewMockRoleBindingRepo(),
+		accessTokenRepo:               NewMockAccessTokenRepo(),
+		revokedTokenRepo:              NewMockRevokedTokenRepo(),
+		auditLogRepo:                  NewMockAuditLogRepo(),
+		signingKeyRingRepo:            NewMockSigningKeyRingRepo(),
 		ExecutionEventRepoIface:       &ExecutionEventRepoInterface{},
 		NodeExecutionEventRepoIface:   &NodeExecutionEventRepoInterface{},
+		TaskExecutionEventRepoIface:   &TaskExecutionEventRepoInterface{},
 		schedulableEntityRepo:         &sMocks.SchedulableEntityRepoInterface{},
 		schedulableEntitySnapshotRepo: &sMocks.ScheduleEntitiesSnapShotRepoInterface{},
 	}
@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+)
+
+type RevokeTokenFunction func(ctx context.Context, id string, expiresAt time.Time) error
+type IsTokenRevokedFunction func(ctx context.Context, ids ...string) (bool, error)
+
+type MockRevokedTokenRepo struct {
+	RevokeTokenFunction    RevokeTokenFunction
+	IsTokenRevokedFunction IsTokenRevokedFunction
+}
+
+func (r *MockRevokedTokenRepo) RevokeToken(ctx context.Context, id string, expiresAt time.Time) error {
+	if r.RevokeTokenFunction != nil {
+		return r.RevokeTokenFunction(ctx, id, expiresAt)
+	}
+	return nil
+}
+
+func (r *MockRevokedTokenRepo) IsTokenRevoked(ctx context.Context, ids ...string) (bool, error) {
+	if r.IsTokenRevokedFunction != nil {
+		return r.IsTokenRevokedFunction(ctx, ids...)
+	}
+	return false, nil
+}
+
+func NewMockRevokedTokenRepo() interfaces.RevokedTokenRepoInterface {
+	return &MockRevokedTokenRepo{}
+}
@@ -0,0 +1,63 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type CreateRoleBindingFunction func(ctx context.Context, input *models.RoleBinding) error
+type GetRoleBindingFunction func(ctx context.Context, id uint) (models.RoleBinding, error)
+type DeleteRoleBindingFunction func(ctx context.Context, id uint) error
+type ListRoleBindingsFunction func(ctx context.Context, project, domain string) ([]models.RoleBinding, error)
+type ListMatchingRoleBindingsFunction func(ctx context.Context, subjects []interfaces.RoleBindingSubject,
+	project, domain string) ([]models.RoleBinding, error)
+
+type MockRoleBindingRepo struct {
+	CreateFunction       CreateRoleBindingFunction
+	GetFunction          GetRoleBindingFunction
+	DeleteFunction       DeleteRoleBindingFunction
+	ListFunction         ListRoleBindingsFunction
+	ListMatchingFunction ListMatchingRoleBindingsFunction
+}
+
+func (r *MockRoleBindingRepo) Create(ctx context.Context, input *models.RoleBinding) error {
+	if r.CreateFunction != nil {
+		return r.CreateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockRoleBindingRepo) Get(ctx context.Context, id uint) (models.RoleBinding, error) {
+	if r.GetFunction != nil {
+		return r.GetFunction(ctx, id)
+	}
+	return models.RoleBinding{}, nil
+}
+
+func (r *MockRoleBindingRepo) Delete(ctx context.Context, id uint) error {
+	if r.DeleteFunction != nil {
+		return r.DeleteFunction(ctx, id)
+	}
+	return nil
+}
+
+func (r *MockRoleBindingRepo) List(ctx context.Context, project, domain string) ([]models.RoleBinding, error) {
+	if r.ListFunction != nil {
+		return r.ListFunction(ctx, project, domain)
+	}
+	return []models.RoleBinding{}, nil
+}
+
+func (r *MockRoleBindingRepo) ListMatching(ctx context.Context, subjects []interfaces.RoleBindingSubject,
+	project, domain string) ([]models.RoleBinding, error) {
+	if r.ListMatchingFunction != nil {
+		return r.ListMatchingFunction(ctx, subjects, project, domain)
+	}
+	return []models.RoleBinding{}, nil
+}
+
+func NewMockRoleBindingRepo() interfaces.RoleBindingRepoInterface {
+	return &MockRoleBindingRepo{}
+}
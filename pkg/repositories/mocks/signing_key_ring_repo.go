@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+)
+
+type GetSigningKeysFunction func(ctx context.Context) (string, uint32, error)
+type SetSigningKeysFunction func(ctx context.Context, keyRing string, version uint32) (bool, error)
+
+type MockSigningKeyRingRepo struct {
+	GetSigningKeysFunction GetSigningKeysFunction
+	SetSigningKeysFunction SetSigningKeysFunction
+}
+
+func (r *MockSigningKeyRingRepo) GetSigningKeys(ctx context.Context) (string, uint32, error) {
+	if r.GetSigningKeysFunction != nil {
+		return r.GetSigningKeysFunction(ctx)
+	}
+	return "", 0, nil
+}
+
+func (r *MockSigningKeyRingRepo) SetSigningKeys(ctx context.Context, keyRing string, version uint32) (bool, error) {
+	if r.SetSigningKeysFunction != nil {
+		return r.SetSigningKeysFunction(ctx, keyRing, version)
+	}
+	return true, nil
+}
+
+func NewMockSigningKeyRingRepo() interfaces.SigningKeyRingRepoInterface {
+	return &MockSigningKeyRingRepo{}
+}
@@ -17,6 +17,9 @@ type MockTaskExecutionRepo struct {
 	getFunction    GetTaskExecutionFunc
 	updateFunction UpdateTaskExecutionFunc
 	listFunction   ListTaskExecutionFunc
+	// When unset, the outbox variants fall back to the create and update callbacks.
+	CreateWithOutboxEventFunction func(ctx context.Context, input models.TaskExecution, event models.OutboxEvent) error
+	UpdateWithOutboxEventFunction func(ctx context.Context, input models.TaskExecution, event models.OutboxEvent) error
 }
 
 func (r *MockTaskExecutionRepo) Create(ctx context.Context, input models.TaskExecution) error {
@@ -52,6 +55,22 @@ func (r *MockTaskExecutionRepo) SetUpdateCallback(updateFunction UpdateTaskExecu
 	r.updateFunction = updateFunction
 }
 
+func (r *MockTaskExecutionRepo) CreateWithOutboxEvent(
+	ctx context.Context, input models.TaskExecution, event models.OutboxEvent) error {
+	if r.CreateWithOutboxEventFunction != nil {
+		return r.CreateWithOutboxEventFunction(ctx, input, event)
+	}
+	return r.Create(ctx, input)
+}
+
+func (r *MockTaskExecutionRepo) UpdateWithOutboxEvent(
+	ctx context.Context, execution models.TaskExecution, event models.OutboxEvent) error {
+	if r.UpdateWithOutboxEventFunction != nil {
+		return r.UpdateWithOutboxEventFunction(ctx, execution, event)
+	}
+	return r.Update(ctx, execution)
+}
+
 func (r *MockTaskExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
 	if r.listFunction != nil {
 		return r.listFunction(ctx, input)
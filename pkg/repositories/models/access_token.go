@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Database model to encapsulate a personal access token or a service account token. Only the hash of the token is
+// stored, the token itself is returned once when it is created.
+type AccessToken struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Hex encoded SHA-256 hash of the token.
+	TokenHash string `gorm:"unique_index" valid:"length(0|255)"`
+	Name      string `valid:"length(0|255)"`
+	// The user who created the token. Personal access tokens authenticate as their owner.
+	Owner string `gorm:"index" valid:"length(0|255)"`
+	// Set for service account tokens, which authenticate as an app of this name.
+	ServiceAccount string `valid:"length(0|255)"`
+	// Space separated scopes granted to the token.
+	Scopes string
+	// JSON encoded claims roles can be bound to, e.g. the groups of the owner, as of the creation of a personal access
+	// token. Unset for service account tokens.
+	Claims []byte
+	// The email address of the owner of a personal access token as of its creation.
+	Email     string `valid:"length(0|255)"`
+	ExpiresAt time.Time
+	// Set once the token is revoked.
+	RevokedAt *time.Time
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// Database model of an audit entry recording a request issued to the admin service and its response.
+type AuditLog struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	// The authenticated end-user, and the client which initiated the auth flow, that issued the request.
+	Subject       string `gorm:"index" valid:"length(0|255)"`
+	ClientID      string `valid:"length(0|255)"`
+	TokenIssuedAt *time.Time
+	ClientIP      string `valid:"length(0|255)"`
+	// The service method e.g. TerminateExecution.
+	Method string `gorm:"index" valid:"length(0|255)"`
+	// Whether the request was read only (0) or read write (1).
+	Mode    int
+	Project string `gorm:"index:audit_log_project_domain_idx" valid:"length(0|255)"`
+	Domain  string `gorm:"index:audit_log_project_domain_idx" valid:"length(0|255)"`
+	// The JSON serialized request parameters, after redaction.
+	Parameters   []byte
+	ResponseCode string    `valid:"length(0|255)"`
+	ReceivedAt   time.Time `gorm:"index"`
+	SentAt       time.Time
+}
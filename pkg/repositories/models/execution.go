@@ -59,4 +59,8 @@ type Execution struct {
 	// The user responsible for launching this execution.
 	// This is also stored in the spec but promoted as a column for filtering.
 	User string `gorm:"index" valid:"length(0|255)"`
+	// The location of the closure in blob storage when it was offloaded there, in which case Closure is empty.
+	ClosureReference storage.DataReference
+	// Incremented by every update, which only applies when the version is still the one the updated model was read at.
+	StateVersion uint32
 }
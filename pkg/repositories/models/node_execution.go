@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"github.com/flyteorg/flytestdlib/storage"
 )
 
 // IMPORTANT: If you update the model below, be sure to double check model definitions in
@@ -49,4 +51,8 @@ type NodeExecution struct {
 	CacheStatus *string
 	// In the case of dynamic workflow nodes, the remote closure is uploaded to the path specified here.
 	DynamicWorkflowRemoteClosureReference string
+	// The location of the closure in blob storage when it was offloaded there, in which case Closure is empty.
+	ClosureReference storage.DataReference
+	// Incremented by every update, which only applies when the version is still the one the updated model was read at.
+	StateVersion uint32
 }
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// Notification delivery statuses.
+const (
+	// The notification has been published and is waiting to be picked up by the processor.
+	NotificationDeliveryQueued = "QUEUED"
+	// A delivery attempt failed and the processor will try again.
+	NotificationDeliveryRetrying  = "RETRYING"
+	NotificationDeliverySucceeded = "SUCCEEDED"
+	// All delivery attempts failed, or the notification couldn't be published in the first place.
+	NotificationDeliveryFailed = "FAILED"
+)
+
+// Notification delivery channels. Slack and PagerDuty notifications are delivered by email too.
+const (
+	NotificationChannelEmail     = "email"
+	NotificationChannelPagerDuty = "pagerduty"
+	NotificationChannelSlack     = "slack"
+)
+
+// Database model to encapsulate the delivery history of a single workflow execution notification.
+type NotificationDelivery struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time `sql:"index"`
+	// Deliveries picked up by the processor which weren't recorded at publish time have no execution.
+	ExecutionProject string `gorm:"index:notification_delivery_execution_idx" valid:"length(0|255)"`
+	ExecutionDomain  string `gorm:"index:notification_delivery_execution_idx" valid:"length(0|255)"`
+	ExecutionName    string `gorm:"index:notification_delivery_execution_idx" valid:"length(0|255)"`
+	Channel          string `valid:"length(0|255)"`
+	// Comma-separated recipient email addresses.
+	Recipients string
+	// Serialized flyteidl.admin.EmailMessage, kept so that failed deliveries can be re-sent.
+	Message []byte
+	// Hex-encoded SHA-256 digest of Message, used by the processor to find the delivery for a dequeued message.
+	MessageDigest string `gorm:"index" valid:"length(0|255)"`
+	Status        string `gorm:"index" valid:"length(0|255)"`
+	Attempts      int
+	// The error encountered on the most recent failed attempt.
+	Error         string
+	LastAttemptAt *time.Time
+}
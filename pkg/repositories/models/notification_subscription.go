@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// Database model to encapsulate a user's subscription to the workflow execution notifications of a project and domain,
+// optionally narrowed down to a workflow and/or launch plan name.
+type NotificationSubscription struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Project   string `gorm:"unique_index:notification_subscription_idx" valid:"length(0|255)"`
+	Domain    string `gorm:"unique_index:notification_subscription_idx" valid:"length(0|255)"`
+	// Empty when the subscription applies to all workflows.
+	Workflow string `gorm:"unique_index:notification_subscription_idx" valid:"length(0|255)"`
+	// Empty when the subscription applies to all launch plans.
+	LaunchPlan string `gorm:"unique_index:notification_subscription_idx" valid:"length(0|255)"`
+	Channel    string `gorm:"unique_index:notification_subscription_idx" valid:"length(0|255)"`
+	// The email address or Slack handle to notify.
+	Recipient string `gorm:"unique_index:notification_subscription_idx" valid:"length(0|255)"`
+	// Comma-separated workflow execution phase names.
+	Phases string
+	// The authenticated user who created the subscription, if any.
+	CreatedBy string `valid:"length(0|255)"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Database model for an external event waiting to be relayed to the events publisher. Outbox events are written in
+// the same transaction as the execution state change they describe, so that events are published if and only if the
+// change is committed.
+type OutboxEvent struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	// The proto message name of the event request, which doubles as the publish key.
+	EventType string `gorm:"not null" valid:"length(0|255)"`
+	// Events sharing a partition key, i.e. belonging to the same execution, are published in order.
+	PartitionKey string `valid:"length(0|255)"`
+	// The serialized event request.
+	Payload []byte `gorm:"not null"`
+	// Unset until the event has been published.
+	PublishedAt *time.Time `gorm:"index"`
+	// The number of failed publish attempts.
+	Attempts  uint32
+	LastError string
+	// Set once the relay gives up on publishing the event, which is kept for inspection but no longer relayed.
+	DeadLetteredAt *time.Time
+}
+
+// The ID of the single row of the outbox relay leases table.
+const OutboxRelayLeaseID = 1
+
+// Database model of the lease held by the flyteadmin replica which relays the outbox. Only the holder relays events,
+// so that concurrent relays neither publish the same events nor publish the events of an execution out of order.
+type OutboxRelayLease struct {
+	ID        uint   `gorm:"primary_key"`
+	Holder    string `gorm:"not null" valid:"length(0|255)"`
+	ExpiresAt time.Time
+}
@@ -27,3 +27,10 @@ type Resource struct {
 	// Serialized flyteidl.admin.MatchingAttributes.
 	Attributes []byte
 }
+
+// Resource types which aren't (yet) defined as a flyteidl.admin.MatchableResource. Their attributes are stored as
+// JSON rather than as serialized flyteidl.admin.MatchingAttributes.
+const (
+	NotificationTemplateResourceType = "NOTIFICATION_TEMPLATE"
+	NotificationRuleResourceType     = "NOTIFICATION_RULE"
+)
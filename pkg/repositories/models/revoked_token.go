@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// Database model of a revoked OAuth2 token, or of an authorization grant all tokens of which are revoked. Entries are
+// only kept until the tokens they revoke expire.
+type RevokedToken struct {
+	// The ID (jti claim) of the token or the ID of the grant.
+	ID        string `gorm:"primary_key" valid:"length(0|255)"`
+	CreatedAt time.Time
+	ExpiresAt time.Time `gorm:"index"`
+}
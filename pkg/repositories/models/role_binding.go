@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// Role binding subject types.
+const (
+	RoleBindingSubjectUser        = "user"
+	RoleBindingSubjectApp         = "app"
+	RoleBindingSubjectGroup       = "group"
+	RoleBindingSubjectEmailDomain = "emailDomain"
+)
+
+// Roles, each of which grants the permissions of the previous ones. Viewers may read entities and executions,
+// contributors may also register entities and launch and terminate executions, and admins may also change the
+// configuration of projects and domains and manage their role bindings.
+const (
+	RoleViewer      = "viewer"
+	RoleContributor = "contributor"
+	RoleAdmin       = "admin"
+)
+
+// Database model to encapsulate a role bound to a user, app or group in a project and domain. Bindings with an empty
+// domain apply to all domains of the project, and those with an empty project to all projects.
+type RoleBinding struct {
+	ID        uint `gorm:"AUTO_INCREMENT;column:id;primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// One of "user", "app", "group" or "emailDomain".
+	SubjectType string `gorm:"unique_index:role_binding_idx" valid:"length(0|255)"`
+	// The user or app ID, the group name or the email domain.
+	Subject string `gorm:"unique_index:role_binding_idx" valid:"length(0|255)"`
+	Project string `gorm:"unique_index:role_binding_idx" valid:"length(0|255)"`
+	Domain  string `gorm:"unique_index:role_binding_idx" valid:"length(0|255)"`
+	// One of "viewer", "contributor" or "admin".
+	Role string `valid:"length(0|255)"`
+	// The authenticated user who created the binding, if any.
+	CreatedBy string `valid:"length(0|255)"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// The ID of the single row of the signing key rings table.
+const SigningKeyRingID = 1
+
+// Database model of the token signing key ring shared by the replicas of the self-hosted authorization server. The
+// replica which rotates the keys increments the state version, so that concurrent rotations don't overwrite it.
+type SigningKeyRing struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// The key ring, encrypted by the authorization server.
+	KeyRing      []byte `gorm:"not null"`
+	StateVersion uint32
+}
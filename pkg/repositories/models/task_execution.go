@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"github.com/flyteorg/flytestdlib/storage"
 )
 
 // IMPORTANT: If you update the model below, be sure to double check model definitions in
@@ -36,4 +38,8 @@ type TaskExecution struct {
 	Duration               time.Duration
 	// The child node executions (if any) launched by this task execution.
 	ChildNodeExecution []NodeExecution `gorm:"foreignkey:ParentTaskExecutionID"`
+	// The location of the closure in blob storage when it was offloaded there, in which case Closure is empty.
+	ClosureReference storage.DataReference
+	// Incremented by every update, which only applies when the version is still the one the updated model was read at.
+	StateVersion uint32
 }
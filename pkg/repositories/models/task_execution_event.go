@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+)
+
+type TaskExecutionEvent struct {
+	BaseModel
+	TaskExecutionKey
+	RequestID    string
+	OccurredAt   time.Time
+	Phase        string `gorm:"primary_key"`
+	PhaseVersion uint32 `gorm:"primary_key;AUTO_INCREMENT:FALSE"`
+}
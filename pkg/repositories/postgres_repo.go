@@ -1,6 +1,10 @@
 package repositories
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/gormimpl"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
@@ -11,6 +15,7 @@ import (
 )
 
 type PostgresRepo struct {
+	db                           *gorm.DB
 	executionRepo                interfaces.ExecutionRepoInterface
 	executionEventRepo           interfaces.ExecutionEventRepoInterface
 	namedEntityRepo              interfaces.NamedEntityRepoInterface
@@ -20,8 +25,17 @@ type PostgresRepo struct {
 	nodeExecutionEventRepo       interfaces.NodeExecutionEventRepoInterface
 	taskRepo                     interfaces.TaskRepoInterface
 	taskExecutionRepo            interfaces.TaskExecutionRepoInterface
+	taskExecutionEventRepo       interfaces.TaskExecutionEventRepoInterface
 	workflowRepo                 interfaces.WorkflowRepoInterface
 	resourceRepo                 interfaces.ResourceRepoInterface
+	notificationDeliveryRepo     interfaces.NotificationDeliveryRepoInterface
+	notificationSubscriptionRepo interfaces.NotificationSubscriptionRepoInterface
+	outboxEventRepo              interfaces.OutboxEventRepoInterface
+	roleBindingRepo              interfaces.RoleBindingRepoInterface
+	accessTokenRepo              interfaces.AccessTokenRepoInterface
+	revokedTokenRepo             interfaces.RevokedTokenRepoInterface
+	auditLogRepo                 interfaces.AuditLogRepoInterface
+	signingKeyRingRepo           interfaces.SigningKeyRingRepoInterface
 	schedulableEntityRepo        schedulerInterfaces.SchedulableEntityRepoInterface
 	scheduleEntitiesSnapshotRepo schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
 }
@@ -62,6 +76,10 @@ func (p *PostgresRepo) TaskExecutionRepo() interfaces.TaskExecutionRepoInterface
 	return p.taskExecutionRepo
 }
 
+func (p *PostgresRepo) TaskExecutionEventRepo() interfaces.TaskExecutionEventRepoInterface {
+	return p.taskExecutionEventRepo
+}
+
 func (p *PostgresRepo) WorkflowRepo() interfaces.WorkflowRepoInterface {
 	return p.workflowRepo
 }
@@ -70,6 +88,38 @@ func (p *PostgresRepo) ResourceRepo() interfaces.ResourceRepoInterface {
 	return p.resourceRepo
 }
 
+func (p *PostgresRepo) NotificationDeliveryRepo() interfaces.NotificationDeliveryRepoInterface {
+	return p.notificationDeliveryRepo
+}
+
+func (p *PostgresRepo) NotificationSubscriptionRepo() interfaces.NotificationSubscriptionRepoInterface {
+	return p.notificationSubscriptionRepo
+}
+
+func (p *PostgresRepo) OutboxEventRepo() interfaces.OutboxEventRepoInterface {
+	return p.outboxEventRepo
+}
+
+func (p *PostgresRepo) RoleBindingRepo() interfaces.RoleBindingRepoInterface {
+	return p.roleBindingRepo
+}
+
+func (p *PostgresRepo) AccessTokenRepo() interfaces.AccessTokenRepoInterface {
+	return p.accessTokenRepo
+}
+
+func (p *PostgresRepo) RevokedTokenRepo() interfaces.RevokedTokenRepoInterface {
+	return p.revokedTokenRepo
+}
+
+func (p *PostgresRepo) AuditLogRepo() interfaces.AuditLogRepoInterface {
+	return p.auditLogRepo
+}
+
+func (p *PostgresRepo) SigningKeyRingRepo() interfaces.SigningKeyRingRepoInterface {
+	return p.signingKeyRingRepo
+}
+
 func (p *PostgresRepo) SchedulableEntityRepo() schedulerInterfaces.SchedulableEntityRepoInterface {
 	return p.schedulableEntityRepo
 }
@@ -78,8 +128,23 @@ func (p *PostgresRepo) ScheduleEntitiesSnapshotRepo() schedulerInterfaces.Schedu
 	return p.scheduleEntitiesSnapshotRepo
 }
 
+func (p *PostgresRepo) CheckReadiness(ctx context.Context) error {
+	if err := p.db.DB().PingContext(ctx); err != nil {
+		return fmt.Errorf("database is unreachable: %w", err)
+	}
+	current, err := config.IsSchemaCurrent(p.db)
+	if err != nil {
+		return fmt.Errorf("failed to read the applied migrations: %w", err)
+	}
+	if !current {
+		return fmt.Errorf("database has pending migrations")
+	}
+	return nil
+}
+
 func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) RepositoryInterface {
 	return &PostgresRepo{
+		db:                           db,
 		executionRepo:                gormimpl.NewExecutionRepo(db, errorTransformer, scope.NewSubScope("executions")),
 		executionEventRepo:           gormimpl.NewExecutionEventRepo(db, errorTransformer, scope.NewSubScope("execution_events")),
 		launchPlanRepo:               gormimpl.NewLaunchPlanRepo(db, errorTransformer, scope.NewSubScope("launch_plans")),
@@ -89,8 +154,18 @@ func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scop
 		nodeExecutionEventRepo:       gormimpl.NewNodeExecutionEventRepo(db, errorTransformer, scope.NewSubScope("node_execution_events")),
 		taskRepo:                     gormimpl.NewTaskRepo(db, errorTransformer, scope.NewSubScope("tasks")),
 		taskExecutionRepo:            gormimpl.NewTaskExecutionRepo(db, errorTransformer, scope.NewSubScope("task_executions")),
+		taskExecutionEventRepo:       gormimpl.NewTaskExecutionEventRepo(db, errorTransformer, scope.NewSubScope("task_execution_events")),
 		workflowRepo:                 gormimpl.NewWorkflowRepo(db, errorTransformer, scope.NewSubScope("workflows")),
 		resourceRepo:                 gormimpl.NewResourceRepo(db, errorTransformer, scope.NewSubScope("resources")),
+		notificationDeliveryRepo:     gormimpl.NewNotificationDeliveryRepo(db, errorTransformer, scope.NewSubScope("notification_deliveries")),
+		notificationSubscriptionRepo: gormimpl.NewNotificationSubscriptionRepo(db, errorTransformer, scope.NewSubScope("notification_subscriptions")),
+		outboxEventRepo:              gormimpl.NewOutboxEventRepo(db, errorTransformer, scope.NewSubScope("outbox_events")),
+		roleBindingRepo:              gormimpl.NewRoleBindingRepo(db, errorTransformer, scope.NewSubScope("role_bindings")),
+		accessTokenRepo:              gormimpl.NewAccessTokenRepo(db, errorTransformer, scope.NewSubScope("access_tokens")),
+		revokedTokenRepo:             gormimpl.NewRevokedTokenRepo(db, errorTransformer, scope.NewSubScope("revoked_tokens")),
+		auditLogRepo:                 gormimpl.NewAuditLogRepo(db, errorTransformer, scope.NewSubScope("audit_logs")),
+		signingKeyRingRepo: gormimpl.NewSigningKeyRingRepo(db, errorTransformer,
+			scope.NewSubScope("signing_key_rings")),
 		schedulableEntityRepo:        schedulerGormImpl.NewSchedulableEntityRepo(db, errorTransformer, scope.NewSubScope("schedulable_entity")),
 		scheduleEntitiesSnapshotRepo: schedulerGormImpl.NewScheduleEntitiesSnapshotRepo(db, errorTransformer, scope.NewSubScope("schedule_entities_snapshot")),
 	}
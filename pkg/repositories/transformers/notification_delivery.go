@@ -0,0 +1,61 @@
+package transformers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+)
+
+const recipientsSeparator = ","
+
+// Returns the digest used to match a serialized notification message to its delivery.
+func NotificationMessageDigest(message []byte) string {
+	digest := sha256.Sum256(message)
+	return hex.EncodeToString(digest[:])
+}
+
+// Transforms an email message about to be published into a queued NotificationDelivery model. The execution
+// identifier may be nil when the originating execution is unknown.
+func CreateNotificationDeliveryModel(executionID *core.WorkflowExecutionIdentifier, channel string,
+	email *admin.EmailMessage) (models.NotificationDelivery, error) {
+	message, err := proto.Marshal(email)
+	if err != nil {
+		return models.NotificationDelivery{}, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to marshal notification message: %v", err)
+	}
+	return models.NotificationDelivery{
+		ExecutionProject: executionID.GetProject(),
+		ExecutionDomain:  executionID.GetDomain(),
+		ExecutionName:    executionID.GetName(),
+		Channel:          channel,
+		Recipients:       strings.Join(email.RecipientsEmail, recipientsSeparator),
+		Message:          message,
+		MessageDigest:    NotificationMessageDigest(message),
+		Status:           models.NotificationDeliveryQueued,
+	}, nil
+}
+
+// Returns the recipient email addresses of a NotificationDelivery model.
+func FromNotificationDeliveryModelRecipients(model models.NotificationDelivery) []string {
+	if len(model.Recipients) == 0 {
+		return nil
+	}
+	return strings.Split(model.Recipients, recipientsSeparator)
+}
+
+// Returns the email message stored with a NotificationDelivery model.
+func FromNotificationDeliveryModelToEmailMessage(model models.NotificationDelivery) (*admin.EmailMessage, error) {
+	var email admin.EmailMessage
+	if err := proto.Unmarshal(model.Message, &email); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal message for notification delivery [%d]: %v", model.ID, err)
+	}
+	return &email, nil
+}
@@ -0,0 +1,58 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var notificationEmail = &admin.EmailMessage{
+	RecipientsEmail: []string{"a@example.com", "b@example.com"},
+	SenderEmail:     "no-reply@example.com",
+	SubjectLine:     "subject",
+	Body:            "body",
+}
+
+func TestCreateNotificationDeliveryModel(t *testing.T) {
+	model, err := CreateNotificationDeliveryModel(&core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}, "slack", notificationEmail)
+	assert.NoError(t, err)
+	message, _ := proto.Marshal(notificationEmail)
+	assert.Equal(t, models.NotificationDelivery{
+		ExecutionProject: "project",
+		ExecutionDomain:  "domain",
+		ExecutionName:    "name",
+		Channel:          "slack",
+		Recipients:       "a@example.com,b@example.com",
+		Message:          message,
+		MessageDigest:    NotificationMessageDigest(message),
+		Status:           models.NotificationDeliveryQueued,
+	}, model)
+	assert.Len(t, model.MessageDigest, 64)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, FromNotificationDeliveryModelRecipients(model))
+
+	email, err := FromNotificationDeliveryModelToEmailMessage(model)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(notificationEmail, email))
+}
+
+func TestCreateNotificationDeliveryModel_NoExecution(t *testing.T) {
+	model, err := CreateNotificationDeliveryModel(nil, "email", &admin.EmailMessage{})
+	assert.NoError(t, err)
+	assert.Empty(t, model.ExecutionName)
+	assert.Empty(t, FromNotificationDeliveryModelRecipients(model))
+}
+
+func TestFromNotificationDeliveryModelToEmailMessage_Error(t *testing.T) {
+	_, err := FromNotificationDeliveryModelToEmailMessage(models.NotificationDelivery{Message: []byte("atreyu")})
+	assert.Equal(t, codes.Internal, err.(errors.FlyteAdminError).Code())
+}
@@ -0,0 +1,33 @@
+package transformers
+
+import (
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+const phasesSeparator = ","
+
+// Transforms a subscription to the workflow execution phases of a scope into a NotificationSubscription model.
+func CreateNotificationSubscriptionModel(scope interfaces.NotificationSubscriptionScope, channel, recipient string,
+	phases []string, createdBy string) models.NotificationSubscription {
+	return models.NotificationSubscription{
+		Project:    scope.Project,
+		Domain:     scope.Domain,
+		Workflow:   scope.Workflow,
+		LaunchPlan: scope.LaunchPlan,
+		Channel:    channel,
+		Recipient:  recipient,
+		Phases:     strings.Join(phases, phasesSeparator),
+		CreatedBy:  createdBy,
+	}
+}
+
+// Returns the workflow execution phase names a notification subscription applies to.
+func FromNotificationSubscriptionModelPhases(model models.NotificationSubscription) []string {
+	if len(model.Phases) == 0 {
+		return []string{}
+	}
+	return strings.Split(model.Phases, phasesSeparator)
+}
@@ -0,0 +1,28 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNotificationSubscriptionModel(t *testing.T) {
+	model := CreateNotificationSubscriptionModel(interfaces.NotificationSubscriptionScope{
+		Project:    "project",
+		Domain:     "domain",
+		LaunchPlan: "launch_plan",
+	}, models.NotificationChannelSlack, "@oncall", []string{"FAILED", "TIMED_OUT"}, "user")
+	assert.Equal(t, models.NotificationSubscription{
+		Project:    "project",
+		Domain:     "domain",
+		LaunchPlan: "launch_plan",
+		Channel:    models.NotificationChannelSlack,
+		Recipient:  "@oncall",
+		Phases:     "FAILED,TIMED_OUT",
+		CreatedBy:  "user",
+	}, model)
+	assert.Equal(t, []string{"FAILED", "TIMED_OUT"}, FromNotificationSubscriptionModelPhases(model))
+	assert.Empty(t, FromNotificationSubscriptionModelPhases(models.NotificationSubscription{}))
+}
@@ -0,0 +1,39 @@
+package transformers
+
+import (
+	"reflect"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+)
+
+// Transforms an event request into an OutboxEvent model, published under its proto message name.
+func CreateOutboxEventModel(partitionKey string, request proto.Message) (models.OutboxEvent, error) {
+	payload, err := proto.Marshal(request)
+	if err != nil {
+		return models.OutboxEvent{}, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to marshal outbox event with err: %v", err)
+	}
+	return models.OutboxEvent{
+		EventType:    proto.MessageName(request),
+		PartitionKey: partitionKey,
+		Payload:      payload,
+	}, nil
+}
+
+// Returns the event request stored in an OutboxEvent model.
+func FromOutboxEventModel(model models.OutboxEvent) (proto.Message, error) {
+	messageType := proto.MessageType(model.EventType)
+	if messageType == nil || messageType.Kind() != reflect.Ptr {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"unknown outbox event type [%s] for event [%d]", model.EventType, model.ID)
+	}
+	request := reflect.New(messageType.Elem()).Interface().(proto.Message)
+	if err := proto.Unmarshal(model.Payload, request); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal outbox event [%d] with err: %v", model.ID, err)
+	}
+	return request, nil
+}
@@ -0,0 +1,45 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutboxEventModel(t *testing.T) {
+	request := &admin.NodeExecutionEventRequest{
+		RequestId: "request id",
+		Event: &event.NodeExecutionEvent{
+			Id: &core.NodeExecutionIdentifier{
+				NodeId: "node",
+				ExecutionId: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+			},
+			Phase: core.NodeExecution_RUNNING,
+		},
+	}
+	model, err := CreateOutboxEventModel("project/domain/name", request)
+	assert.NoError(t, err)
+	assert.Equal(t, "flyteidl.admin.NodeExecutionEventRequest", model.EventType)
+	assert.Equal(t, "project/domain/name", model.PartitionKey)
+
+	msg, err := FromOutboxEventModel(model)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(request, msg))
+}
+
+func TestFromOutboxEventModel_UnknownType(t *testing.T) {
+	_, err := FromOutboxEventModel(models.OutboxEvent{
+		ID:        1,
+		EventType: "flyteidl.admin.Unknown",
+	})
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"context"
+	"encoding/json"
 
 	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flytestdlib/logger"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"google.golang.org/grpc/codes"
 )
@@ -181,3 +183,69 @@ func FromResourceModelsToMatchableAttributes(models []models.Resource) (
 	}
 	return configs, nil
 }
+
+func NotificationTemplatesToResourceModel(resourceID repoInterfaces.ResourceID,
+	templates map[string]runtimeInterfaces.NotificationTemplate) (models.Resource, error) {
+	attributeBytes, err := json.Marshal(templates)
+	if err != nil {
+		return models.Resource{}, errors.NewFlyteAdminErrorf(codes.Internal,
+			"Failed to marshal notification templates for [%+v] with err: %v", resourceID, err)
+	}
+	priority := models.ResourcePriorityProjectDomainLevel
+	if len(resourceID.Workflow) > 0 {
+		priority = models.ResourcePriorityWorkflowLevel
+	}
+	return models.Resource{
+		Project:      resourceID.Project,
+		Domain:       resourceID.Domain,
+		Workflow:     resourceID.Workflow,
+		ResourceType: models.NotificationTemplateResourceType,
+		Priority:     priority,
+		Attributes:   attributeBytes,
+	}, nil
+}
+
+func FromResourceModelToNotificationTemplates(model models.Resource) (
+	map[string]runtimeInterfaces.NotificationTemplate, error) {
+	var templates map[string]runtimeInterfaces.NotificationTemplate
+	err := json.Unmarshal(model.Attributes, &templates)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(
+			codes.Internal, "Failed to decode notification template attributes with err: %v", err)
+	}
+	return templates, nil
+}
+
+func NotificationRulesToResourceModel(resourceID repoInterfaces.ResourceID,
+	rules runtimeInterfaces.NotificationRules) (models.Resource, error) {
+	attributeBytes, err := json.Marshal(rules)
+	if err != nil {
+		return models.Resource{}, errors.NewFlyteAdminErrorf(codes.Internal,
+			"Failed to marshal notification rules for [%+v] with err: %v", resourceID, err)
+	}
+	priority := models.ResourcePriorityProjectDomainLevel
+	if len(resourceID.LaunchPlan) > 0 {
+		priority = models.ResourcePriorityLaunchPlanLevel
+	} else if len(resourceID.Workflow) > 0 {
+		priority = models.ResourcePriorityWorkflowLevel
+	}
+	return models.Resource{
+		Project:      resourceID.Project,
+		Domain:       resourceID.Domain,
+		Workflow:     resourceID.Workflow,
+		LaunchPlan:   resourceID.LaunchPlan,
+		ResourceType: models.NotificationRuleResourceType,
+		Priority:     priority,
+		Attributes:   attributeBytes,
+	}, nil
+}
+
+func FromResourceModelToNotificationRules(model models.Resource) (runtimeInterfaces.NotificationRules, error) {
+	var rules runtimeInterfaces.NotificationRules
+	err := json.Unmarshal(model.Attributes, &rules)
+	if err != nil {
+		return runtimeInterfaces.NotificationRules{}, errors.NewFlyteAdminErrorf(
+			codes.Internal, "Failed to decode notification rule attributes with err: %v", err)
+	}
+	return rules, nil
+}
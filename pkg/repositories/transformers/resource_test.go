@@ -11,6 +11,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"google.golang.org/grpc/codes"
 
@@ -252,3 +253,65 @@ func TestFromWorkflowAttributesModel_InvalidResourceAttributes(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, codes.Internal, err.(errors.FlyteAdminError).Code())
 }
+
+func TestNotificationTemplatesToResourceModel(t *testing.T) {
+	templates := map[string]runtimeInterfaces.NotificationTemplate{
+		"default": {Subject: "{{ .Name }}", Body: "{{ .Phase }}"},
+	}
+	model, err := NotificationTemplatesToResourceModel(repoInterfaces.ResourceID{
+		Project: resourceProject,
+		Domain:  resourceDomain,
+	}, templates)
+	assert.NoError(t, err)
+	assert.Equal(t, models.NotificationTemplateResourceType, model.ResourceType)
+	assert.Equal(t, models.ResourcePriorityProjectDomainLevel, model.Priority)
+	assert.Empty(t, model.Workflow)
+
+	decoded, err := FromResourceModelToNotificationTemplates(model)
+	assert.NoError(t, err)
+	assert.Equal(t, templates, decoded)
+
+	model, err = NotificationTemplatesToResourceModel(repoInterfaces.ResourceID{
+		Project:  resourceProject,
+		Domain:   resourceDomain,
+		Workflow: resourceWorkflow,
+	}, templates)
+	assert.NoError(t, err)
+	assert.Equal(t, resourceWorkflow, model.Workflow)
+	assert.Equal(t, models.ResourcePriorityWorkflowLevel, model.Priority)
+
+	_, err = FromResourceModelToNotificationTemplates(models.Resource{Attributes: []byte("{")})
+	assert.Equal(t, codes.Internal, err.(errors.FlyteAdminError).Code())
+}
+
+func TestNotificationRulesToResourceModel(t *testing.T) {
+	rules := runtimeInterfaces.NotificationRules{
+		Nodes: []runtimeInterfaces.NotificationRule{
+			{NodeID: "train", Phases: []string{"FAILED"}, Recipients: []string{"a@example.com"}},
+		},
+	}
+	model, err := NotificationRulesToResourceModel(repoInterfaces.ResourceID{
+		Project:    resourceProject,
+		Domain:     resourceDomain,
+		Workflow:   resourceWorkflow,
+		LaunchPlan: "launch_plan",
+	}, rules)
+	assert.NoError(t, err)
+	assert.Equal(t, models.NotificationRuleResourceType, model.ResourceType)
+	assert.Equal(t, models.ResourcePriorityLaunchPlanLevel, model.Priority)
+	assert.Equal(t, "launch_plan", model.LaunchPlan)
+
+	decoded, err := FromResourceModelToNotificationRules(model)
+	assert.NoError(t, err)
+	assert.Equal(t, rules, decoded)
+
+	model, err = NotificationRulesToResourceModel(repoInterfaces.ResourceID{
+		Project: resourceProject,
+		Domain:  resourceDomain,
+	}, rules)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ResourcePriorityProjectDomainLevel, model.Priority)
+
+	_, err = FromResourceModelToNotificationRules(models.Resource{Attributes: []byte("{")})
+	assert.Equal(t, codes.Internal, err.(errors.FlyteAdminError).Code())
+}
@@ -0,0 +1,41 @@
+package transformers
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+)
+
+// Transforms a TaskExecutionEventRequest to a TaskExecutionEvent model
+func CreateTaskExecutionEventModel(request admin.TaskExecutionEventRequest) (*models.TaskExecutionEvent, error) {
+	occurredAt, err := ptypes.Timestamp(request.Event.OccurredAt)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal occurred at timestamp")
+	}
+	retryAttempt := request.Event.RetryAttempt
+	return &models.TaskExecutionEvent{
+		TaskExecutionKey: models.TaskExecutionKey{
+			TaskKey: models.TaskKey{
+				Project: request.Event.TaskId.Project,
+				Domain:  request.Event.TaskId.Domain,
+				Name:    request.Event.TaskId.Name,
+				Version: request.Event.TaskId.Version,
+			},
+			NodeExecutionKey: models.NodeExecutionKey{
+				NodeID: request.Event.ParentNodeExecutionId.NodeId,
+				ExecutionKey: models.ExecutionKey{
+					Project: request.Event.ParentNodeExecutionId.ExecutionId.Project,
+					Domain:  request.Event.ParentNodeExecutionId.ExecutionId.Domain,
+					Name:    request.Event.ParentNodeExecutionId.ExecutionId.Name,
+				},
+			},
+			RetryAttempt: &retryAttempt,
+		},
+		RequestID:    request.RequestId,
+		OccurredAt:   occurredAt,
+		Phase:        request.Event.Phase.String(),
+		PhaseVersion: request.Event.PhaseVersion,
+	}, nil
+}
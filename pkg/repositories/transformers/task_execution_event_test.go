@@ -0,0 +1,69 @@
+package transformers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTaskExecutionEventModel(t *testing.T) {
+	occurredAt := time.Now().UTC()
+	occurredAtProto, _ := ptypes.TimestampProto(occurredAt)
+	request := admin.TaskExecutionEventRequest{
+		RequestId: "request id",
+		Event: &event.TaskExecutionEvent{
+			TaskId: &core.Identifier{
+				ResourceType: core.ResourceType_TASK,
+				Project:      "project",
+				Domain:       "domain",
+				Name:         "task",
+				Version:      "version",
+			},
+			ParentNodeExecutionId: &core.NodeExecutionIdentifier{
+				NodeId: "nodey",
+				ExecutionId: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+			},
+			RetryAttempt: 2,
+			Phase:        core.TaskExecution_RUNNING,
+			PhaseVersion: 3,
+			OccurredAt:   occurredAtProto,
+		},
+	}
+
+	taskExecutionEventModel, err := CreateTaskExecutionEventModel(request)
+	assert.Nil(t, err)
+	retryAttempt := uint32(2)
+	assert.Equal(t, &models.TaskExecutionEvent{
+		TaskExecutionKey: models.TaskExecutionKey{
+			TaskKey: models.TaskKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "task",
+				Version: "version",
+			},
+			NodeExecutionKey: models.NodeExecutionKey{
+				NodeID: "nodey",
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+			},
+			RetryAttempt: &retryAttempt,
+		},
+		RequestID:    "request id",
+		OccurredAt:   occurredAt,
+		Phase:        "RUNNING",
+		PhaseVersion: 3,
+	}, taskExecutionEventModel)
+}
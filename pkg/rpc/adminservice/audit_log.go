@@ -0,0 +1,36 @@
+package adminservice
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func auditLogAuditParameters(request *interfaces.AuditLogListRequest) map[string]string {
+	return map[string]string{
+		audit.Project: request.Project,
+		audit.Domain:  request.Domain,
+	}
+}
+
+func (m *AdminService) ListAuditLogs(
+	ctx context.Context, request *interfaces.AuditLogListRequest) (*interfaces.AuditLogList, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.AuditLogList
+	var err error
+	m.Metrics.auditLogEndpointMetrics.list.Time(func() {
+		response, err = m.AuditLogManager.ListAuditLogs(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.auditLogEndpointMetrics.list)
+	}
+
+	return response, nil
+}
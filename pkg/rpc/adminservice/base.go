@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"runtime/debug"
 
+	authConfig "github.com/flyteorg/flyteadmin/auth/config"
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
 	eventWriter "github.com/flyteorg/flyteadmin/pkg/async/events/implementations"
+	eventWriterInterfaces "github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
 
@@ -13,10 +16,12 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
 	"github.com/flyteorg/flyteadmin/pkg/async/schedule"
+	"github.com/flyteorg/flyteadmin/pkg/audit"
 	"github.com/flyteorg/flyteadmin/pkg/data"
 	executionCluster "github.com/flyteorg/flyteadmin/pkg/executioncluster/impl"
 	manager "github.com/flyteorg/flyteadmin/pkg/manager/impl"
 	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rbac"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/runtime"
@@ -25,7 +30,6 @@ import (
 	"github.com/flyteorg/flytestdlib/profutils"
 	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/flyteorg/flytestdlib/storage"
-	"github.com/golang/protobuf/proto"
 )
 
 type AdminService struct {
@@ -40,11 +44,53 @@ type AdminService struct {
 	ResourceManager      interfaces.ResourceInterface
 	NamedEntityManager   interfaces.NamedEntityInterface
 	VersionManager       interfaces.VersionInterface
-	Metrics              AdminMetrics
+	// Endpoints for the following managers are served as JSON over HTTP, see RegisterHTTPHandlers.
+	ExecutionTimelineManager        interfaces.ExecutionTimelineInterface
+	NotificationTemplateManager     interfaces.NotificationTemplateInterface
+	NotificationDeliveryManager     interfaces.NotificationDeliveryInterface
+	NotificationRuleManager         interfaces.NotificationRuleInterface
+	NotificationSubscriptionManager interfaces.NotificationSubscriptionInterface
+	RoleBindingManager              interfaces.RoleBindingInterface
+	AccessTokenManager              interfaces.AccessTokenInterface
+	AuditLogManager                 interfaces.AuditLogInterface
+	Metrics                         AdminMetrics
+	repository                      repositories.RepositoryInterface
+	// Authorizes the requests of authenticated identities. The gRPC server applies it as an interceptor and the JSON
+	// endpoints call it directly. Requests aren't authorized when nil.
+	Authorizer *rbac.Authorizer
+	// Asynchronous event writers, which write their queued events when the service stops.
+	executionEventWriter     eventWriterInterfaces.WorkflowExecutionEventWriter
+	nodeExecutionEventWriter eventWriterInterfaces.NodeExecutionEventWriter
+	taskExecutionEventWriter eventWriterInterfaces.TaskExecutionEventWriter
+}
+
+// Writes the events queued by the service, to be called once the service stopped serving requests.
+func (m *AdminService) Stop() {
+	logger.Info(context.Background(), "Writing queued execution events")
+	if m.executionEventWriter != nil {
+		m.executionEventWriter.Stop()
+	}
+	if m.nodeExecutionEventWriter != nil {
+		m.nodeExecutionEventWriter.Stop()
+	}
+	if m.taskExecutionEventWriter != nil {
+		m.taskExecutionEventWriter.Stop()
+	}
+}
+
+// RevokedTokenStore returns the store of the tokens revoked by the self-hosted authorization server.
+func (m *AdminService) RevokedTokenStore() authInterfaces.TokenRevocationStore {
+	return m.repository.RevokedTokenRepo()
+}
+
+// SigningKeyStore returns the store of the token signing key ring shared by the replicas of the self-hosted
+// authorization server.
+func (m *AdminService) SigningKeyStore() authInterfaces.SigningKeyStore {
+	return m.repository.SigningKeyRingRepo()
 }
 
 // Intercepts all admin requests to handle panics during execution.
-func (m *AdminService) interceptPanic(ctx context.Context, request proto.Message) {
+func (m *AdminService) interceptPanic(ctx context.Context, request interface{}) {
 	err := recover()
 	if err == nil {
 		return
@@ -71,19 +117,9 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 	}()
 
 	dbConfigValues := configuration.ApplicationConfiguration().GetDbConfig()
-	dbConfig := repositoryConfig.DbConfig{
-		BaseConfig: repositoryConfig.BaseConfig{
-			IsDebug: dbConfigValues.Debug,
-		},
-		Host:         dbConfigValues.Host,
-		Port:         dbConfigValues.Port,
-		DbName:       dbConfigValues.DbName,
-		User:         dbConfigValues.User,
-		Password:     dbConfigValues.Password,
-		ExtraOptions: dbConfigValues.ExtraOptions,
-	}
+	dbConfig := repositoryConfig.NewDbConfig(dbConfigValues)
 	db := repositories.GetRepository(
-		repositories.POSTGRES, dbConfig, adminScope.NewSubScope("database"))
+		repositories.GetRepoConfig(dbConfig), dbConfig, adminScope.NewSubScope("database"))
 	storeConfig := storage.GetConfig()
 	execCluster := executionCluster.GetExecutionCluster(
 		adminScope.NewSubScope("executor").NewSubScope("cluster"),
@@ -102,14 +138,36 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 		logger.Error(context.Background(), "Failed to initialize storage config")
 		panic(err)
 	}
+	blobDeleter, err := data.GetBlobDeleter(storeConfig)
+	if err != nil {
+		logger.Error(context.Background(), "Failed to initialize blob deletion")
+		panic(err)
+	}
+	db = repositories.NewClosureOffloadingRepo(db, repositories.NewClosureOffloader(dataStorageClient, blobDeleter,
+		applicationConfiguration.MetadataStoragePrefix, applicationConfiguration.ClosureOffloadingThresholdBytes,
+		adminScope.NewSubScope("closure_offloading")))
 
 	publisher := notifications.NewNotificationsPublisher(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
-	processor := notifications.NewNotificationsProcessor(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
-	eventPublisher := notifications.NewEventsPublisher(*configuration.ApplicationConfiguration().GetExternalEventsConfig(), adminScope)
+	processor := notifications.NewNotificationsProcessor(*configuration.ApplicationConfiguration().GetNotificationsConfig(),
+		db.NotificationDeliveryRepo(), adminScope)
+	eventPublisher := notifications.NewEventsPublisher(*configuration.ApplicationConfiguration().GetExternalEventsConfig(),
+		db.ExecutionRepo(), adminScope)
+	auditSink, err := audit.NewSink(*audit.GetConfig(), db.AuditLogRepo(), eventPublisher)
+	if err != nil {
+		logger.Error(context.Background(), "Failed to initialize audit log sinks")
+		panic(err)
+	}
+	audit.SetSink(auditSink)
 	go func() {
 		logger.Info(context.Background(), "Started processing notifications.")
 		processor.StartProcessing()
 	}()
+	outboxRelay := notifications.NewOutboxRelay(*configuration.ApplicationConfiguration().GetExternalEventsConfig(),
+		db.OutboxEventRepo(), eventPublisher, adminScope)
+	go func() {
+		logger.Info(context.Background(), "Started relaying outbox events.")
+		outboxRelay.StartProcessing()
+	}()
 
 	// Configure workflow scheduler async processes.
 	schedulerConfig := configuration.ApplicationConfiguration().GetSchedulerConfig()
@@ -139,7 +197,8 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 		adminScope.NewSubScope("workflow_manager"))
 	namedEntityManager := manager.NewNamedEntityManager(db, configuration, adminScope.NewSubScope("named_entity_manager"))
 
-	executionEventWriter := eventWriter.NewWorkflowExecutionEventWriter(db, applicationConfiguration.AsyncEventsBufferSize)
+	executionEventWriter := eventWriter.NewWorkflowExecutionEventWriter(db, applicationConfiguration.AsyncEventsBufferSize,
+		applicationConfiguration.AsyncEventsBatching, adminScope.NewSubScope("execution_event_writer"))
 	go func() {
 		executionEventWriter.Run()
 	}()
@@ -165,11 +224,22 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 		}
 	}()
 
-	nodeExecutionEventWriter := eventWriter.NewNodeExecutionEventWriter(db, applicationConfiguration.AsyncEventsBufferSize)
+	nodeExecutionEventWriter := eventWriter.NewNodeExecutionEventWriter(db, applicationConfiguration.AsyncEventsBufferSize,
+		applicationConfiguration.AsyncEventsBatching, adminScope.NewSubScope("node_execution_event_writer"))
 	go func() {
 		nodeExecutionEventWriter.Run()
 	}()
 
+	taskExecutionEventWriter := eventWriter.NewTaskExecutionEventWriter(db, applicationConfiguration.AsyncEventsBufferSize,
+		applicationConfiguration.AsyncEventsBatching, adminScope.NewSubScope("task_execution_event_writer"))
+	go func() {
+		taskExecutionEventWriter.Run()
+	}()
+
+	authorizationConfig := authConfig.GetConfig().Authorization
+	authorizer := rbac.NewAuthorizer(authorizationConfig, db.RoleBindingRepo(),
+		adminScope.NewSubScope("authorizer"))
+
 	logger.Info(context.Background(), "Initializing a new AdminService")
 	return &AdminService{
 		TaskManager: manager.NewTaskManager(db, configuration, workflowengine.NewCompiler(),
@@ -180,11 +250,26 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 		NamedEntityManager: namedEntityManager,
 		VersionManager:     versionManager,
 		NodeExecutionManager: manager.NewNodeExecutionManager(db, configuration, applicationConfiguration.MetadataStoragePrefix, dataStorageClient,
-			adminScope.NewSubScope("node_execution_manager"), urlData, eventPublisher, nodeExecutionEventWriter),
+			adminScope.NewSubScope("node_execution_manager"), urlData, eventPublisher, nodeExecutionEventWriter,
+			publisher),
 		TaskExecutionManager: manager.NewTaskExecutionManager(db, configuration, dataStorageClient,
-			adminScope.NewSubScope("task_execution_manager"), urlData, eventPublisher),
-		ProjectManager:  manager.NewProjectManager(db, configuration),
-		ResourceManager: resources.NewResourceManager(db, configuration.ApplicationConfiguration()),
-		Metrics:         InitMetrics(adminScope),
+			adminScope.NewSubScope("task_execution_manager"), urlData, eventPublisher, taskExecutionEventWriter,
+			publisher),
+		ProjectManager:                  manager.NewProjectManager(db, configuration),
+		ResourceManager:                 resources.NewResourceManager(db, configuration.ApplicationConfiguration()),
+		ExecutionTimelineManager:        manager.NewExecutionTimelineManager(db),
+		NotificationTemplateManager:     manager.NewNotificationTemplateManager(db, configuration, dataStorageClient),
+		NotificationDeliveryManager:     manager.NewNotificationDeliveryManager(db, publisher),
+		NotificationRuleManager:         manager.NewNotificationRuleManager(db, configuration),
+		NotificationSubscriptionManager: manager.NewNotificationSubscriptionManager(db, configuration),
+		RoleBindingManager:              manager.NewRoleBindingManager(db, configuration),
+		AccessTokenManager:              manager.NewAccessTokenManager(db, configuration, authConfig.GetConfig()),
+		AuditLogManager:                 manager.NewAuditLogManager(db),
+		Metrics:                         InitMetrics(adminScope),
+		repository:                      db,
+		executionEventWriter:            executionEventWriter,
+		nodeExecutionEventWriter:        nodeExecutionEventWriter,
+		taskExecutionEventWriter:        taskExecutionEventWriter,
+		Authorizer:                      authorizer,
 	}
 }
@@ -0,0 +1,38 @@
+package adminservice
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func executionTimelineAuditParameters(request *interfaces.ExecutionTimelineRequest) map[string]string {
+	return map[string]string{
+		audit.Project: request.Project,
+		audit.Domain:  request.Domain,
+		audit.Name:    request.Name,
+		audit.NodeID:  request.NodeID,
+	}
+}
+
+func (m *AdminService) GetExecutionTimeline(
+	ctx context.Context, request *interfaces.ExecutionTimelineRequest) (*interfaces.ExecutionTimeline, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.ExecutionTimeline
+	var err error
+	m.Metrics.executionTimelineEndpointMetrics.get.Time(func() {
+		response, err = m.ExecutionTimelineManager.GetExecutionTimeline(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionTimelineEndpointMetrics.get)
+	}
+
+	return response, nil
+}
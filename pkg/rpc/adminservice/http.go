@@ -0,0 +1,448 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Admin endpoints which aren't (yet) defined by the flyteidl AdminService are served as JSON over HTTP, next to the
+// grpc-gateway. Get and delete requests are read from query parameters and all others from the JSON request body.
+const (
+	executionTimelinesURL        = "/api/v1/execution_timelines"
+	notificationTemplatesURL     = "/api/v1/notification_templates"
+	notificationPreviewURL       = "/api/v1/notification_templates/preview"
+	notificationDeliveriesURL    = "/api/v1/notification_deliveries"
+	notificationResendURL        = "/api/v1/notification_deliveries/resend"
+	notificationRulesURL         = "/api/v1/notification_rules"
+	notificationSubscriptionsURL = "/api/v1/notification_subscriptions"
+	roleBindingsURL              = "/api/v1/role_bindings"
+	accessTokensURL              = "/api/v1/access_tokens"
+	auditLogsURL                 = "/api/v1/audit_logs"
+)
+
+const (
+	projectQueryParam    = "project"
+	domainQueryParam     = "domain"
+	workflowQueryParam   = "workflow"
+	nameQueryParam       = "name"
+	launchPlanQueryParam = "launch_plan"
+	idQueryParam         = "id"
+	nodeIDQueryParam     = "node_id"
+	filtersQueryParam    = "filters"
+	limitQueryParam      = "limit"
+	tokenQueryParam      = "token"
+)
+
+// The admin service method an HTTP request is served by, along with the parameters and access mode it is audited
+// with. Handlers describe their call once they've decoded the request, see authorizeHTTPRequest.
+type httpAuditedCall struct {
+	method     string
+	parameters map[string]string
+	mode       audit.AccessMode
+}
+
+// Serves a single HTTP method of an endpoint. The returned value is encoded as the JSON response body.
+type httpMethodHandler func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error)
+
+type httpErrorResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func writeJSONResponse(ctx context.Context, writer http.ResponseWriter, statusCode int, response interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		logger.Errorf(ctx, "failed to write json response, error: %v", err)
+	}
+}
+
+func writeJSONError(ctx context.Context, writer http.ResponseWriter, err error) {
+	s := status.Convert(err)
+	writeJSONResponse(ctx, writer, runtime.HTTPStatusFromCode(s.Code()), httpErrorResponse{
+		Code:    s.Code().String(),
+		Message: s.Message(),
+	})
+}
+
+func decodeJSONBody(request *http.Request, target interface{}) error {
+	if err := json.NewDecoder(request.Body).Decode(target); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to decode request body: %v", err)
+	}
+	return nil
+}
+
+// Mirrors the authentication applied to gRPC requests, including the option to disable enforcement for HTTP. The
+// scopes of the identity are checked along with its roles, see authorizeHTTPRequest.
+func authenticateHTTPRequest(ctx context.Context, request *http.Request,
+	authCtx authInterfaces.AuthenticationContext) (context.Context, error) {
+	identityContext, err := auth.IdentityContextFromRequest(ctx, request, authCtx)
+	if err != nil {
+		if authCtx.Options().DisableForHTTP {
+			return ctx, nil
+		}
+		return ctx, status.Errorf(codes.Unauthenticated, "request unauthenticated: %v", err)
+	}
+	return auth.SetContextForIdentity(ctx, identityContext), nil
+}
+
+// Dispatches requests by HTTP method. Requests are authenticated when an authentication context is provided. Once a
+// handler has described the admin service method it calls, the request is audited whether or not it was authorized.
+func newHTTPHandler(authCtx authInterfaces.AuthenticationContext,
+	handlers map[string]httpMethodHandler) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		requestedAt := time.Now()
+		ctx := withHTTPReadPreference(request.Context(), request)
+		handler, ok := handlers[request.Method]
+		if !ok {
+			writeJSONResponse(ctx, writer, http.StatusMethodNotAllowed, httpErrorResponse{
+				Code:    codes.Unimplemented.String(),
+				Message: "method not allowed",
+			})
+			return
+		}
+		if authCtx != nil {
+			var err error
+			if ctx, err = authenticateHTTPRequest(ctx, request, authCtx); err != nil {
+				writeJSONError(ctx, writer, err)
+				return
+			}
+		}
+		var call httpAuditedCall
+		response, err := handler(ctx, &call, request)
+		if len(call.method) > 0 {
+			audit.NewLogBuilder().WithAuthenticatedCtx(ctx).WithRequest(
+				call.method, call.parameters, call.mode, requestedAt).WithResponse(time.Now(), err).Log(ctx)
+		}
+		if err != nil {
+			writeJSONError(ctx, writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, http.StatusOK, response)
+	}
+}
+
+func notificationTemplateGetRequestFromQuery(request *http.Request) *interfaces.NotificationTemplateGetRequest {
+	query := request.URL.Query()
+	return &interfaces.NotificationTemplateGetRequest{
+		Project:  query.Get(projectQueryParam),
+		Domain:   query.Get(domainQueryParam),
+		Workflow: query.Get(workflowQueryParam),
+	}
+}
+
+func notificationRuleGetRequestFromQuery(request *http.Request) *interfaces.NotificationRuleGetRequest {
+	query := request.URL.Query()
+	return &interfaces.NotificationRuleGetRequest{
+		Project:    query.Get(projectQueryParam),
+		Domain:     query.Get(domainQueryParam),
+		Workflow:   query.Get(workflowQueryParam),
+		LaunchPlan: query.Get(launchPlanQueryParam),
+	}
+}
+
+// Authorizes a request of the admin service method it is passed to, after noting the call so that it is audited.
+// Unlike gRPC requests, which are authorized by an interceptor, JSON requests are only decoded by their handlers.
+func (m *AdminService) authorizeHTTPRequest(ctx context.Context, call *httpAuditedCall, method string,
+	request interface{}, mode audit.AccessMode, parameters map[string]string) error {
+	call.method = method
+	call.parameters = parameters
+	call.mode = mode
+	if m.Authorizer == nil {
+		return nil
+	}
+	return m.Authorizer.Authorize(ctx, method, request)
+}
+
+// RegisterHTTPHandlers registers the JSON endpoints of the admin service. The authentication context is nil when
+// authentication is disabled.
+func (m *AdminService) RegisterHTTPHandlers(
+	handler authInterfaces.HandlerRegisterer, authCtx authInterfaces.AuthenticationContext) {
+	handler.HandleFunc(executionTimelinesURL, newHTTPHandler(authCtx, map[string]httpMethodHandler{
+		http.MethodGet: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			query := request.URL.Query()
+			timelineRequest := &interfaces.ExecutionTimelineRequest{
+				Project: query.Get(projectQueryParam),
+				Domain:  query.Get(domainQueryParam),
+				Name:    query.Get(nameQueryParam),
+				NodeID:  query.Get(nodeIDQueryParam),
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "GetExecutionTimeline", timelineRequest, audit.ReadOnly,
+				executionTimelineAuditParameters(timelineRequest)); err != nil {
+				return nil, err
+			}
+			return m.GetExecutionTimeline(ctx, timelineRequest)
+		},
+	}))
+	handler.HandleFunc(notificationTemplatesURL, newHTTPHandler(authCtx, map[string]httpMethodHandler{
+		http.MethodGet: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			getRequest := notificationTemplateGetRequestFromQuery(request)
+			if err := m.authorizeHTTPRequest(ctx, call, "GetNotificationTemplates", getRequest, audit.ReadOnly,
+				notificationTemplateAuditParameters(getRequest.Project, getRequest.Domain, getRequest.Workflow)); err != nil {
+				return nil, err
+			}
+			return m.GetNotificationTemplates(ctx, getRequest)
+		},
+		http.MethodPut: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			var attributes interfaces.NotificationTemplateAttributes
+			if err := decodeJSONBody(request, &attributes); err != nil {
+				return nil, err
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "UpdateNotificationTemplates", &attributes, audit.ReadWrite,
+				notificationTemplateAuditParameters(attributes.Project, attributes.Domain, attributes.Workflow)); err != nil {
+				return nil, err
+			}
+			return m.UpdateNotificationTemplates(ctx, &attributes)
+		},
+		http.MethodDelete: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			getRequest := notificationTemplateGetRequestFromQuery(request)
+			if err := m.authorizeHTTPRequest(ctx, call, "DeleteNotificationTemplates", getRequest, audit.ReadWrite,
+				notificationTemplateAuditParameters(getRequest.Project, getRequest.Domain, getRequest.Workflow)); err != nil {
+				return nil, err
+			}
+			return m.DeleteNotificationTemplates(ctx, getRequest)
+		},
+	}))
+	handler.HandleFunc(notificationPreviewURL, newHTTPHandler(authCtx, map[string]httpMethodHandler{
+		http.MethodPost: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			var previewRequest interfaces.NotificationPreviewRequest
+			if err := decodeJSONBody(request, &previewRequest); err != nil {
+				return nil, err
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "PreviewNotification", &previewRequest, audit.ReadOnly,
+				notificationTemplateAuditParameters(previewRequest.Project, previewRequest.Domain,
+					previewRequest.Name)); err != nil {
+				return nil, err
+			}
+			return m.PreviewNotification(ctx, &previewRequest)
+		},
+	}))
+	handler.HandleFunc(notificationDeliveriesURL, newHTTPHandler(authCtx, map[string]httpMethodHandler{
+		http.MethodGet: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			query := request.URL.Query()
+			listRequest := &interfaces.NotificationDeliveryListRequest{
+				Project: query.Get(projectQueryParam),
+				Domain:  query.Get(domainQueryParam),
+				Name:    query.Get(nameQueryParam),
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "ListNotificationDeliveries", listRequest, audit.ReadOnly,
+				notificationDeliveryListAuditParameters(listRequest)); err != nil {
+				return nil, err
+			}
+			return m.ListNotificationDeliveries(ctx, listRequest)
+		},
+	}))
+	handler.HandleFunc(notificationResendURL, newHTTPHandler(authCtx, map[string]httpMethodHandler{
+		http.MethodPost: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			var resendRequest interfaces.NotificationDeliveryResendRequest
+			if err := decodeJSONBody(request, &resendRequest); err != nil {
+				return nil, err
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "ResendNotificationDelivery", &resendRequest, audit.ReadWrite,
+				notificationDeliveryAuditParameters(&resendRequest)); err != nil {
+				return nil, err
+			}
+			return m.ResendNotificationDelivery(ctx, &resendRequest)
+		},
+	}))
+	handler.HandleFunc(notificationRulesURL, newHTTPHandler(authCtx, map[string]httpMethodHandler{
+		http.MethodGet: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			getRequest := notificationRuleGetRequestFromQuery(request)
+			if err := m.authorizeHTTPRequest(ctx, call, "GetNotificationRules", getRequest, audit.ReadOnly,
+				notificationRuleAuditParameters(getRequest.Project, getRequest.Domain, getRequest.Workflow,
+					getRequest.LaunchPlan)); err != nil {
+				return nil, err
+			}
+			return m.GetNotificationRules(ctx, getRequest)
+		},
+		http.MethodPut: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			var attributes interfaces.NotificationRuleAttributes
+			if err := decodeJSONBody(request, &attributes); err != nil {
+				return nil, err
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "UpdateNotificationRules", &attributes, audit.ReadWrite,
+				notificationRuleAuditParameters(attributes.Project, attributes.Domain, attributes.Workflow,
+					attributes.LaunchPlan)); err != nil {
+				return nil, err
+			}
+			return m.UpdateNotificationRules(ctx, &attributes)
+		},
+		http.MethodDelete: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			getRequest := notificationRuleGetRequestFromQuery(request)
+			if err := m.authorizeHTTPRequest(ctx, call, "DeleteNotificationRules", getRequest, audit.ReadWrite,
+				notificationRuleAuditParameters(getRequest.Project, getRequest.Domain, getRequest.Workflow,
+					getRequest.LaunchPlan)); err != nil {
+				return nil, err
+			}
+			return m.DeleteNotificationRules(ctx, getRequest)
+		},
+	}))
+	handler.HandleFunc(notificationSubscriptionsURL, newHTTPHandler(authCtx, map[string]httpMethodHandler{
+		http.MethodGet: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			query := request.URL.Query()
+			listRequest := &interfaces.NotificationSubscriptionListRequest{
+				Project:    query.Get(projectQueryParam),
+				Domain:     query.Get(domainQueryParam),
+				Workflow:   query.Get(workflowQueryParam),
+				LaunchPlan: query.Get(launchPlanQueryParam),
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "ListNotificationSubscriptions", listRequest, audit.ReadOnly,
+				notificationSubscriptionAuditParameters(listRequest.Project, listRequest.Domain, 0)); err != nil {
+				return nil, err
+			}
+			return m.ListNotificationSubscriptions(ctx, listRequest)
+		},
+		http.MethodPost: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			var subscription interfaces.NotificationSubscription
+			if err := decodeJSONBody(request, &subscription); err != nil {
+				return nil, err
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "CreateNotificationSubscription", &subscription, audit.ReadWrite,
+				notificationSubscriptionAuditParameters(subscription.Project, subscription.Domain,
+					subscription.ID)); err != nil {
+				return nil, err
+			}
+			return m.CreateNotificationSubscription(ctx, &subscription)
+		},
+		http.MethodPut: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			var subscription interfaces.NotificationSubscription
+			if err := decodeJSONBody(request, &subscription); err != nil {
+				return nil, err
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "UpdateNotificationSubscription", &subscription, audit.ReadWrite,
+				notificationSubscriptionAuditParameters(subscription.Project, subscription.Domain,
+					subscription.ID)); err != nil {
+				return nil, err
+			}
+			return m.UpdateNotificationSubscription(ctx, &subscription)
+		},
+		http.MethodDelete: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			query := request.URL.Query()
+			id, err := strconv.ParseUint(query.Get(idQueryParam), 10, 64)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid notification subscription id: %v", err)
+			}
+			deleteRequest := &interfaces.NotificationSubscriptionDeleteRequest{
+				ID:      uint(id),
+				Project: query.Get(projectQueryParam),
+				Domain:  query.Get(domainQueryParam),
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "DeleteNotificationSubscription", deleteRequest, audit.ReadWrite,
+				notificationSubscriptionAuditParameters(deleteRequest.Project, deleteRequest.Domain,
+					deleteRequest.ID)); err != nil {
+				return nil, err
+			}
+			return m.DeleteNotificationSubscription(ctx, deleteRequest)
+		},
+	}))
+	handler.HandleFunc(roleBindingsURL, newHTTPHandler(authCtx, map[string]httpMethodHandler{
+		http.MethodGet: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			query := request.URL.Query()
+			listRequest := &interfaces.RoleBindingListRequest{
+				Project: query.Get(projectQueryParam),
+				Domain:  query.Get(domainQueryParam),
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "ListRoleBindings", listRequest, audit.ReadOnly,
+				roleBindingAuditParameters(listRequest.Project, listRequest.Domain)); err != nil {
+				return nil, err
+			}
+			return m.ListRoleBindings(ctx, listRequest)
+		},
+		http.MethodPost: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			var roleBinding interfaces.RoleBinding
+			if err := decodeJSONBody(request, &roleBinding); err != nil {
+				return nil, err
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "CreateRoleBinding", &roleBinding, audit.ReadWrite,
+				roleBindingCreateAuditParameters(&roleBinding)); err != nil {
+				return nil, err
+			}
+			return m.CreateRoleBinding(ctx, &roleBinding)
+		},
+		http.MethodDelete: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			query := request.URL.Query()
+			id, err := strconv.ParseUint(query.Get(idQueryParam), 10, 64)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid role binding id: %v", err)
+			}
+			deleteRequest := &interfaces.RoleBindingDeleteRequest{
+				ID:      uint(id),
+				Project: query.Get(projectQueryParam),
+				Domain:  query.Get(domainQueryParam),
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "DeleteRoleBinding", deleteRequest, audit.ReadWrite,
+				roleBindingDeleteAuditParameters(deleteRequest)); err != nil {
+				return nil, err
+			}
+			return m.DeleteRoleBinding(ctx, deleteRequest)
+		},
+	}))
+	handler.HandleFunc(accessTokensURL, newHTTPHandler(authCtx, map[string]httpMethodHandler{
+		http.MethodGet: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			listRequest := &interfaces.AccessTokenListRequest{}
+			if err := m.authorizeHTTPRequest(ctx, call, "ListAccessTokens", listRequest, audit.ReadOnly,
+				accessTokenAuditParameters("")); err != nil {
+				return nil, err
+			}
+			return m.ListAccessTokens(ctx, listRequest)
+		},
+		http.MethodPost: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			var createRequest interfaces.AccessTokenCreateRequest
+			if err := decodeJSONBody(request, &createRequest); err != nil {
+				return nil, err
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, getCreateAccessTokenMethod(&createRequest), &createRequest,
+				audit.ReadWrite, accessTokenAuditParameters(createRequest.Name)); err != nil {
+				return nil, err
+			}
+			return m.CreateAccessToken(ctx, &createRequest)
+		},
+		http.MethodDelete: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			id, err := strconv.ParseUint(request.URL.Query().Get(idQueryParam), 10, 64)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid access token id: %v", err)
+			}
+			revokeRequest := &interfaces.AccessTokenRevokeRequest{
+				ID: uint(id),
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "RevokeAccessToken", revokeRequest, audit.ReadWrite,
+				accessTokenAuditParameters(strconv.FormatUint(uint64(revokeRequest.ID), 10))); err != nil {
+				return nil, err
+			}
+			return m.RevokeAccessToken(ctx, revokeRequest)
+		},
+	}))
+	handler.HandleFunc(auditLogsURL, newHTTPHandler(authCtx, map[string]httpMethodHandler{
+		http.MethodGet: func(ctx context.Context, call *httpAuditedCall, request *http.Request) (interface{}, error) {
+			query := request.URL.Query()
+			limit, err := strconv.ParseUint(query.Get(limitQueryParam), 10, 32)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid audit log limit: %v", err)
+			}
+			listRequest := &interfaces.AuditLogListRequest{
+				Project: query.Get(projectQueryParam),
+				Domain:  query.Get(domainQueryParam),
+				Filters: query.Get(filtersQueryParam),
+				Limit:   uint32(limit),
+				Token:   query.Get(tokenQueryParam),
+			}
+			if err := m.authorizeHTTPRequest(ctx, call, "ListAuditLogs", listRequest, audit.ReadOnly,
+				auditLogAuditParameters(listRequest)); err != nil {
+				return nil, err
+			}
+			return m.ListAuditLogs(ctx, listRequest)
+		},
+	}))
+}
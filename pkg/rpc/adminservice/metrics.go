@@ -20,6 +20,12 @@ type executionEndpointMetrics struct {
 	terminate   util.RequestMetrics
 }
 
+type executionTimelineEndpointMetrics struct {
+	scope promutils.Scope
+
+	get util.RequestMetrics
+}
+
 type launchPlanEndpointMetrics struct {
 	scope promutils.Scope
 
@@ -50,6 +56,61 @@ type nodeExecutionEndpointMetrics struct {
 	listChildren util.RequestMetrics
 }
 
+type notificationDeliveryEndpointMetrics struct {
+	scope promutils.Scope
+
+	list   util.RequestMetrics
+	resend util.RequestMetrics
+}
+
+type notificationRuleEndpointMetrics struct {
+	scope promutils.Scope
+
+	update util.RequestMetrics
+	get    util.RequestMetrics
+	delete util.RequestMetrics
+}
+
+type notificationSubscriptionEndpointMetrics struct {
+	scope promutils.Scope
+
+	create util.RequestMetrics
+	update util.RequestMetrics
+	list   util.RequestMetrics
+	delete util.RequestMetrics
+}
+
+type notificationTemplateEndpointMetrics struct {
+	scope promutils.Scope
+
+	update  util.RequestMetrics
+	get     util.RequestMetrics
+	delete  util.RequestMetrics
+	preview util.RequestMetrics
+}
+
+type accessTokenEndpointMetrics struct {
+	scope promutils.Scope
+
+	create util.RequestMetrics
+	list   util.RequestMetrics
+	revoke util.RequestMetrics
+}
+
+type auditLogEndpointMetrics struct {
+	scope promutils.Scope
+
+	list util.RequestMetrics
+}
+
+type roleBindingEndpointMetrics struct {
+	scope promutils.Scope
+
+	create util.RequestMetrics
+	list   util.RequestMetrics
+	delete util.RequestMetrics
+}
+
 type projectEndpointMetrics struct {
 	scope promutils.Scope
 
@@ -98,18 +159,26 @@ type AdminMetrics struct {
 	Scope        promutils.Scope
 	PanicCounter prometheus.Counter
 
-	executionEndpointMetrics               executionEndpointMetrics
-	launchPlanEndpointMetrics              launchPlanEndpointMetrics
-	namedEntityEndpointMetrics             namedEntityEndpointMetrics
-	nodeExecutionEndpointMetrics           nodeExecutionEndpointMetrics
-	projectEndpointMetrics                 projectEndpointMetrics
-	projectAttributesEndpointMetrics       attributeEndpointMetrics
-	projectDomainAttributesEndpointMetrics attributeEndpointMetrics
-	workflowAttributesEndpointMetrics      attributeEndpointMetrics
-	matchableAttributesEndpointMetrics     attributeEndpointMetrics
-	taskEndpointMetrics                    taskEndpointMetrics
-	taskExecutionEndpointMetrics           taskExecutionEndpointMetrics
-	workflowEndpointMetrics                workflowEndpointMetrics
+	executionEndpointMetrics                executionEndpointMetrics
+	executionTimelineEndpointMetrics        executionTimelineEndpointMetrics
+	launchPlanEndpointMetrics               launchPlanEndpointMetrics
+	namedEntityEndpointMetrics              namedEntityEndpointMetrics
+	nodeExecutionEndpointMetrics            nodeExecutionEndpointMetrics
+	notificationDeliveryEndpointMetrics     notificationDeliveryEndpointMetrics
+	notificationRuleEndpointMetrics         notificationRuleEndpointMetrics
+	notificationSubscriptionEndpointMetrics notificationSubscriptionEndpointMetrics
+	notificationTemplateEndpointMetrics     notificationTemplateEndpointMetrics
+	projectEndpointMetrics                  projectEndpointMetrics
+	roleBindingEndpointMetrics              roleBindingEndpointMetrics
+	accessTokenEndpointMetrics              accessTokenEndpointMetrics
+	auditLogEndpointMetrics                 auditLogEndpointMetrics
+	projectAttributesEndpointMetrics        attributeEndpointMetrics
+	projectDomainAttributesEndpointMetrics  attributeEndpointMetrics
+	workflowAttributesEndpointMetrics       attributeEndpointMetrics
+	matchableAttributesEndpointMetrics      attributeEndpointMetrics
+	taskEndpointMetrics                     taskEndpointMetrics
+	taskExecutionEndpointMetrics            taskExecutionEndpointMetrics
+	workflowEndpointMetrics                 workflowEndpointMetrics
 }
 
 func InitMetrics(adminScope promutils.Scope) AdminMetrics {
@@ -129,6 +198,10 @@ func InitMetrics(adminScope promutils.Scope) AdminMetrics {
 			list:        util.NewRequestMetrics(adminScope, "list_execution"),
 			terminate:   util.NewRequestMetrics(adminScope, "terminate_execution"),
 		},
+		executionTimelineEndpointMetrics: executionTimelineEndpointMetrics{
+			scope: adminScope,
+			get:   util.NewRequestMetrics(adminScope, "get_execution_timeline"),
+		},
 		launchPlanEndpointMetrics: launchPlanEndpointMetrics{
 			scope:      adminScope,
 			create:     util.NewRequestMetrics(adminScope, "create_launch_plan"),
@@ -153,12 +226,53 @@ func InitMetrics(adminScope promutils.Scope) AdminMetrics {
 			list:         util.NewRequestMetrics(adminScope, "list_node_execution"),
 			listChildren: util.NewRequestMetrics(adminScope, "list_children_node_executions"),
 		},
+		notificationDeliveryEndpointMetrics: notificationDeliveryEndpointMetrics{
+			scope:  adminScope,
+			list:   util.NewRequestMetrics(adminScope, "list_notification_deliveries"),
+			resend: util.NewRequestMetrics(adminScope, "resend_notification_delivery"),
+		},
+		notificationRuleEndpointMetrics: notificationRuleEndpointMetrics{
+			scope:  adminScope,
+			update: util.NewRequestMetrics(adminScope, "update_notification_rules"),
+			get:    util.NewRequestMetrics(adminScope, "get_notification_rules"),
+			delete: util.NewRequestMetrics(adminScope, "delete_notification_rules"),
+		},
+		notificationSubscriptionEndpointMetrics: notificationSubscriptionEndpointMetrics{
+			scope:  adminScope,
+			create: util.NewRequestMetrics(adminScope, "create_notification_subscription"),
+			update: util.NewRequestMetrics(adminScope, "update_notification_subscription"),
+			list:   util.NewRequestMetrics(adminScope, "list_notification_subscriptions"),
+			delete: util.NewRequestMetrics(adminScope, "delete_notification_subscription"),
+		},
+		notificationTemplateEndpointMetrics: notificationTemplateEndpointMetrics{
+			scope:   adminScope,
+			update:  util.NewRequestMetrics(adminScope, "update_notification_templates"),
+			get:     util.NewRequestMetrics(adminScope, "get_notification_templates"),
+			delete:  util.NewRequestMetrics(adminScope, "delete_notification_templates"),
+			preview: util.NewRequestMetrics(adminScope, "preview_notification"),
+		},
 		projectEndpointMetrics: projectEndpointMetrics{
 			scope:    adminScope,
 			register: util.NewRequestMetrics(adminScope, "register_project"),
 			list:     util.NewRequestMetrics(adminScope, "list_projects"),
 			update:   util.NewRequestMetrics(adminScope, "update_project"),
 		},
+		roleBindingEndpointMetrics: roleBindingEndpointMetrics{
+			scope:  adminScope,
+			create: util.NewRequestMetrics(adminScope, "create_role_binding"),
+			list:   util.NewRequestMetrics(adminScope, "list_role_bindings"),
+			delete: util.NewRequestMetrics(adminScope, "delete_role_binding"),
+		},
+		accessTokenEndpointMetrics: accessTokenEndpointMetrics{
+			scope:  adminScope,
+			create: util.NewRequestMetrics(adminScope, "create_access_token"),
+			list:   util.NewRequestMetrics(adminScope, "list_access_tokens"),
+			revoke: util.NewRequestMetrics(adminScope, "revoke_access_token"),
+		},
+		auditLogEndpointMetrics: auditLogEndpointMetrics{
+			scope: adminScope,
+			list:  util.NewRequestMetrics(adminScope, "list_audit_logs"),
+		},
 		projectAttributesEndpointMetrics: attributeEndpointMetrics{
 			scope:  adminScope,
 			update: util.NewRequestMetrics(adminScope, "update_project_attrs"),
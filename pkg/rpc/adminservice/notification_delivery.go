@@ -0,0 +1,69 @@
+package adminservice
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const notificationDeliveryResourceType = "notification_delivery"
+
+func notificationDeliveryListAuditParameters(request *interfaces.NotificationDeliveryListRequest) map[string]string {
+	return map[string]string{
+		audit.Project: request.Project,
+		audit.Domain:  request.Domain,
+		audit.Name:    request.Name,
+	}
+}
+
+func notificationDeliveryAuditParameters(request *interfaces.NotificationDeliveryResendRequest) map[string]string {
+	return map[string]string{
+		audit.Project:      request.Project,
+		audit.Domain:       request.Domain,
+		audit.ResourceType: notificationDeliveryResourceType,
+		audit.Name:         strconv.FormatUint(uint64(request.ID), 10),
+	}
+}
+
+func (m *AdminService) ListNotificationDeliveries(
+	ctx context.Context, request *interfaces.NotificationDeliveryListRequest) (
+	*interfaces.NotificationDeliveryList, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationDeliveryList
+	var err error
+	m.Metrics.notificationDeliveryEndpointMetrics.list.Time(func() {
+		response, err = m.NotificationDeliveryManager.ListNotificationDeliveries(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationDeliveryEndpointMetrics.list)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) ResendNotificationDelivery(
+	ctx context.Context, request *interfaces.NotificationDeliveryResendRequest) (
+	*interfaces.NotificationDelivery, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationDelivery
+	var err error
+	m.Metrics.notificationDeliveryEndpointMetrics.resend.Time(func() {
+		response, err = m.NotificationDeliveryManager.ResendNotificationDelivery(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationDeliveryEndpointMetrics.resend)
+	}
+
+	return response, nil
+}
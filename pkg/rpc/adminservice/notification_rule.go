@@ -0,0 +1,83 @@
+package adminservice
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const notificationRuleResourceType = "notification_rule"
+
+func notificationRuleAuditParameters(project, domain, workflow, launchPlan string) map[string]string {
+	name := workflow
+	if len(launchPlan) > 0 {
+		name = workflow + "/" + launchPlan
+	}
+	return map[string]string{
+		audit.Project:      project,
+		audit.Domain:       domain,
+		audit.Name:         name,
+		audit.ResourceType: notificationRuleResourceType,
+	}
+}
+
+func (m *AdminService) UpdateNotificationRules(
+	ctx context.Context, request *interfaces.NotificationRuleAttributes) (
+	*interfaces.NotificationRuleUpdateResponse, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationRuleUpdateResponse
+	var err error
+	m.Metrics.notificationRuleEndpointMetrics.update.Time(func() {
+		response, err = m.NotificationRuleManager.UpdateNotificationRules(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationRuleEndpointMetrics.update)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) GetNotificationRules(
+	ctx context.Context, request *interfaces.NotificationRuleGetRequest) (
+	*interfaces.NotificationRuleAttributes, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationRuleAttributes
+	var err error
+	m.Metrics.notificationRuleEndpointMetrics.get.Time(func() {
+		response, err = m.NotificationRuleManager.GetNotificationRules(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationRuleEndpointMetrics.get)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) DeleteNotificationRules(
+	ctx context.Context, request *interfaces.NotificationRuleGetRequest) (
+	*interfaces.NotificationRuleDeleteResponse, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationRuleDeleteResponse
+	var err error
+	m.Metrics.notificationRuleEndpointMetrics.delete.Time(func() {
+		response, err = m.NotificationRuleManager.DeleteNotificationRules(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationRuleEndpointMetrics.delete)
+	}
+
+	return response, nil
+}
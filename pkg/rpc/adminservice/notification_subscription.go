@@ -0,0 +1,100 @@
+package adminservice
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const notificationSubscriptionResourceType = "notification_subscription"
+
+func notificationSubscriptionAuditParameters(project, domain string, id uint) map[string]string {
+	parameters := map[string]string{
+		audit.Project:      project,
+		audit.Domain:       domain,
+		audit.ResourceType: notificationSubscriptionResourceType,
+	}
+	if id > 0 {
+		parameters[audit.Name] = strconv.FormatUint(uint64(id), 10)
+	}
+	return parameters
+}
+
+func (m *AdminService) CreateNotificationSubscription(
+	ctx context.Context, request *interfaces.NotificationSubscription) (*interfaces.NotificationSubscription, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationSubscription
+	var err error
+	m.Metrics.notificationSubscriptionEndpointMetrics.create.Time(func() {
+		response, err = m.NotificationSubscriptionManager.CreateNotificationSubscription(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationSubscriptionEndpointMetrics.create)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) UpdateNotificationSubscription(
+	ctx context.Context, request *interfaces.NotificationSubscription) (*interfaces.NotificationSubscription, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationSubscription
+	var err error
+	m.Metrics.notificationSubscriptionEndpointMetrics.update.Time(func() {
+		response, err = m.NotificationSubscriptionManager.UpdateNotificationSubscription(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationSubscriptionEndpointMetrics.update)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) ListNotificationSubscriptions(
+	ctx context.Context, request *interfaces.NotificationSubscriptionListRequest) (
+	*interfaces.NotificationSubscriptionList, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationSubscriptionList
+	var err error
+	m.Metrics.notificationSubscriptionEndpointMetrics.list.Time(func() {
+		response, err = m.NotificationSubscriptionManager.ListNotificationSubscriptions(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationSubscriptionEndpointMetrics.list)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) DeleteNotificationSubscription(
+	ctx context.Context, request *interfaces.NotificationSubscriptionDeleteRequest) (
+	*interfaces.NotificationSubscriptionDeleteResponse, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationSubscriptionDeleteResponse
+	var err error
+	m.Metrics.notificationSubscriptionEndpointMetrics.delete.Time(func() {
+		response, err = m.NotificationSubscriptionManager.DeleteNotificationSubscription(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationSubscriptionEndpointMetrics.delete)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,95 @@
+package adminservice
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func notificationTemplateAuditParameters(project, domain, name string) map[string]string {
+	return map[string]string{
+		audit.Project: project,
+		audit.Domain:  domain,
+		audit.Name:    name,
+	}
+}
+
+func (m *AdminService) UpdateNotificationTemplates(
+	ctx context.Context, request *interfaces.NotificationTemplateAttributes) (
+	*interfaces.NotificationTemplateUpdateResponse, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationTemplateUpdateResponse
+	var err error
+	m.Metrics.notificationTemplateEndpointMetrics.update.Time(func() {
+		response, err = m.NotificationTemplateManager.UpdateNotificationTemplates(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationTemplateEndpointMetrics.update)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) GetNotificationTemplates(
+	ctx context.Context, request *interfaces.NotificationTemplateGetRequest) (
+	*interfaces.NotificationTemplateAttributes, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationTemplateAttributes
+	var err error
+	m.Metrics.notificationTemplateEndpointMetrics.get.Time(func() {
+		response, err = m.NotificationTemplateManager.GetNotificationTemplates(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationTemplateEndpointMetrics.get)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) DeleteNotificationTemplates(
+	ctx context.Context, request *interfaces.NotificationTemplateGetRequest) (
+	*interfaces.NotificationTemplateDeleteResponse, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationTemplateDeleteResponse
+	var err error
+	m.Metrics.notificationTemplateEndpointMetrics.delete.Time(func() {
+		response, err = m.NotificationTemplateManager.DeleteNotificationTemplates(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationTemplateEndpointMetrics.delete)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) PreviewNotification(
+	ctx context.Context, request *interfaces.NotificationPreviewRequest) (
+	*interfaces.NotificationPreviewResponse, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.NotificationPreviewResponse
+	var err error
+	m.Metrics.notificationTemplateEndpointMetrics.preview.Time(func() {
+		response, err = m.NotificationTemplateManager.PreviewNotification(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationTemplateEndpointMetrics.preview)
+	}
+
+	return response, nil
+}
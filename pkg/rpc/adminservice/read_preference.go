@@ -0,0 +1,65 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata key with which clients request that reads be served by the primary database, for instance to get an
+// execution right after creating it. HTTP clients set the Grpc-Metadata-X-Flyte-Read-Primary header instead.
+const ReadFromPrimaryMetadataKey = "x-flyte-read-primary"
+
+// Header with which clients of the JSON endpoints request that reads be served by the primary database, matching the
+// header grpc-gateway clients set.
+const readFromPrimaryHeader = runtime.MetadataHeaderPrefix + ReadFromPrimaryMetadataKey
+
+// Methods with these prefixes only read state and may be served by a read replica.
+var readOnlyMethodPrefixes = []string{"Get", "List"}
+
+func isReadOnlyMethod(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func readFromPrimaryRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, value := range md.Get(ReadFromPrimaryMetadataKey) {
+		if strings.EqualFold(value, "true") {
+			return true
+		}
+	}
+	return false
+}
+
+// Serves the repository reads of requests from the primary database, rather than a read replica, for methods which
+// change state, since they commonly read what they're about to change, and for requests which ask for it.
+func ReadPreferenceInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if !isReadOnlyMethod(info.FullMethod) || readFromPrimaryRequested(ctx) {
+		ctx = repositoryInterfaces.WithReadFromPrimary(ctx)
+	}
+	return handler(ctx, req)
+}
+
+// Serves the repository reads of JSON requests from the primary database, like ReadPreferenceInterceptor does for
+// gRPC requests. Only get requests read state without changing it.
+func withHTTPReadPreference(ctx context.Context, request *http.Request) context.Context {
+	if request.Method != http.MethodGet || strings.EqualFold(request.Header.Get(readFromPrimaryHeader), "true") {
+		return repositoryInterfaces.WithReadFromPrimary(ctx)
+	}
+	return ctx
+}
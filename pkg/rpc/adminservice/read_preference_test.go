@@ -0,0 +1,65 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func getReadFromPrimary(t *testing.T, ctx context.Context, fullMethod string) bool {
+	var readFromPrimary bool
+	_, err := ReadPreferenceInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			readFromPrimary = repositoryInterfaces.ReadFromPrimary(ctx)
+			return nil, nil
+		})
+	assert.NoError(t, err)
+	return readFromPrimary
+}
+
+func TestReadPreferenceInterceptor(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, getReadFromPrimary(t, ctx, "/flyteidl.service.AdminService/GetExecution"))
+	assert.False(t, getReadFromPrimary(t, ctx, "/flyteidl.service.AdminService/ListNodeExecutions"))
+	assert.True(t, getReadFromPrimary(t, ctx, "/flyteidl.service.AdminService/CreateExecution"))
+	assert.True(t, getReadFromPrimary(t, ctx, "/flyteidl.service.AdminService/UpdateLaunchPlan"))
+	assert.True(t, getReadFromPrimary(t, ctx, "/flyteidl.service.AdminService/CreateNodeEvent"))
+}
+
+func TestReadPreferenceInterceptor_RequestedByClient(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ReadFromPrimaryMetadataKey, "True"))
+	assert.True(t, getReadFromPrimary(t, ctx, "/flyteidl.service.AdminService/GetExecution"))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(ReadFromPrimaryMetadataKey, "false"))
+	assert.False(t, getReadFromPrimary(t, ctx, "/flyteidl.service.AdminService/GetExecution"))
+}
+
+func getHTTPReadFromPrimary(t *testing.T, request *http.Request) bool {
+	var readFromPrimary bool
+	handler := newHTTPHandler(nil, map[string]httpMethodHandler{
+		request.Method: func(ctx context.Context, _ *httpAuditedCall, request *http.Request) (interface{}, error) {
+			readFromPrimary = repositoryInterfaces.ReadFromPrimary(ctx)
+			return struct{}{}, nil
+		},
+	})
+	recorder := httptest.NewRecorder()
+	handler(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	return readFromPrimary
+}
+
+func TestHTTPReadPreference(t *testing.T) {
+	assert.False(t, getHTTPReadFromPrimary(t, httptest.NewRequest(http.MethodGet, auditLogsURL, nil)))
+	assert.True(t, getHTTPReadFromPrimary(t, httptest.NewRequest(http.MethodPost, accessTokensURL, nil)))
+	assert.True(t, getHTTPReadFromPrimary(t, httptest.NewRequest(http.MethodDelete, roleBindingsURL, nil)))
+
+	request := httptest.NewRequest(http.MethodGet, auditLogsURL, nil)
+	request.Header.Set("Grpc-Metadata-X-Flyte-Read-Primary", "true")
+	assert.True(t, getHTTPReadFromPrimary(t, request))
+}
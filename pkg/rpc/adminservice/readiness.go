@@ -0,0 +1,18 @@
+package adminservice
+
+import (
+	"net/http"
+
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// Serves whether the service is ready to serve requests, which it isn't while the database is unreachable or has
+// pending migrations. Unlike the liveness check, failing readiness checks don't call for the service to be restarted.
+func (m *AdminService) HandleReadinessCheck(writer http.ResponseWriter, request *http.Request) {
+	if err := m.repository.CheckReadiness(request.Context()); err != nil {
+		logger.Warningf(request.Context(), "Failed readiness check: %v", err)
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	writer.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,28 @@
+package adminservice
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleReadinessCheck(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	m := AdminService{
+		repository: repository,
+	}
+
+	recorder := httptest.NewRecorder()
+	m.HandleReadinessCheck(recorder, httptest.NewRequest(http.MethodGet, "/readiness", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	repository.ReadinessError = errors.New("database has pending migrations")
+	recorder = httptest.NewRecorder()
+	m.HandleReadinessCheck(recorder, httptest.NewRequest(http.MethodGet, "/readiness", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, "database has pending migrations\n", recorder.Body.String())
+}
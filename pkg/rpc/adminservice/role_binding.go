@@ -0,0 +1,89 @@
+package adminservice
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const roleBindingResourceType = "role_binding"
+
+func roleBindingAuditParameters(project, domain string) map[string]string {
+	return map[string]string{
+		audit.Project:      project,
+		audit.Domain:       domain,
+		audit.ResourceType: roleBindingResourceType,
+	}
+}
+
+func roleBindingCreateAuditParameters(request *interfaces.RoleBinding) map[string]string {
+	parameters := roleBindingAuditParameters(request.Project, request.Domain)
+	parameters[audit.Name] = request.SubjectType + ":" + request.Subject
+	parameters[audit.Role] = request.Role
+	return parameters
+}
+
+func roleBindingDeleteAuditParameters(request *interfaces.RoleBindingDeleteRequest) map[string]string {
+	parameters := roleBindingAuditParameters(request.Project, request.Domain)
+	parameters[audit.Name] = strconv.FormatUint(uint64(request.ID), 10)
+	return parameters
+}
+
+func (m *AdminService) CreateRoleBinding(
+	ctx context.Context, request *interfaces.RoleBinding) (*interfaces.RoleBinding, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.RoleBinding
+	var err error
+	m.Metrics.roleBindingEndpointMetrics.create.Time(func() {
+		response, err = m.RoleBindingManager.CreateRoleBinding(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.roleBindingEndpointMetrics.create)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) ListRoleBindings(
+	ctx context.Context, request *interfaces.RoleBindingListRequest) (*interfaces.RoleBindingList, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.RoleBindingList
+	var err error
+	m.Metrics.roleBindingEndpointMetrics.list.Time(func() {
+		response, err = m.RoleBindingManager.ListRoleBindings(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.roleBindingEndpointMetrics.list)
+	}
+
+	return response, nil
+}
+
+func (m *AdminService) DeleteRoleBinding(
+	ctx context.Context, request *interfaces.RoleBindingDeleteRequest) (*interfaces.RoleBindingDeleteResponse, error) {
+	defer m.interceptPanic(ctx, request)
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Incorrect request, nil requests not allowed")
+	}
+	var response *interfaces.RoleBindingDeleteResponse
+	var err error
+	m.Metrics.roleBindingEndpointMetrics.delete.Time(func() {
+		response, err = m.RoleBindingManager.DeleteRoleBinding(ctx, *request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.roleBindingEndpointMetrics.delete)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	authConfig "github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/rbac"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestCreateAccessToken(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		accessTokenManager: &mocks.MockAccessTokenManager{
+			CreateFunc: func(ctx context.Context, request interfaces.AccessTokenCreateRequest) (
+				*interfaces.AccessTokenCreateResponse, error) {
+				assert.Equal(t, interfaces.AccessTokenCreateRequest{
+					Name:      "ci",
+					Scopes:    []string{auth.ScopeExecutionsWrite},
+					ExpiresIn: "720h",
+				}, request)
+				return &interfaces.AccessTokenCreateResponse{
+					AccessToken: interfaces.AccessToken{ID: 1, Name: request.Name},
+					Token:       auth.AccessTokenPrefix + "secret",
+				}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/access_tokens",
+		strings.NewReader(`{"name":"ci","scopes":["executions:write"],"expiresIn":"720h"}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.AccessTokenCreateResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, uint(1), response.ID)
+	assert.Equal(t, auth.AccessTokenPrefix+"secret", response.Token)
+}
+
+func TestListAccessTokens(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		accessTokenManager: &mocks.MockAccessTokenManager{
+			ListFunc: func(ctx context.Context, request interfaces.AccessTokenListRequest) (
+				*interfaces.AccessTokenList, error) {
+				return &interfaces.AccessTokenList{
+					AccessTokens: []interfaces.AccessToken{{ID: 1, Name: "ci"}},
+				}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/access_tokens", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.AccessTokenList
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.AccessTokens, 1)
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	var revokeCalled bool
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		accessTokenManager: &mocks.MockAccessTokenManager{
+			RevokeFunc: func(ctx context.Context, request interfaces.AccessTokenRevokeRequest) (
+				*interfaces.AccessTokenRevokeResponse, error) {
+				assert.Equal(t, interfaces.AccessTokenRevokeRequest{ID: 3}, request)
+				revokeCalled = true
+				return &interfaces.AccessTokenRevokeResponse{}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/api/v1/access_tokens?id=3", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, revokeCalled)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/api/v1/access_tokens?id=foo", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCreateAccessToken_Authorization(t *testing.T) {
+	authorizer := rbac.NewAuthorizer(authConfig.AuthorizationConfig{
+		Enabled: true,
+	}, repositoryMocks.NewMockRoleBindingRepo(), promutils.NewTestScope())
+	var createCalled bool
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		accessTokenManager: &mocks.MockAccessTokenManager{
+			CreateFunc: func(ctx context.Context, request interfaces.AccessTokenCreateRequest) (
+				*interfaces.AccessTokenCreateResponse, error) {
+				createCalled = true
+				return &interfaces.AccessTokenCreateResponse{}, nil
+			},
+		},
+		authorizer: authorizer,
+	})
+	newRequest := func(body string, scopes ...string) *http.Request {
+		ctx := auth.NewIdentityContext("", "alice", "", time.Now(), sets.NewString(scopes...), nil).WithContext(
+			context.Background())
+		return httptest.NewRequest(http.MethodPost, "/api/v1/access_tokens", strings.NewReader(body)).WithContext(ctx)
+	}
+
+	// Any user can mint personal access tokens, but only admins tokens of service accounts.
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, newRequest(`{"name":"ci","serviceAccount":"ci-bot"}`, auth.ScopeAll))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.False(t, createCalled)
+
+	// Tokens restricted to fine-grained scopes can't mint tokens.
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, newRequest(`{"name":"ci"}`, auth.ScopeExecutionsWrite))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.False(t, createCalled)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, newRequest(`{"name":"ci"}`, auth.ScopeAll))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, createCalled)
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	authConfig "github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/rbac"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestListAuditLogs(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		auditLogManager: &mocks.MockAuditLogManager{
+			ListFunc: func(ctx context.Context, request interfaces.AuditLogListRequest) (*interfaces.AuditLogList, error) {
+				assert.Equal(t, interfaces.AuditLogListRequest{
+					Project: "project",
+					Domain:  "domain",
+					Filters: "eq(subject,alice)+eq(method,TerminateExecution)",
+					Limit:   10,
+					Token:   "10",
+				}, request)
+				return &interfaces.AuditLogList{
+					AuditLogs: []interfaces.AuditLogEntry{{ID: 1, Subject: "alice", Method: "TerminateExecution"}},
+					Token:     "20",
+				}, nil
+			},
+		},
+	})
+
+	query := url.Values{
+		"project": {"project"},
+		"domain":  {"domain"},
+		"filters": {"eq(subject,alice)+eq(method,TerminateExecution)"},
+		"limit":   {"10"},
+		"token":   {"10"},
+	}
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/audit_logs?"+query.Encode(), nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.AuditLogList
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.AuditLogs, 1)
+	assert.Equal(t, "alice", response.AuditLogs[0].Subject)
+	assert.Equal(t, "20", response.Token)
+}
+
+func TestListAuditLogs_InvalidLimit(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		auditLogManager: &mocks.MockAuditLogManager{},
+		authorizer:      nil,
+	})
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/audit_logs?limit=ten", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestListAuditLogs_PermissionDenied(t *testing.T) {
+	authorizer := rbac.NewAuthorizer(authConfig.AuthorizationConfig{
+		Enabled: true,
+		RoleBindings: []authConfig.RoleBinding{
+			{SubjectType: "user", Subject: "alice", Role: "admin", Project: "project"},
+		},
+	}, repositoryMocks.NewMockRoleBindingRepo(), promutils.NewTestScope())
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		auditLogManager: &mocks.MockAuditLogManager{
+			ListFunc: func(ctx context.Context, request interfaces.AuditLogListRequest) (*interfaces.AuditLogList, error) {
+				assert.Equal(t, "project", request.Project)
+				return &interfaces.AuditLogList{}, nil
+			},
+		},
+		authorizer: authorizer,
+	})
+
+	ctx := auth.NewIdentityContext("", "alice", "", time.Now(), sets.NewString(auth.ScopeAll), nil).WithContext(
+		context.Background())
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/audit_logs?project=project&limit=10", nil).
+		WithContext(ctx))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	// Listing the audit log of all projects requires a global admin.
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/audit_logs?limit=10", nil).
+		WithContext(ctx))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetExecutionTimeline(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		executionTimelineManager: &mocks.MockExecutionTimelineManager{
+			GetFunc: func(ctx context.Context, request interfaces.ExecutionTimelineRequest) (
+				*interfaces.ExecutionTimeline, error) {
+				assert.Equal(t, interfaces.ExecutionTimelineRequest{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+					NodeID:  "n0",
+				}, request)
+				return &interfaces.ExecutionTimeline{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+					NodeExecutions: []interfaces.NodeExecutionTimeline{
+						{
+							NodeID:      "n0",
+							Transitions: []interfaces.PhaseTransition{{Phase: "QUEUED"}},
+						},
+					},
+				}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/execution_timelines?project=project&domain=domain&name=name&node_id=n0", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ExecutionTimeline
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.NodeExecutions, 1)
+	assert.Equal(t, "QUEUED", response.NodeExecutions[0].Transitions[0].Phase)
+}
+
+func TestGetExecutionTimeline_NotFound(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		executionTimelineManager: &mocks.MockExecutionTimelineManager{
+			GetFunc: func(ctx context.Context, request interfaces.ExecutionTimelineRequest) (
+				*interfaces.ExecutionTimeline, error) {
+				return nil, errors.NewFlyteAdminError(codes.NotFound, "missing execution")
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/execution_timelines?project=project&domain=domain&name=name", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListNotificationDeliveries(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationDeliveryManager: &mocks.MockNotificationDeliveryManager{
+			ListFunc: func(ctx context.Context, request interfaces.NotificationDeliveryListRequest) (
+				*interfaces.NotificationDeliveryList, error) {
+				assert.Equal(t, interfaces.NotificationDeliveryListRequest{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				}, request)
+				return &interfaces.NotificationDeliveryList{
+					Deliveries: []interfaces.NotificationDelivery{
+						{ID: 1, Status: "FAILED", Error: "oops"},
+					},
+				}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/notification_deliveries?project=project&domain=domain&name=name", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NotificationDeliveryList
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Deliveries, 1)
+	assert.Equal(t, "oops", response.Deliveries[0].Error)
+}
+
+func TestResendNotificationDelivery(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationDeliveryManager: &mocks.MockNotificationDeliveryManager{
+			ResendFunc: func(ctx context.Context, request interfaces.NotificationDeliveryResendRequest) (
+				*interfaces.NotificationDelivery, error) {
+				assert.Equal(t, "project", request.Project)
+				assert.Equal(t, "domain", request.Domain)
+				if request.ID != 1 {
+					return nil, errors.NewFlyteAdminError(codes.FailedPrecondition, "not failed")
+				}
+				return &interfaces.NotificationDelivery{ID: 1, Status: "QUEUED"}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/notification_deliveries/resend",
+		strings.NewReader(`{"id":1,"project":"project","domain":"domain"}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NotificationDelivery
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "QUEUED", response.Status)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/notification_deliveries/resend",
+		strings.NewReader(`{"id":2,"project":"project","domain":"domain"}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "not failed")
+}
@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUpdateNotificationRules(t *testing.T) {
+	var updateCalled bool
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationRuleManager: &mocks.MockNotificationRuleManager{
+			UpdateFunc: func(ctx context.Context, request interfaces.NotificationRuleAttributes) (
+				*interfaces.NotificationRuleUpdateResponse, error) {
+				assert.Equal(t, "workflow", request.Workflow)
+				assert.Equal(t, "launch_plan", request.LaunchPlan)
+				assert.Equal(t, []runtimeInterfaces.NotificationRule{
+					{
+						TaskName:        "train",
+						Phases:          []string{"FAILED"},
+						MinRetryAttempt: 2,
+						Channel:         "slack",
+						Recipients:      []string{"ml@example.com"},
+					},
+				}, request.Rules.Tasks)
+				updateCalled = true
+				return &interfaces.NotificationRuleUpdateResponse{}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, "/api/v1/notification_rules", strings.NewReader(
+		`{"project":"project","domain":"domain","workflow":"workflow","launchPlan":"launch_plan",`+
+			`"rules":{"tasks":[{"taskName":"train","phases":["FAILED"],"minRetryAttempt":2,"channel":"slack",`+
+			`"recipients":["ml@example.com"]}]}}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, updateCalled)
+}
+
+func TestGetNotificationRules(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationRuleManager: &mocks.MockNotificationRuleManager{
+			GetFunc: func(ctx context.Context, request interfaces.NotificationRuleGetRequest) (
+				*interfaces.NotificationRuleAttributes, error) {
+				assert.Equal(t, interfaces.NotificationRuleGetRequest{
+					Project:    "project",
+					Domain:     "domain",
+					Workflow:   "workflow",
+					LaunchPlan: "launch_plan",
+				}, request)
+				return &interfaces.NotificationRuleAttributes{
+					Project: "project",
+					Domain:  "domain",
+					Rules: runtimeInterfaces.NotificationRules{
+						Nodes: []runtimeInterfaces.NotificationRule{
+							{NodeID: "n0", Phases: []string{"FAILED"}, Recipients: []string{"a@example.com"}},
+						},
+					},
+				}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/notification_rules?project=project&domain=domain&workflow=workflow&launch_plan=launch_plan", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NotificationRuleAttributes
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "n0", response.Rules.Nodes[0].NodeID)
+}
+
+func TestDeleteNotificationRules_NotFound(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationRuleManager: &mocks.MockNotificationRuleManager{
+			DeleteFunc: func(ctx context.Context, request interfaces.NotificationRuleGetRequest) (
+				*interfaces.NotificationRuleDeleteResponse, error) {
+				return nil, errors.NewFlyteAdminError(codes.NotFound, "no rules")
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete,
+		"/api/v1/notification_rules?project=project&domain=domain", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "no rules")
+}
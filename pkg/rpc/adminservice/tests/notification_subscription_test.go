@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNotificationSubscription(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationSubscriptionManager: &mocks.MockNotificationSubscriptionManager{
+			CreateFunc: func(ctx context.Context, request interfaces.NotificationSubscription) (
+				*interfaces.NotificationSubscription, error) {
+				assert.Equal(t, interfaces.NotificationSubscription{
+					Project:    "project",
+					Domain:     "domain",
+					LaunchPlan: "launch_plan",
+					Channel:    "slack",
+					Recipient:  "@oncall",
+					Phases:     []string{"FAILED"},
+				}, request)
+				request.ID = 7
+				return &request, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/notification_subscriptions",
+		strings.NewReader(`{"project":"project","domain":"domain","launchPlan":"launch_plan","channel":"slack",`+
+			`"recipient":"@oncall","phases":["FAILED"]}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NotificationSubscription
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, uint(7), response.ID)
+}
+
+func TestUpdateNotificationSubscription(t *testing.T) {
+	var updateCalled bool
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationSubscriptionManager: &mocks.MockNotificationSubscriptionManager{
+			UpdateFunc: func(ctx context.Context, request interfaces.NotificationSubscription) (
+				*interfaces.NotificationSubscription, error) {
+				assert.Equal(t, uint(7), request.ID)
+				assert.Equal(t, []string{"FAILED", "ABORTED"}, request.Phases)
+				updateCalled = true
+				return &request, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, "/api/v1/notification_subscriptions",
+		strings.NewReader(`{"id":7,"project":"project","domain":"domain","recipient":"a@example.com",`+
+			`"phases":["FAILED","ABORTED"]}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, updateCalled)
+}
+
+func TestListNotificationSubscriptions(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationSubscriptionManager: &mocks.MockNotificationSubscriptionManager{
+			ListFunc: func(ctx context.Context, request interfaces.NotificationSubscriptionListRequest) (
+				*interfaces.NotificationSubscriptionList, error) {
+				assert.Equal(t, interfaces.NotificationSubscriptionListRequest{
+					Project:  "project",
+					Domain:   "domain",
+					Workflow: "workflow",
+				}, request)
+				return &interfaces.NotificationSubscriptionList{
+					Subscriptions: []interfaces.NotificationSubscription{{ID: 1, Recipient: "a@example.com"}},
+				}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/notification_subscriptions?project=project&domain=domain&workflow=workflow", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NotificationSubscriptionList
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "a@example.com", response.Subscriptions[0].Recipient)
+}
+
+func TestDeleteNotificationSubscription(t *testing.T) {
+	var deleted interfaces.NotificationSubscriptionDeleteRequest
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationSubscriptionManager: &mocks.MockNotificationSubscriptionManager{
+			DeleteFunc: func(ctx context.Context, request interfaces.NotificationSubscriptionDeleteRequest) (
+				*interfaces.NotificationSubscriptionDeleteResponse, error) {
+				deleted = request
+				return &interfaces.NotificationSubscriptionDeleteResponse{}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete,
+		"/api/v1/notification_subscriptions?id=7&project=project&domain=domain", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, interfaces.NotificationSubscriptionDeleteRequest{
+		ID: 7, Project: "project", Domain: "domain",
+	}, deleted)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/api/v1/notification_subscriptions?id=x", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
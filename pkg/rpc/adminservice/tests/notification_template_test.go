@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUpdateNotificationTemplates(t *testing.T) {
+	var updateCalled bool
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationTemplateManager: &mocks.MockNotificationTemplateManager{
+			UpdateFunc: func(ctx context.Context, request interfaces.NotificationTemplateAttributes) (
+				*interfaces.NotificationTemplateUpdateResponse, error) {
+				assert.Equal(t, "project", request.Project)
+				assert.Equal(t, "domain", request.Domain)
+				assert.Equal(t, runtimeInterfaces.NotificationTemplate{
+					Subject: "{{ .Name }}",
+					Body:    "<b>{{ .Phase }}</b>",
+					HTML:    true,
+				}, request.Templates["default"])
+				updateCalled = true
+				return &interfaces.NotificationTemplateUpdateResponse{}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, "/api/v1/notification_templates", strings.NewReader(
+		`{"project":"project","domain":"domain","templates":{"default":{"subject":"{{ .Name }}","body":"<b>{{ .Phase }}</b>","html":true}}}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, updateCalled)
+}
+
+func TestGetNotificationTemplates(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationTemplateManager: &mocks.MockNotificationTemplateManager{
+			GetFunc: func(ctx context.Context, request interfaces.NotificationTemplateGetRequest) (
+				*interfaces.NotificationTemplateAttributes, error) {
+				assert.Equal(t, interfaces.NotificationTemplateGetRequest{
+					Project:  "project",
+					Domain:   "domain",
+					Workflow: "workflow",
+				}, request)
+				return &interfaces.NotificationTemplateAttributes{
+					Project:  "project",
+					Domain:   "domain",
+					Workflow: "workflow",
+					Templates: map[string]runtimeInterfaces.NotificationTemplate{
+						"failed": {Subject: "subject", Body: "body"},
+					},
+				}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/notification_templates?project=project&domain=domain&workflow=workflow", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NotificationTemplateAttributes
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "body", response.Templates["failed"].Body)
+}
+
+func TestDeleteNotificationTemplates_NotFound(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationTemplateManager: &mocks.MockNotificationTemplateManager{
+			DeleteFunc: func(ctx context.Context, request interfaces.NotificationTemplateGetRequest) (
+				*interfaces.NotificationTemplateDeleteResponse, error) {
+				return nil, errors.NewFlyteAdminError(codes.NotFound, "no templates")
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete,
+		"/api/v1/notification_templates?project=project&domain=domain", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "no templates")
+}
+
+func TestPreviewNotification(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		notificationTemplateManager: &mocks.MockNotificationTemplateManager{
+			PreviewFunc: func(ctx context.Context, request interfaces.NotificationPreviewRequest) (
+				*interfaces.NotificationPreviewResponse, error) {
+				assert.Equal(t, "name", request.Name)
+				assert.Equal(t, "failed", request.Phase)
+				return &interfaces.NotificationPreviewResponse{
+					Subject: "subject",
+					Body:    "body",
+					Source:  "config",
+				}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/notification_templates/preview",
+		strings.NewReader(`{"project":"project","domain":"domain","name":"name","phase":"failed"}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NotificationPreviewResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "config", response.Source)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/notification_templates/preview",
+		strings.NewReader(`{"project":`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/notification_templates/preview", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
@@ -0,0 +1,165 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	authConfig "github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/rbac"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestCreateRoleBinding(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		roleBindingManager: &mocks.MockRoleBindingManager{
+			CreateFunc: func(ctx context.Context, request interfaces.RoleBinding) (*interfaces.RoleBinding, error) {
+				assert.Equal(t, interfaces.RoleBinding{
+					SubjectType: "user",
+					Subject:     "alice",
+					Role:        "contributor",
+					Project:     "project",
+					Domain:      "domain",
+				}, request)
+				request.ID = 3
+				return &request, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/role_bindings",
+		strings.NewReader(`{"subjectType":"user","subject":"alice","role":"contributor","project":"project",`+
+			`"domain":"domain"}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.RoleBinding
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, uint(3), response.ID)
+}
+
+func TestListRoleBindings(t *testing.T) {
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		roleBindingManager: &mocks.MockRoleBindingManager{
+			ListFunc: func(ctx context.Context, request interfaces.RoleBindingListRequest) (
+				*interfaces.RoleBindingList, error) {
+				assert.Equal(t, interfaces.RoleBindingListRequest{Project: "project", Domain: "domain"}, request)
+				return &interfaces.RoleBindingList{
+					RoleBindings: []interfaces.RoleBinding{{ID: 1, Subject: "alice"}},
+				}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/role_bindings?project=project&domain=domain", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.RoleBindingList
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.RoleBindings, 1)
+}
+
+func TestDeleteRoleBinding(t *testing.T) {
+	var deleteCalled bool
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		roleBindingManager: &mocks.MockRoleBindingManager{
+			DeleteFunc: func(ctx context.Context, request interfaces.RoleBindingDeleteRequest) (
+				*interfaces.RoleBindingDeleteResponse, error) {
+				assert.Equal(t, interfaces.RoleBindingDeleteRequest{ID: 3, Project: "project"}, request)
+				deleteCalled = true
+				return &interfaces.RoleBindingDeleteResponse{}, nil
+			},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/api/v1/role_bindings?id=3&project=project", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, deleteCalled)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/api/v1/role_bindings?id=foo", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestRoleBindings_PermissionDenied(t *testing.T) {
+	authorizer := rbac.NewAuthorizer(authConfig.AuthorizationConfig{
+		Enabled: true,
+		RoleBindings: []authConfig.RoleBinding{
+			{SubjectType: "user", Subject: "alice", Role: "contributor", Project: "project"},
+		},
+	}, repositoryMocks.NewMockRoleBindingRepo(), promutils.NewTestScope())
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		roleBindingManager: &mocks.MockRoleBindingManager{
+			ListFunc: func(ctx context.Context, request interfaces.RoleBindingListRequest) (
+				*interfaces.RoleBindingList, error) {
+				assert.Fail(t, "unauthorized request shouldn't be served")
+				return nil, nil
+			},
+		},
+		authorizer: authorizer,
+	})
+
+	ctx := auth.NewIdentityContext("", "alice", "", time.Now(), sets.NewString(auth.ScopeAll), nil).WithContext(
+		context.Background())
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/role_bindings?project=project", nil).
+		WithContext(ctx))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "PermissionDenied")
+}
+
+type recordingAuditSink struct {
+	messages []audit.Message
+}
+
+func (s *recordingAuditSink) Write(_ context.Context, message audit.Message) error {
+	s.messages = append(s.messages, message)
+	return nil
+}
+
+func TestCreateRoleBinding_PermissionDeniedIsAudited(t *testing.T) {
+	sink := &recordingAuditSink{}
+	audit.SetSink(sink)
+	defer audit.SetSink(audit.NewLoggerSink())
+	authorizer := rbac.NewAuthorizer(authConfig.AuthorizationConfig{
+		Enabled: true,
+		RoleBindings: []authConfig.RoleBinding{
+			{SubjectType: "user", Subject: "alice", Role: "contributor", Project: "project"},
+		},
+	}, repositoryMocks.NewMockRoleBindingRepo(), promutils.NewTestScope())
+	mux := NewMockHTTPMux(NewMockAdminServerInput{
+		roleBindingManager: &mocks.MockRoleBindingManager{},
+		authorizer:         authorizer,
+	})
+
+	ctx := auth.NewIdentityContext("", "alice", "", time.Now(), sets.NewString(auth.ScopeAll), nil).WithContext(
+		context.Background())
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/role_bindings",
+		strings.NewReader(`{"subjectType":"user","subject":"alice","role":"admin","project":"project"}`)).
+		WithContext(ctx))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Len(t, sink.messages, 1)
+	assert.Equal(t, "CreateRoleBinding", sink.messages[0].Request.Method)
+	assert.Equal(t, audit.ReadWrite, sink.messages[0].Request.Mode)
+	assert.Equal(t, map[string]string{
+		audit.Project:      "project",
+		audit.Domain:       "",
+		audit.Name:         "user:alice",
+		audit.Role:         "admin",
+		audit.ResourceType: "role_binding",
+	}, sink.messages[0].Request.Parameters)
+	assert.Equal(t, "PermissionDenied", sink.messages[0].Response.ResponseCode)
+}
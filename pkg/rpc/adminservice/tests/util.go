@@ -1,7 +1,10 @@
 package tests
 
 import (
+	"net/http"
+
 	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/rbac"
 	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 )
@@ -15,6 +18,17 @@ type NewMockAdminServerInput struct {
 	taskManager          *mocks.MockTaskManager
 	workflowManager      *mocks.MockWorkflowManager
 	taskExecutionManager *mocks.MockTaskExecutionManager
+
+	executionTimelineManager    *mocks.MockExecutionTimelineManager
+	notificationTemplateManager *mocks.MockNotificationTemplateManager
+	notificationDeliveryManager *mocks.MockNotificationDeliveryManager
+	notificationRuleManager     *mocks.MockNotificationRuleManager
+
+	notificationSubscriptionManager *mocks.MockNotificationSubscriptionManager
+	roleBindingManager              *mocks.MockRoleBindingManager
+	accessTokenManager              *mocks.MockAccessTokenManager
+	auditLogManager                 *mocks.MockAuditLogManager
+	authorizer                      *rbac.Authorizer
 }
 
 func NewMockAdminServer(input NewMockAdminServerInput) *adminservice.AdminService {
@@ -28,6 +42,23 @@ func NewMockAdminServer(input NewMockAdminServerInput) *adminservice.AdminServic
 		ResourceManager:      input.resourceManager,
 		WorkflowManager:      input.workflowManager,
 		TaskExecutionManager: input.taskExecutionManager,
-		Metrics:              adminservice.InitMetrics(testScope),
+
+		ExecutionTimelineManager:        input.executionTimelineManager,
+		NotificationTemplateManager:     input.notificationTemplateManager,
+		NotificationDeliveryManager:     input.notificationDeliveryManager,
+		NotificationRuleManager:         input.notificationRuleManager,
+		NotificationSubscriptionManager: input.notificationSubscriptionManager,
+		RoleBindingManager:              input.roleBindingManager,
+		AccessTokenManager:              input.accessTokenManager,
+		AuditLogManager:                 input.auditLogManager,
+		Authorizer:                      input.authorizer,
+		Metrics:                         adminservice.InitMetrics(testScope),
 	}
 }
+
+// Returns a mux serving the JSON endpoints of a mock admin server, with authentication disabled.
+func NewMockHTTPMux(input NewMockAdminServerInput) *http.ServeMux {
+	mux := http.NewServeMux()
+	NewMockAdminServer(input).RegisterHTTPHandlers(mux, nil)
+	return mux
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
@@ -30,6 +31,11 @@ var databaseConfig = config.MustRegisterSection(database, &interfaces.DbConfigSe
 	Host:         postgres,
 	DbName:       postgres,
 	ExtraOptions: "sslmode=disable",
+	MaxOpenConns: 100,
+	MaxIdleConns: 10,
+	ConnMaxLifetime: config.Duration{
+		Duration: time.Hour,
+	},
 })
 var flyteAdminConfig = config.MustRegisterSection(flyteAdmin, &interfaces.ApplicationConfig{
 	ProfilerPort:          10254,
@@ -81,30 +87,64 @@ var externalEventsConfig = config.MustRegisterSection(externalEvents, &interface
 // Implementation of an interfaces.ApplicationConfiguration
 type ApplicationConfigurationProvider struct{}
 
+// Returns the password, read from the password path when one is set.
+func resolveDbPassword(password, passwordPath string) string {
+	if len(passwordPath) == 0 {
+		return password
+	}
+	if _, err := os.Stat(passwordPath); os.IsNotExist(err) {
+		logger.Fatalf(context.Background(),
+			"missing database password at specified path [%s]", passwordPath)
+	}
+	passwordVal, err := ioutil.ReadFile(passwordPath)
+	if err != nil {
+		logger.Fatalf(context.Background(), "failed to read database password from path [%s] with err: %v",
+			passwordPath, err)
+	}
+	return string(passwordVal)
+}
+
+// Returns the config of the read replica, which defaults to the config of the primary database, or nil when no replica
+// is configured.
+func getReadReplicaConfig(
+	dbConfig interfaces.DbConfig, replicaSection interfaces.ReadReplicaConfigSection) *interfaces.DbConfig {
+	if len(replicaSection.Host) == 0 {
+		return nil
+	}
+	replicaConfig := dbConfig
+	replicaConfig.Host = replicaSection.Host
+	if replicaSection.Port != 0 {
+		replicaConfig.Port = replicaSection.Port
+	}
+	if len(replicaSection.User) > 0 {
+		replicaConfig.User = replicaSection.User
+	}
+	if len(replicaSection.Password) > 0 || len(replicaSection.PasswordPath) > 0 {
+		replicaConfig.Password = resolveDbPassword(replicaSection.Password, replicaSection.PasswordPath)
+	}
+	if len(replicaSection.ExtraOptions) > 0 {
+		replicaConfig.ExtraOptions = replicaSection.ExtraOptions
+	}
+	return &replicaConfig
+}
+
 func (p *ApplicationConfigurationProvider) GetDbConfig() interfaces.DbConfig {
 	dbConfigSection := databaseConfig.GetConfig().(*interfaces.DbConfigSection)
-	password := dbConfigSection.Password
-	if len(dbConfigSection.PasswordPath) > 0 {
-		if _, err := os.Stat(dbConfigSection.PasswordPath); os.IsNotExist(err) {
-			logger.Fatalf(context.Background(),
-				"missing database password at specified path [%s]", dbConfigSection.PasswordPath)
-		}
-		passwordVal, err := ioutil.ReadFile(dbConfigSection.PasswordPath)
-		if err != nil {
-			logger.Fatalf(context.Background(), "failed to read database password from path [%s] with err: %v",
-				dbConfigSection.PasswordPath, err)
-		}
-		password = string(passwordVal)
-	}
-	return interfaces.DbConfig{
-		Host:         dbConfigSection.Host,
-		Port:         dbConfigSection.Port,
-		DbName:       dbConfigSection.DbName,
-		User:         dbConfigSection.User,
-		Password:     password,
-		ExtraOptions: dbConfigSection.ExtraOptions,
-		Debug:        dbConfigSection.Debug,
+	dbConfig := interfaces.DbConfig{
+		Type:            dbConfigSection.Type,
+		Host:            dbConfigSection.Host,
+		Port:            dbConfigSection.Port,
+		DbName:          dbConfigSection.DbName,
+		User:            dbConfigSection.User,
+		Password:        resolveDbPassword(dbConfigSection.Password, dbConfigSection.PasswordPath),
+		ExtraOptions:    dbConfigSection.ExtraOptions,
+		Debug:           dbConfigSection.Debug,
+		MaxOpenConns:    dbConfigSection.MaxOpenConns,
+		MaxIdleConns:    dbConfigSection.MaxIdleConns,
+		ConnMaxLifetime: dbConfigSection.ConnMaxLifetime.Duration,
 	}
+	dbConfig.ReadReplica = getReadReplicaConfig(dbConfig, dbConfigSection.ReadReplica)
+	return dbConfig
 }
 
 func (p *ApplicationConfigurationProvider) GetTopLevelConfig() *interfaces.ApplicationConfig {
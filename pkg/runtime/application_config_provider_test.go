@@ -0,0 +1,49 @@
+package runtime
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReadReplicaConfig(t *testing.T) {
+	dbConfig := interfaces.DbConfig{
+		Type:         "postgres",
+		Host:         "primary",
+		Port:         5432,
+		DbName:       "flyteadmin",
+		User:         "flyte",
+		Password:     "secret",
+		ExtraOptions: "sslmode=disable",
+	}
+	assert.Nil(t, getReadReplicaConfig(dbConfig, interfaces.ReadReplicaConfigSection{}))
+
+	replicaConfig := getReadReplicaConfig(dbConfig, interfaces.ReadReplicaConfigSection{
+		Host: "replica",
+	})
+	expectedConfig := dbConfig
+	expectedConfig.Host = "replica"
+	assert.Equal(t, &expectedConfig, replicaConfig)
+
+	passwordPath := filepath.Join(t.TempDir(), "password")
+	assert.NoError(t, ioutil.WriteFile(passwordPath, []byte("replica-secret"), 0600))
+	replicaConfig = getReadReplicaConfig(dbConfig, interfaces.ReadReplicaConfigSection{
+		Host:         "replica",
+		Port:         6432,
+		User:         "reader",
+		PasswordPath: passwordPath,
+		ExtraOptions: "sslmode=require",
+	})
+	assert.Equal(t, &interfaces.DbConfig{
+		Type:         "postgres",
+		Host:         "replica",
+		Port:         6432,
+		DbName:       "flyteadmin",
+		User:         "reader",
+		Password:     "replica-secret",
+		ExtraOptions: "sslmode=require",
+	}, replicaConfig)
+}
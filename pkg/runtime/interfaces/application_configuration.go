@@ -1,11 +1,19 @@
 package interfaces
 
-import "golang.org/x/time/rate"
+import (
+	"time"
+
+	"github.com/flyteorg/flytestdlib/config"
+	"golang.org/x/time/rate"
+)
 
 // This configuration section is used to for initiating the database connection with the store that holds registered
 // entities (e.g. workflows, tasks, launch plans...)
 // This struct specifically maps to the flyteadmin config yaml structure.
 type DbConfigSection struct {
+	// The database type, one of "postgres" (the default), "mysql" or "sqlite". SQLite databases are stored in a single
+	// file, at the path set as the database name, and are intended for single-node deployments and tests.
+	Type string `json:"type"`
 	// The host name of the database server
 	Host string `json:"host"`
 	// The port name of the database server
@@ -22,12 +30,37 @@ type DbConfigSection struct {
 	ExtraOptions string `json:"options"`
 	// Whether or not to start the database connection with debug mode enabled.
 	Debug bool `json:"debug"`
+	// The maximum number of open connections to the database, unlimited when 0.
+	MaxOpenConns int `json:"maxOpenConns"`
+	// The maximum number of idle connections kept open to the database.
+	MaxIdleConns int `json:"maxIdleConns"`
+	// The maximum amount of time a connection is reused for, connections are reused forever when 0.
+	ConnMaxLifetime config.Duration `json:"connMaxLifetime"`
+	// An optional read replica of the database. When a replica host is set, get and list queries of the admin service
+	// are issued on the replica.
+	ReadReplica ReadReplicaConfigSection `json:"readReplica"`
+}
+
+// Connection settings for the read replica of the database. Unset values default to those of the primary database.
+type ReadReplicaConfigSection struct {
+	// The host name of the replica server. Reads are issued on the primary database when unset.
+	Host string `json:"host"`
+	// The port of the replica server.
+	Port int `json:"port"`
+	// The user who is connecting to the replica server.
+	User string `json:"username"`
+	// The password, or the path of a file containing the password, of the replica user.
+	Password     string `json:"password"`
+	PasswordPath string `json:"passwordPath"`
+	// Connection options for the replica, see the options of the primary database.
+	ExtraOptions string `json:"options"`
 }
 
 // This represents a configuration used for initiating database connections much like DbConfigSection, however the
 // password is *resolved* in this struct and therefore it is used as the value the runtime provider returns to callers
 // requesting the database config.
 type DbConfig struct {
+	Type         string `json:"type"`
 	Host         string `json:"host"`
 	Port         int    `json:"port"`
 	DbName       string `json:"dbname"`
@@ -35,6 +68,12 @@ type DbConfig struct {
 	Password     string `json:"password"`
 	ExtraOptions string `json:"options"`
 	Debug        bool   `json:"debug"`
+	// Connection pool settings, which apply to the read replica as well.
+	MaxOpenConns    int           `json:"maxOpenConns"`
+	MaxIdleConns    int           `json:"maxIdleConns"`
+	ConnMaxLifetime time.Duration `json:"connMaxLifetime"`
+	// The resolved config of the read replica, if one is configured.
+	ReadReplica *DbConfig `json:"readReplica"`
 }
 
 // This configuration is the base configuration to start admin
@@ -54,6 +93,36 @@ type ApplicationConfig struct {
 	EventVersion int `json:"eventVersion"`
 	// Specifies the shared buffer size which is used to queue asynchronous event writes.
 	AsyncEventsBufferSize int `json:"asyncEventsBufferSize"`
+	// Configures how the queued asynchronous event writes are batched.
+	AsyncEventsBatching AsyncEventsBatchConfig `json:"asyncEventsBatching"`
+	// Serialized execution, node execution and task execution closures larger than this many bytes are offloaded to
+	// the configured storage, under the metadata storage prefix, rather than stored in the database. Closures are
+	// stored in the database regardless of their size when unset.
+	ClosureOffloadingThresholdBytes int `json:"closureOffloadingThresholdBytes"`
+	// Configures the lifetimes of the access tokens users mint for themselves and for service accounts.
+	AccessTokens AccessTokensConfig `json:"accessTokens"`
+}
+
+// Configures the lifetimes of personal and service account access tokens.
+type AccessTokensConfig struct {
+	// The lifetime of tokens created without an explicit one. Defaults to 720h (30 days).
+	DefaultLifetime config.Duration `json:"defaultLifetime"`
+	// The longest lifetime a token can be created with. Defaults to 8760h (365 days).
+	MaxLifetime config.Duration `json:"maxLifetime"`
+}
+
+// Configures how asynchronously written workflow and node execution events are batched into database inserts. Queued
+// events are inserted once a batch of them is queued or the flush interval elapsed, whichever happens first.
+type AsyncEventsBatchConfig struct {
+	// The maximum number of events inserted at once. Defaults to 100.
+	BatchSize int `json:"batchSize"`
+	// The maximum time, in milliseconds, events are queued for before being inserted. Defaults to 500.
+	FlushIntervalMillis int `json:"flushIntervalMillis"`
+	// The number of times inserts failing with transient database errors are retried before their events are dropped.
+	// Defaults to 3.
+	MaxRetries int `json:"maxRetries"`
+	// The time, in milliseconds, waited before the first retry of an insert. Doubles with every retry. Defaults to 100.
+	RetryBackoffMillis int `json:"retryBackoffMillis"`
 }
 
 // This section holds common config for AWS
@@ -289,6 +358,10 @@ type NotificationsProcessorConfig struct {
 	// The account id (according to whichever cloud provider scheme is used) that has permission to read from the above
 	// queue.
 	AccountID string `json:"accountId"`
+	// The number of times to attempt delivering a notification before recording it as failed. Defaults to 3.
+	MaxDeliveryAttempts int `json:"maxDeliveryAttempts"`
+	// The delay before the first delivery retry, doubled for every subsequent retry. Defaults to 1 second.
+	DeliveryRetryDelaySeconds int `json:"deliveryRetryDelaySeconds"`
 }
 
 type EmailServerConfig struct {
@@ -298,6 +371,37 @@ type EmailServerConfig struct {
 	APIKeyFilePath string `json:"apiKeyFilePath"`
 }
 
+// A notification template written using Go template syntax (https://golang.org/pkg/text/template/).
+type NotificationTemplate struct {
+	// The template used to render the notification subject line.
+	Subject string `json:"subject"`
+	// The template used to render the notification body.
+	Body string `json:"body"`
+	// Whether the body is rendered with html/template, which escapes all substituted values, rather than text/template.
+	HTML bool `json:"html"`
+}
+
+// Sends a notification when a node or task execution within a workflow execution reaches one of the phases.
+type NotificationRule struct {
+	// Optional, when empty the rule matches every node.
+	NodeID string `json:"nodeId,omitempty"`
+	// Optional and only used by task rules, when empty the rule matches every task.
+	TaskName string `json:"taskName,omitempty"`
+	// Upper-cased node execution phases for node rules and task execution phases for task rules, e.g. FAILED.
+	Phases []string `json:"phases"`
+	// Only used by task rules, the rule matches task executions whose retry attempt is at least this value.
+	MinRetryAttempt uint32 `json:"minRetryAttempt,omitempty"`
+	// One of email, slack or pagerduty. Defaults to email.
+	Channel    string   `json:"channel,omitempty"`
+	Recipients []string `json:"recipients"`
+}
+
+// Node- and task-level notification rules, registered for a project and domain, a workflow or a launch plan.
+type NotificationRules struct {
+	Nodes []NotificationRule `json:"nodes,omitempty"`
+	Tasks []NotificationRule `json:"tasks,omitempty"`
+}
+
 // This section handles the configuration of notifications emails.
 type NotificationsEmailerConfig struct {
 	// For use with external email services (mailchimp/sendgrid)
@@ -308,6 +412,11 @@ type NotificationsEmailerConfig struct {
 	Sender string `json:"sender"`
 	// The optionally templatized body the sender used in notification emails.
 	Body string `json:"body"`
+	// Optional Go templates keyed by lower-cased workflow execution phase (e.g. "failed"). The "default" key applies
+	// to all phases without a more specific entry. When no template matches, Subject and Body above are used instead.
+	Templates map[string]NotificationTemplate `json:"templates"`
+	// Base url of the Flyte console, used to populate links to executions in notification templates.
+	ConsoleURL string `json:"consoleUrl"`
 }
 
 // This section handles configuration for the workflow notifications pipeline.
@@ -318,13 +427,59 @@ type EventsPublisherConfig struct {
 	EventTypes []string `json:"eventTypes"`
 }
 
+// Connection options for Kafka backed notifications and external events.
+type KafkaConfig struct {
+	// Addresses of the brokers used to bootstrap the client connection.
+	Brokers []string `json:"brokers"`
+	// The Kafka protocol version of the brokers, e.g. "2.0.0". Defaults to 1.0.0.
+	Version string `json:"version"`
+	// The consumer group joined by the notifications processor. Defaults to "flyteadmin-notifications".
+	ConsumerGroup string `json:"consumerGroup"`
+}
+
+// Configures the transactional outbox for external events. When enabled, events are written to the outbox in the same
+// transaction as the execution state change they describe and relayed to the events publisher in the background, which
+// guarantees at-least-once delivery of events for committed changes only.
+type OutboxConfig struct {
+	Enable bool `json:"enable"`
+	// How often the relay polls the outbox for unpublished events. Defaults to 1 second.
+	PollIntervalSeconds int `json:"pollIntervalSeconds"`
+	// The maximum number of events relayed per poll. Defaults to 100.
+	BatchSize int `json:"batchSize"`
+	// The number of failed publish attempts after which an event is dead-lettered and no longer relayed. Defaults to 10.
+	MaxAttempts int `json:"maxAttempts"`
+	// How long published events are kept in the outbox before being deleted. Defaults to 24 hours.
+	RetentionHours int `json:"retentionHours"`
+	// How long the replica relaying the outbox holds its lease without renewing it, after which another replica takes
+	// over. Must exceed the poll interval. Defaults to 30 seconds.
+	LeaseDurationSeconds int `json:"leaseDurationSeconds"`
+}
+
+// Options for external events published in the CloudEvents 1.0 JSON format.
+type CloudEventsConfig struct {
+	// Populates the CloudEvents source attribute. Defaults to "flyteadmin".
+	Source string `json:"source"`
+	// Prefixes the CloudEvents type attribute, which is suffixed with the event type, e.g. workflow, node or task.
+	// Defaults to "org.flyte.event".
+	TypePrefix string `json:"typePrefix"`
+	// Optional URI of the schema that the event data adheres to, set as the CloudEvents dataschema attribute.
+	DataSchema string `json:"dataSchema"`
+}
+
 type ExternalEventsConfig struct {
 	Enable bool `json:"enable"`
+	// The format events are published in, either "protobuf" (the default) for serialized event requests or
+	// "cloudevents" for JSON CloudEvents envelopes enriched with execution metadata.
+	Format            string            `json:"format"`
+	CloudEventsConfig CloudEventsConfig `json:"cloudEvents"`
+	OutboxConfig      OutboxConfig      `json:"outbox"`
 	// Defines the cloud provider that backs the scheduler. In the absence of a specification the no-op, 'local'
 	// scheme is used.
 	Type      string    `json:"type"`
 	AWSConfig AWSConfig `json:"aws"`
 	GCPConfig GCPConfig `json:"gcp"`
+	// Used when the type is kafka, in which case events are published to the topic in the events publisher config.
+	KafkaConfig KafkaConfig `json:"kafka"`
 	// Publish events to a pubsub tops
 	EventsPublisherConfig EventsPublisherConfig `json:"eventsPublisher"`
 	// Number of times to attempt recreating a notifications processor client should there be any disruptions.
@@ -342,6 +497,7 @@ type NotificationsConfig struct {
 	Region                       string                       `json:"region"`
 	AWSConfig                    AWSConfig                    `json:"aws"`
 	GCPConfig                    GCPConfig                    `json:"gcp"`
+	KafkaConfig                  KafkaConfig                  `json:"kafka"`
 	NotificationsPublisherConfig NotificationsPublisherConfig `json:"publisher"`
 	NotificationsProcessorConfig NotificationsProcessorConfig `json:"processor"`
 	NotificationsEmailerConfig   NotificationsEmailerConfig   `json:"emailer"`
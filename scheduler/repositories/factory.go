@@ -13,10 +13,14 @@ type RepoConfig int32
 
 const (
 	POSTGRES RepoConfig = 0
+	SQLITE   RepoConfig = 1
+	MYSQL    RepoConfig = 2
 )
 
 var RepositoryConfigurationName = map[int32]string{
 	0: "POSTGRES",
+	1: "SQLITE",
+	2: "MYSQL",
 }
 
 // The SchedulerRepoInterface indicates the methods that each Repository must support.
@@ -38,7 +42,35 @@ func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutil
 			db,
 			errors.NewPostgresErrorTransformer(postgresScope.NewSubScope("errors")),
 			postgresScope.NewSubScope("repositories"))
+	case SQLITE:
+		sqliteScope := scope.NewSubScope("sqlite")
+		db := config.OpenDbConnection(config.NewSQLiteConfigProvider(dbConfig, sqliteScope))
+		return NewPostgresRepo(
+			db,
+			errors.NewSQLiteErrorTransformer(sqliteScope.NewSubScope("errors")),
+			sqliteScope.NewSubScope("repositories"))
+	case MYSQL:
+		mysqlScope := scope.NewSubScope("mysql")
+		db := config.OpenDbConnection(config.NewMySQLConfigProvider(dbConfig, mysqlScope))
+		return NewPostgresRepo(
+			db,
+			errors.NewMySQLErrorTransformer(mysqlScope.NewSubScope("errors")),
+			mysqlScope.NewSubScope("repositories"))
 	default:
 		panic(fmt.Sprintf("Invalid repoType %v", repoType))
 	}
 }
+
+// Returns the repository type for the configured database type, which defaults to Postgres.
+func GetRepoConfig(dbConfig config.DbConfig) RepoConfig {
+	switch dbConfig.Type {
+	case "", config.PostgresDbType:
+		return POSTGRES
+	case config.SQLiteDbType:
+		return SQLITE
+	case config.MySQLDbType:
+		return MYSQL
+	default:
+		panic(fmt.Sprintf("Invalid database type %v", dbConfig.Type))
+	}
+}
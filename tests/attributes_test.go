@@ -11,7 +11,6 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
-	databaseConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
@@ -30,7 +29,7 @@ func TestUpdateProjectDomainAttributes(t *testing.T) {
 	client, conn := GetTestAdminServiceClient()
 	defer conn.Close()
 
-	db := databaseConfig.OpenDbConnection(databaseConfig.NewPostgresConfigProvider(getDbConfig(), adminScope))
+	db := openDbConnectionForTesting()
 	truncateTableForTesting(db, "resources")
 	db.Close()
 
@@ -102,7 +101,7 @@ func TestUpdateWorkflowAttributes(t *testing.T) {
 	client, conn := GetTestAdminServiceClient()
 	defer conn.Close()
 
-	db := databaseConfig.OpenDbConnection(databaseConfig.NewPostgresConfigProvider(getDbConfig(), adminScope))
+	db := openDbConnectionForTesting()
 	truncateTableForTesting(db, "resources")
 	db.Close()
 
@@ -159,7 +158,7 @@ func TestListAllMatchableAttributes(t *testing.T) {
 	client, conn := GetTestAdminServiceClient()
 	defer conn.Close()
 
-	db := databaseConfig.OpenDbConnection(databaseConfig.NewPostgresConfigProvider(getDbConfig(), adminScope))
+	db := openDbConnectionForTesting()
 	truncateTableForTesting(db, "resources")
 	db.Close()
 
@@ -4,6 +4,7 @@ package tests
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/jinzhu/gorm"
 
@@ -11,13 +12,24 @@ import (
 	"github.com/flyteorg/flytestdlib/promutils"
 )
 
+// Executions are inserted with an empty spec, which is valid in both Postgres and SQLite.
 const insertExecutionQueryStr = `INSERT INTO "executions" ` +
-	`("execution_project","execution_domain","execution_name","phase","launch_plan_id","workflow_id") ` +
-	`VALUES ('%s', '%s', '%s', '%s', '%d', '%d')`
+	`("execution_project","execution_domain","execution_name","phase","launch_plan_id","workflow_id","spec") ` +
+	`VALUES ('%s', '%s', '%s', '%s', '%d', '%d', '')`
+
+// Set to the path of the SQLite database file of the flyteadmin instance under test to run the integration tests
+// against SQLite rather than Postgres.
+const sqliteDbEnvVar = "FLYTEADMIN_TEST_SQLITE_DB"
 
 var adminScope = promutils.NewScope("flyteadmin")
 
 func getDbConfig() database_config.DbConfig {
+	if sqliteDb := os.Getenv(sqliteDbEnvVar); len(sqliteDb) > 0 {
+		return database_config.DbConfig{
+			Type:   database_config.SQLiteDbType,
+			DbName: sqliteDb,
+		}
+	}
 	return database_config.DbConfig{
 		Host:   "postgres",
 		Port:   5432,
@@ -36,41 +48,33 @@ func getLocalDbConfig() database_config.DbConfig {
 	}
 }
 
+func openDbConnectionForTesting() *gorm.DB {
+	return database_config.OpenDbConnection(database_config.NewDbConnectionConfigProvider(getDbConfig(), adminScope))
+}
+
 func truncateTableForTesting(db *gorm.DB, tableName string) {
+	if db.Dialect().GetName() == database_config.SQLite {
+		// SQLite doesn't support TRUNCATE.
+		db.Exec(fmt.Sprintf("DELETE FROM %s;", tableName))
+		return
+	}
 	db.Exec(fmt.Sprintf("TRUNCATE TABLE %s;", tableName))
 }
 
 func truncateAllTablesForTestingOnly() {
 	// Load the running configuration in order to talk to the running flyteadmin instance
 	fmt.Println("Truncating tables")
-	TruncateTasks := fmt.Sprintf("TRUNCATE TABLE tasks;")
-	TruncateWorkflows := fmt.Sprintf("TRUNCATE TABLE workflows;")
-	TruncateLaunchPlans := fmt.Sprintf("TRUNCATE TABLE launch_plans;")
-	// HACK: alter executions table so that spec is not required for testing.
-	TruncateExecutions := fmt.Sprintf("TRUNCATE TABLE executions; alter table executions alter column spec drop not null;")
-	TruncateExecutionEvents := fmt.Sprintf("TRUNCATE TABLE execution_events;")
-	TruncateNamedEntityMetadata := fmt.Sprintf("TRUNCATE TABLE named_entity_metadata;")
-	TruncateNodeExecutions := fmt.Sprintf("TRUNCATE TABLE node_executions;")
-	TruncateNodeExecutionEvents := fmt.Sprintf("TRUNCATE TABLE node_execution_events;")
-	TruncateTaskExecutions := fmt.Sprintf("TRUNCATE TABLE task_executions;")
-	TruncateResources := fmt.Sprintf("TRUNCATE TABLE resources;")
-	db := database_config.OpenDbConnection(database_config.NewPostgresConfigProvider(getDbConfig(), adminScope))
+	db := openDbConnectionForTesting()
 	defer db.Close()
-	db.Exec(TruncateTasks)
-	db.Exec(TruncateWorkflows)
-	db.Exec(TruncateLaunchPlans)
-	db.Exec(TruncateExecutions)
-	db.Exec(TruncateExecutionEvents)
-	db.Exec(TruncateNamedEntityMetadata)
-	db.Exec(TruncateNodeExecutions)
-	db.Exec(TruncateNodeExecutionEvents)
-	db.Exec(TruncateTaskExecutions)
-	db.Exec(TruncateResources)
+	for _, tableName := range []string{"tasks", "workflows", "launch_plans", "executions", "execution_events",
+		"named_entity_metadata", "node_executions", "node_execution_events", "task_executions", "resources"} {
+		truncateTableForTesting(db, tableName)
+	}
 }
 
 func populateWorkflowExecutionForTestingOnly(project, domain, name string) {
 	InsertExecution := fmt.Sprintf(insertExecutionQueryStr, project, domain, name, "UNDEFINED", 1, 2)
-	db := database_config.OpenDbConnection(database_config.NewPostgresConfigProvider(getDbConfig(), adminScope))
+	db := openDbConnectionForTesting()
 	defer db.Close()
 	db.Exec(InsertExecution)
 }
@@ -13,7 +13,6 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 
-	database_config "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
@@ -156,14 +155,14 @@ func populateWorkflowExecutionsForTestingOnly() {
 		fmt.Sprintf(insertExecutionQueryStr, "project1", "domain2", "name1", "RUNNING", 1, 2),
 		fmt.Sprintf(insertExecutionQueryStr, "project2", "domain2", "name1", "SUCCEEDED", 1, 2),
 	}
-	db := database_config.OpenDbConnection(database_config.NewPostgresConfigProvider(getDbConfig(), adminScope))
+	db := openDbConnectionForTesting()
 	defer db.Close()
 
 	// Insert dummy launch plans;
 	db.Exec(`INSERT INTO launch_plans ("id", "project", "domain", "name", "version", "spec", "closure") ` +
-		`VALUES (1, 'project1', 'domain1', 'name1', 'version1', E'\\000', E'\\000')`)
+		`VALUES (1, 'project1', 'domain1', 'name1', 'version1', ?, ?)`, []byte{0}, []byte{0})
 	db.Exec(`INSERT INTO launch_plans ("id", "project", "domain", "name", "version", "spec", "closure") ` +
-		`VALUES (3, 'project2', 'domain2', 'name2', 'version1', E'\\000', E'\\000')`)
+		`VALUES (3, 'project2', 'domain2', 'name2', 'version1', ?, ?)`, []byte{0}, []byte{0})
 	// And dummy workflows:
 	db.Exec(`INSERT INTO workflows ("id", "project", "domain", "name", "version", "remote_closure_identifier") ` +
 		`VALUES (2, 'project1', 'domain1', 'name1', 'version1', 's3://foo')`)